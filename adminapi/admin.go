@@ -14,6 +14,7 @@ import (
 	"github.com/SplitFi/go-splitfi/service/persist/postgres"
 	"github.com/SplitFi/go-splitfi/service/user"
 	"github.com/SplitFi/go-splitfi/validate"
+	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/go-playground/validator/v10"
 )
 
@@ -23,10 +24,11 @@ type AdminAPI struct {
 	authRefreshCache *redis.Cache
 	validator        *validator.Validate
 	multichain       *multichain.Provider
+	ethClient        *ethclient.Client
 }
 
-func NewAPI(repos *postgres.Repositories, queries *db.Queries, authRefreshCache *redis.Cache, validator *validator.Validate, mp *multichain.Provider) *AdminAPI {
-	return &AdminAPI{repos, queries, authRefreshCache, validator, mp}
+func NewAPI(repos *postgres.Repositories, queries *db.Queries, authRefreshCache *redis.Cache, validator *validator.Validate, mp *multichain.Provider, ethClient *ethclient.Client) *AdminAPI {
+	return &AdminAPI{repos, queries, authRefreshCache, validator, mp, ethClient}
 }
 
 func (api *AdminAPI) AddRolesToUser(ctx context.Context, username string, roles []*persist.Role) (*db.User, error) {
@@ -0,0 +1,48 @@
+package adminapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/SplitFi/go-splitfi/db/gen/coredb"
+	"github.com/SplitFi/go-splitfi/service/persist"
+	"github.com/SplitFi/go-splitfi/service/splitsync"
+	"github.com/SplitFi/go-splitfi/validate"
+	"github.com/jackc/pgx/v4"
+)
+
+// ResyncSplitFromChain replaces a split's recipients with the recipients and allocations
+// enforced by its deployed contract. Splits that haven't been deployed can't be resynced.
+func (api *AdminAPI) ResyncSplitFromChain(ctx context.Context, splitID persist.DBID) (*db.Split, error) {
+	requireRetoolAuthorized(ctx)
+
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"splitID": validate.WithTag(splitID, "required"),
+	}); err != nil {
+		return nil, err
+	}
+
+	split, err := api.queries.GetSplitById(ctx, splitID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, persist.ErrSplitNotFound{ID: splitID}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if split.Address == "" {
+		return nil, validate.ErrInvalidInput{Parameters: []string{"splitID"}, Reasons: []string{"split hasn't been deployed"}}
+	}
+
+	err = splitsync.ResyncFromChain(ctx, api.repos, api.queries, api.ethClient, split, persist.RevisionSourceAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	split, err = api.queries.GetSplitById(ctx, splitID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &split, nil
+}
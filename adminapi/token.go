@@ -0,0 +1,30 @@
+package adminapi
+
+import (
+	"context"
+
+	db "github.com/SplitFi/go-splitfi/db/gen/coredb"
+	"github.com/SplitFi/go-splitfi/service/persist"
+	"github.com/SplitFi/go-splitfi/validate"
+)
+
+// SetTokenPrice sets the USD price and decimals used to value transfers of a token in split analytics
+func (api *AdminAPI) SetTokenPrice(ctx context.Context, chain persist.Chain, tokenAddress persist.Address, decimals int, usdPrice float64) error {
+	requireRetoolAuthorized(ctx)
+
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"tokenAddress": validate.WithTag(tokenAddress, "required"),
+		"decimals":     validate.WithTag(decimals, "min=0,max=77"),
+		"usdPrice":     validate.WithTag(usdPrice, "min=0"),
+	}); err != nil {
+		return err
+	}
+
+	return api.queries.UpsertTokenPrice(ctx, db.UpsertTokenPriceParams{
+		ID:           persist.GenerateID(),
+		Chain:        chain,
+		TokenAddress: persist.Address(chain.NormalizeAddress(tokenAddress)),
+		Decimals:     int32(decimals),
+		UsdPrice:     usdPrice,
+	})
+}
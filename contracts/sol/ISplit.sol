@@ -0,0 +1,25 @@
+// SPDX-License-Identifier: MIT
+
+pragma solidity ^0.8.0;
+
+/**
+ * @title Split contract configuration
+ * @dev Read-only view of the recipients and allocations enforced by a deployed split
+ */
+interface ISplit {
+    /**
+     * @dev Returns the recipient accounts of the split and their allocations. Both arrays
+     * have the same length and are index-aligned.
+     */
+    function getRecipients() external view returns (address[] memory accounts, uint32[] memory allocations);
+
+    /**
+     * @dev Returns the sum of all allocations of the split.
+     */
+    function totalAllocation() external view returns (uint32);
+
+    /**
+     * @dev Returns the account that is allowed to update the split, or the zero address if the split is immutable.
+     */
+    function controller() external view returns (address);
+}
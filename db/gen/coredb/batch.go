@@ -18,6 +18,70 @@ var (
 	ErrBatchAlreadyClosed = errors.New("batch already closed")
 )
 
+const getContactByOwnerChainAddressBatch = `-- name: GetContactByOwnerChainAddressBatch :batchone
+select id, version, created_at, last_updated, deleted, owner_id, chain, address, label, notes from contacts where owner_id = $1 and chain = $2 and address = $3 and deleted = false
+`
+
+type GetContactByOwnerChainAddressBatchBatchResults struct {
+	br     pgx.BatchResults
+	tot    int
+	closed bool
+}
+
+type GetContactByOwnerChainAddressBatchParams struct {
+	OwnerID persist.DBID    `db:"owner_id" json:"owner_id"`
+	Chain   persist.Chain   `db:"chain" json:"chain"`
+	Address persist.Address `db:"address" json:"address"`
+}
+
+func (q *Queries) GetContactByOwnerChainAddressBatch(ctx context.Context, arg []GetContactByOwnerChainAddressBatchParams) *GetContactByOwnerChainAddressBatchBatchResults {
+	batch := &pgx.Batch{}
+	for _, a := range arg {
+		vals := []interface{}{
+			a.OwnerID,
+			a.Chain,
+			a.Address,
+		}
+		batch.Queue(getContactByOwnerChainAddressBatch, vals...)
+	}
+	br := q.db.SendBatch(ctx, batch)
+	return &GetContactByOwnerChainAddressBatchBatchResults{br, len(arg), false}
+}
+
+func (b *GetContactByOwnerChainAddressBatchBatchResults) QueryRow(f func(int, Contact, error)) {
+	defer b.br.Close()
+	for t := 0; t < b.tot; t++ {
+		var i Contact
+		if b.closed {
+			if f != nil {
+				f(t, i, ErrBatchAlreadyClosed)
+			}
+			continue
+		}
+		row := b.br.QueryRow()
+		err := row.Scan(
+			&i.ID,
+			&i.Version,
+			&i.CreatedAt,
+			&i.LastUpdated,
+			&i.Deleted,
+			&i.OwnerID,
+			&i.Chain,
+			&i.Address,
+			&i.Label,
+			&i.Notes,
+		)
+		if f != nil {
+			f(t, i, err)
+		}
+	}
+}
+
+func (b *GetContactByOwnerChainAddressBatchBatchResults) Close() error {
+	b.closed = true
+	return b.br.Close()
+}
+
 const getNotificationByIDBatch = `-- name: GetNotificationByIDBatch :batchone
 SELECT id, deleted, owner_id, version, last_updated, created_at, action, data, event_ids, split_id, seen, amount FROM notifications WHERE id = $1 AND deleted = false
 `
@@ -206,16 +270,19 @@ func (b *GetSplitByIdBatchBatchResults) Close() error {
 
 const getSplitsByUserIDBatch = `-- name: GetSplitsByUserIDBatch :batchmany
 select s.id, s.version, s.last_updated, s.created_at, s.deleted, s.chain, s.l1_chain, s.address, s.name, s.description, s.creator_address, s.logo_url, s.banner_url, s.badge_url, s.total_ownership
-    from users u, unnest(u.wallets)
-    with ordinality as a(wallet_id, wallet_ord)
-        join wallets w on w.id = a.wallet_id
-        join recipients r on r.address = w.address
-        join splits s on s.id = r.split_id
-    where u.id = $1
-      and u.deleted = false
-      and w.deleted = false
-      and r.deleted = false
-      and s.deleted = false
+    from splits s
+        join (select distinct r.split_id
+                from users u, unnest(u.wallets) as a(wallet_id)
+                    join wallets w on w.id = a.wallet_id
+                    join recipients r on r.address = w.address
+                where u.id = $1
+                  and u.deleted = false
+                  and w.deleted = false
+                  and r.deleted = false) m on m.split_id = s.id
+        left join split_user_settings us on us.split_id = s.id and us.user_id = $1 and us.deleted = false
+    where s.deleted = false
+      and coalesce(us.hidden, false) = false
+    order by us.position collate "C" nulls last, s.created_at, s.id
 `
 
 type GetSplitsByUserIDBatchBatchResults struct {
@@ -6,12 +6,26 @@ package coredb
 
 import (
 	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/SplitFi/go-splitfi/service/persist"
 	"github.com/jackc/pgtype"
 )
 
+type Contact struct {
+	ID          persist.DBID    `db:"id" json:"id"`
+	Version     int32           `db:"version" json:"version"`
+	CreatedAt   time.Time       `db:"created_at" json:"created_at"`
+	LastUpdated time.Time       `db:"last_updated" json:"last_updated"`
+	Deleted     bool            `db:"deleted" json:"deleted"`
+	OwnerID     persist.DBID    `db:"owner_id" json:"owner_id"`
+	Chain       persist.Chain   `db:"chain" json:"chain"`
+	Address     persist.Address `db:"address" json:"address"`
+	Label       string          `db:"label" json:"label"`
+	Notes       string          `db:"notes" json:"notes"`
+}
+
 type DevMetadataUser struct {
 	UserID          persist.DBID  `db:"user_id" json:"user_id"`
 	HasEmailAddress persist.Email `db:"has_email_address" json:"has_email_address"`
@@ -36,6 +50,25 @@ type Event struct {
 	GroupID        sql.NullString       `db:"group_id" json:"group_id"`
 }
 
+type LedgerExport struct {
+	ID               persist.DBID    `db:"id" json:"id"`
+	Version          int32           `db:"version" json:"version"`
+	CreatedAt        time.Time       `db:"created_at" json:"created_at"`
+	LastUpdated      time.Time       `db:"last_updated" json:"last_updated"`
+	Deleted          bool            `db:"deleted" json:"deleted"`
+	RequesterID      persist.DBID    `db:"requester_id" json:"requester_id"`
+	SplitID          persist.DBID    `db:"split_id" json:"split_id"`
+	RecipientAddress persist.Address `db:"recipient_address" json:"recipient_address"`
+	Format           string          `db:"format" json:"format"`
+	FromTime         time.Time       `db:"from_time" json:"from_time"`
+	ToTime           time.Time       `db:"to_time" json:"to_time"`
+	Status           string          `db:"status" json:"status"`
+	ObjectName       sql.NullString  `db:"object_name" json:"object_name"`
+	RowCount         int32           `db:"row_count" json:"row_count"`
+	Error            sql.NullString  `db:"error" json:"error"`
+	CompletedAt      sql.NullTime    `db:"completed_at" json:"completed_at"`
+}
+
 type LegacyView struct {
 	UserID      persist.DBID  `db:"user_id" json:"user_id"`
 	ViewCount   sql.NullInt32 `db:"view_count" json:"view_count"`
@@ -179,6 +212,237 @@ type Split struct {
 	TotalOwnership int32           `db:"total_ownership" json:"total_ownership"`
 }
 
+type SplitDeletionApproval struct {
+	ID          persist.DBID    `db:"id" json:"id"`
+	Version     int32           `db:"version" json:"version"`
+	CreatedAt   time.Time       `db:"created_at" json:"created_at"`
+	LastUpdated time.Time       `db:"last_updated" json:"last_updated"`
+	Deleted     bool            `db:"deleted" json:"deleted"`
+	RequestID   persist.DBID    `db:"request_id" json:"request_id"`
+	ApproverID  persist.DBID    `db:"approver_id" json:"approver_id"`
+	Address     persist.Address `db:"address" json:"address"`
+	Ownership   int32           `db:"ownership" json:"ownership"`
+}
+
+type SplitDeletionRequest struct {
+	ID          persist.DBID `db:"id" json:"id"`
+	Version     int32        `db:"version" json:"version"`
+	CreatedAt   time.Time    `db:"created_at" json:"created_at"`
+	LastUpdated time.Time    `db:"last_updated" json:"last_updated"`
+	Deleted     bool         `db:"deleted" json:"deleted"`
+	SplitID     persist.DBID `db:"split_id" json:"split_id"`
+	RequesterID persist.DBID `db:"requester_id" json:"requester_id"`
+	Quorum      int32        `db:"quorum" json:"quorum"`
+	CompletedAt sql.NullTime `db:"completed_at" json:"completed_at"`
+}
+
+type SplitDraft struct {
+	ID          persist.DBID   `db:"id" json:"id"`
+	Version     int32          `db:"version" json:"version"`
+	CreatedAt   time.Time      `db:"created_at" json:"created_at"`
+	LastUpdated time.Time      `db:"last_updated" json:"last_updated"`
+	Deleted     bool           `db:"deleted" json:"deleted"`
+	SplitID     persist.DBID   `db:"split_id" json:"split_id"`
+	EditID      string         `db:"edit_id" json:"edit_id"`
+	OwnerID     persist.DBID   `db:"owner_id" json:"owner_id"`
+	Name        sql.NullString `db:"name" json:"name"`
+	Description sql.NullString `db:"description" json:"description"`
+	ExpiresAt   time.Time      `db:"expires_at" json:"expires_at"`
+}
+
+type SplitFollow struct {
+	ID          persist.DBID `db:"id" json:"id"`
+	Version     int32        `db:"version" json:"version"`
+	CreatedAt   time.Time    `db:"created_at" json:"created_at"`
+	LastUpdated time.Time    `db:"last_updated" json:"last_updated"`
+	Deleted     bool         `db:"deleted" json:"deleted"`
+	SplitID     persist.DBID `db:"split_id" json:"split_id"`
+	UserID      persist.DBID `db:"user_id" json:"user_id"`
+}
+
+type SplitGroup struct {
+	ID          persist.DBID    `db:"id" json:"id"`
+	Version     int32           `db:"version" json:"version"`
+	CreatedAt   time.Time       `db:"created_at" json:"created_at"`
+	LastUpdated time.Time       `db:"last_updated" json:"last_updated"`
+	Deleted     bool            `db:"deleted" json:"deleted"`
+	L1Chain     persist.L1Chain `db:"l1_chain" json:"l1_chain"`
+	Name        string          `db:"name" json:"name"`
+	Description string          `db:"description" json:"description"`
+	CreatorID   persist.DBID    `db:"creator_id" json:"creator_id"`
+}
+
+type SplitGroupMember struct {
+	ID          persist.DBID  `db:"id" json:"id"`
+	Version     int32         `db:"version" json:"version"`
+	CreatedAt   time.Time     `db:"created_at" json:"created_at"`
+	LastUpdated time.Time     `db:"last_updated" json:"last_updated"`
+	Deleted     bool          `db:"deleted" json:"deleted"`
+	GroupID     persist.DBID  `db:"group_id" json:"group_id"`
+	SplitID     persist.DBID  `db:"split_id" json:"split_id"`
+	Chain       persist.Chain `db:"chain" json:"chain"`
+}
+
+type SplitInflow struct {
+	ID           persist.DBID    `db:"id" json:"id"`
+	Version      int32           `db:"version" json:"version"`
+	CreatedAt    time.Time       `db:"created_at" json:"created_at"`
+	LastUpdated  time.Time       `db:"last_updated" json:"last_updated"`
+	Deleted      bool            `db:"deleted" json:"deleted"`
+	SplitID      persist.DBID    `db:"split_id" json:"split_id"`
+	Chain        persist.Chain   `db:"chain" json:"chain"`
+	TokenAddress persist.Address `db:"token_address" json:"token_address"`
+	PayerAddress persist.Address `db:"payer_address" json:"payer_address"`
+	Amount       pgtype.Numeric  `db:"amount" json:"amount"`
+	UsdValue     pgtype.Numeric  `db:"usd_value" json:"usd_value"`
+	TxHash       string          `db:"tx_hash" json:"tx_hash"`
+	BlockNumber  int64           `db:"block_number" json:"block_number"`
+	LogIndex     int32           `db:"log_index" json:"log_index"`
+}
+
+type SplitInflowRollup struct {
+	SplitID      persist.DBID    `db:"split_id" json:"split_id"`
+	Day          time.Time       `db:"day" json:"day"`
+	Chain        persist.Chain   `db:"chain" json:"chain"`
+	TokenAddress persist.Address `db:"token_address" json:"token_address"`
+	PayerAddress persist.Address `db:"payer_address" json:"payer_address"`
+	Amount       pgtype.Numeric  `db:"amount" json:"amount"`
+	UsdValue     pgtype.Numeric  `db:"usd_value" json:"usd_value"`
+	InflowCount  int32           `db:"inflow_count" json:"inflow_count"`
+	LastUpdated  time.Time       `db:"last_updated" json:"last_updated"`
+}
+
+type SplitLedgerEntry struct {
+	ID               persist.DBID            `db:"id" json:"id"`
+	Version          int32                   `db:"version" json:"version"`
+	CreatedAt        time.Time               `db:"created_at" json:"created_at"`
+	LastUpdated      time.Time               `db:"last_updated" json:"last_updated"`
+	Deleted          bool                    `db:"deleted" json:"deleted"`
+	SplitID          persist.DBID            `db:"split_id" json:"split_id"`
+	EntryType        persist.LedgerEntryType `db:"entry_type" json:"entry_type"`
+	Chain            persist.Chain           `db:"chain" json:"chain"`
+	TokenAddress     persist.Address         `db:"token_address" json:"token_address"`
+	RecipientAddress persist.Address         `db:"recipient_address" json:"recipient_address"`
+	Amount           persist.HexString       `db:"amount" json:"amount"`
+	SplitBalance     persist.HexString       `db:"split_balance" json:"split_balance"`
+	TxHash           string                  `db:"tx_hash" json:"tx_hash"`
+	BlockNumber      int64                   `db:"block_number" json:"block_number"`
+	LogIndex         int32                   `db:"log_index" json:"log_index"`
+}
+
+type SplitMedia struct {
+	ID            persist.DBID    `db:"id" json:"id"`
+	Version       int32           `db:"version" json:"version"`
+	CreatedAt     time.Time       `db:"created_at" json:"created_at"`
+	LastUpdated   time.Time       `db:"last_updated" json:"last_updated"`
+	Deleted       bool            `db:"deleted" json:"deleted"`
+	SplitID       persist.DBID    `db:"split_id" json:"split_id"`
+	Kind          string          `db:"kind" json:"kind"`
+	UploaderID    persist.DBID    `db:"uploader_id" json:"uploader_id"`
+	ContentType   string          `db:"content_type" json:"content_type"`
+	ContentLength int64           `db:"content_length" json:"content_length"`
+	Status        string          `db:"status" json:"status"`
+	Error         sql.NullString  `db:"error" json:"error"`
+	Width         sql.NullInt32   `db:"width" json:"width"`
+	Height        sql.NullInt32   `db:"height" json:"height"`
+	Blurhash      sql.NullString  `db:"blurhash" json:"blurhash"`
+	AspectRatio   sql.NullFloat64 `db:"aspect_ratio" json:"aspect_ratio"`
+	SmallUrl      sql.NullString  `db:"small_url" json:"small_url"`
+	MediumUrl     sql.NullString  `db:"medium_url" json:"medium_url"`
+	LargeUrl      sql.NullString  `db:"large_url" json:"large_url"`
+	CompletedAt   sql.NullTime    `db:"completed_at" json:"completed_at"`
+}
+
+type SplitMember struct {
+	ID          persist.DBID `db:"id" json:"id"`
+	Version     int32        `db:"version" json:"version"`
+	CreatedAt   time.Time    `db:"created_at" json:"created_at"`
+	LastUpdated time.Time    `db:"last_updated" json:"last_updated"`
+	Deleted     bool         `db:"deleted" json:"deleted"`
+	SplitID     persist.DBID `db:"split_id" json:"split_id"`
+	UserID      persist.DBID `db:"user_id" json:"user_id"`
+	Role        string       `db:"role" json:"role"`
+	GrantedBy   persist.DBID `db:"granted_by" json:"granted_by"`
+}
+
+type SplitRecipientInvite struct {
+	ID           persist.DBID  `db:"id" json:"id"`
+	Version      int32         `db:"version" json:"version"`
+	CreatedAt    time.Time     `db:"created_at" json:"created_at"`
+	LastUpdated  time.Time     `db:"last_updated" json:"last_updated"`
+	Deleted      bool          `db:"deleted" json:"deleted"`
+	SplitID      persist.DBID  `db:"split_id" json:"split_id"`
+	RecipientID  persist.DBID  `db:"recipient_id" json:"recipient_id"`
+	InviterID    persist.DBID  `db:"inviter_id" json:"inviter_id"`
+	EmailAddress persist.Email `db:"email_address" json:"email_address"`
+	Status       string        `db:"status" json:"status"`
+	InviteeID    persist.DBID  `db:"invitee_id" json:"invitee_id"`
+	LastSentAt   time.Time     `db:"last_sent_at" json:"last_sent_at"`
+	AcceptedAt   sql.NullTime  `db:"accepted_at" json:"accepted_at"`
+}
+
+type SplitRelevance struct {
+	ID    persist.DBID `db:"id" json:"id"`
+	Score float64      `db:"score" json:"score"`
+}
+
+type SplitRevision struct {
+	ID             persist.DBID               `db:"id" json:"id"`
+	Version        int32                      `db:"version" json:"version"`
+	CreatedAt      time.Time                  `db:"created_at" json:"created_at"`
+	LastUpdated    time.Time                  `db:"last_updated" json:"last_updated"`
+	Deleted        bool                       `db:"deleted" json:"deleted"`
+	SplitID        persist.DBID               `db:"split_id" json:"split_id"`
+	Revision       int32                      `db:"revision" json:"revision"`
+	ActorID        sql.NullString             `db:"actor_id" json:"actor_id"`
+	Source         persist.RevisionSource     `db:"source" json:"source"`
+	Name           string                     `db:"name" json:"name"`
+	Description    string                     `db:"description" json:"description"`
+	LogoUrl        sql.NullString             `db:"logo_url" json:"logo_url"`
+	BannerUrl      sql.NullString             `db:"banner_url" json:"banner_url"`
+	BadgeUrl       sql.NullString             `db:"badge_url" json:"badge_url"`
+	TotalOwnership int32                      `db:"total_ownership" json:"total_ownership"`
+	Recipients     persist.RevisionRecipients `db:"recipients" json:"recipients"`
+}
+
+type SplitTemplate struct {
+	ID             persist.DBID   `db:"id" json:"id"`
+	Version        int32          `db:"version" json:"version"`
+	CreatedAt      time.Time      `db:"created_at" json:"created_at"`
+	LastUpdated    time.Time      `db:"last_updated" json:"last_updated"`
+	Deleted        bool           `db:"deleted" json:"deleted"`
+	OwnerID        persist.DBID   `db:"owner_id" json:"owner_id"`
+	Name           string         `db:"name" json:"name"`
+	Description    string         `db:"description" json:"description"`
+	LogoUrl        sql.NullString `db:"logo_url" json:"logo_url"`
+	BannerUrl      sql.NullString `db:"banner_url" json:"banner_url"`
+	BadgeUrl       sql.NullString `db:"badge_url" json:"badge_url"`
+	TotalOwnership int32          `db:"total_ownership" json:"total_ownership"`
+}
+
+type SplitTemplateRecipient struct {
+	ID          persist.DBID    `db:"id" json:"id"`
+	Version     int32           `db:"version" json:"version"`
+	CreatedAt   time.Time       `db:"created_at" json:"created_at"`
+	LastUpdated time.Time       `db:"last_updated" json:"last_updated"`
+	Deleted     bool            `db:"deleted" json:"deleted"`
+	TemplateID  persist.DBID    `db:"template_id" json:"template_id"`
+	Address     persist.Address `db:"address" json:"address"`
+	Ownership   int32           `db:"ownership" json:"ownership"`
+}
+
+type SplitUserSetting struct {
+	ID          persist.DBID   `db:"id" json:"id"`
+	Version     int32          `db:"version" json:"version"`
+	CreatedAt   time.Time      `db:"created_at" json:"created_at"`
+	LastUpdated time.Time      `db:"last_updated" json:"last_updated"`
+	Deleted     bool           `db:"deleted" json:"deleted"`
+	UserID      persist.DBID   `db:"user_id" json:"user_id"`
+	SplitID     persist.DBID   `db:"split_id" json:"split_id"`
+	Position    sql.NullString `db:"position" json:"position"`
+	Hidden      bool           `db:"hidden" json:"hidden"`
+}
+
 type Token struct {
 	ID           persist.DBID      `db:"id" json:"id"`
 	Deleted      bool              `db:"deleted" json:"deleted"`
@@ -202,6 +466,19 @@ type TokenMetadata struct {
 	Thumbnail       sql.NullString  `db:"thumbnail" json:"thumbnail"`
 	Chain           persist.Chain   `db:"chain" json:"chain"`
 	ContractAddress persist.Address `db:"contract_address" json:"contract_address"`
+	IsSpam          bool            `db:"is_spam" json:"is_spam"`
+}
+
+type TokenPrice struct {
+	ID           persist.DBID    `db:"id" json:"id"`
+	Version      int32           `db:"version" json:"version"`
+	CreatedAt    time.Time       `db:"created_at" json:"created_at"`
+	LastUpdated  time.Time       `db:"last_updated" json:"last_updated"`
+	Deleted      bool            `db:"deleted" json:"deleted"`
+	Chain        persist.Chain   `db:"chain" json:"chain"`
+	TokenAddress persist.Address `db:"token_address" json:"token_address"`
+	Decimals     int32           `db:"decimals" json:"decimals"`
+	UsdPrice     pgtype.Numeric  `db:"usd_price" json:"usd_price"`
 }
 
 type User struct {
@@ -231,6 +508,11 @@ type UserBlocklist struct {
 	Active        sql.NullBool `db:"active" json:"active"`
 }
 
+type UserRelevance struct {
+	ID    persist.DBID `db:"id" json:"id"`
+	Score float64      `db:"score" json:"score"`
+}
+
 type UserRole struct {
 	ID          persist.DBID `db:"id" json:"id"`
 	UserID      persist.DBID `db:"user_id" json:"user_id"`
@@ -252,3 +534,35 @@ type Wallet struct {
 	Chain       persist.Chain      `db:"chain" json:"chain"`
 	L1Chain     persist.L1Chain    `db:"l1_chain" json:"l1_chain"`
 }
+
+type Webhook struct {
+	ID          persist.DBID `db:"id" json:"id"`
+	Version     int32        `db:"version" json:"version"`
+	CreatedAt   time.Time    `db:"created_at" json:"created_at"`
+	LastUpdated time.Time    `db:"last_updated" json:"last_updated"`
+	Deleted     bool         `db:"deleted" json:"deleted"`
+	OwnerID     persist.DBID `db:"owner_id" json:"owner_id"`
+	SplitID     persist.DBID `db:"split_id" json:"split_id"`
+	Url         string       `db:"url" json:"url"`
+	Secret      string       `db:"secret" json:"secret"`
+	EventTypes  []string     `db:"event_types" json:"event_types"`
+	Enabled     bool         `db:"enabled" json:"enabled"`
+}
+
+type WebhookDelivery struct {
+	ID             persist.DBID             `db:"id" json:"id"`
+	Version        int32                    `db:"version" json:"version"`
+	CreatedAt      time.Time                `db:"created_at" json:"created_at"`
+	LastUpdated    time.Time                `db:"last_updated" json:"last_updated"`
+	Deleted        bool                     `db:"deleted" json:"deleted"`
+	WebhookID      persist.DBID             `db:"webhook_id" json:"webhook_id"`
+	EventID        persist.DBID             `db:"event_id" json:"event_id"`
+	EventType      persist.WebhookEventType `db:"event_type" json:"event_type"`
+	Payload        json.RawMessage          `db:"payload" json:"payload"`
+	Status         string                   `db:"status" json:"status"`
+	Attempts       int32                    `db:"attempts" json:"attempts"`
+	ResponseStatus sql.NullInt32            `db:"response_status" json:"response_status"`
+	Error          sql.NullString           `db:"error" json:"error"`
+	NextAttemptAt  sql.NullTime             `db:"next_attempt_at" json:"next_attempt_at"`
+	CompletedAt    sql.NullTime             `db:"completed_at" json:"completed_at"`
+}
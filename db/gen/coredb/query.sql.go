@@ -303,13 +303,13 @@ func (q *Queries) CreateSimpleNotification(ctx context.Context, arg CreateSimple
 }
 
 const createSplit = `-- name: CreateSplit :one
-insert into splits (id, chain, address, name, description, creator_address, logo_url, banner_url, badge_url, total_ownership, created_at, last_updated) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, now(), now()) returning id, version, last_updated, created_at, deleted, chain, l1_chain, address, name, description, creator_address, logo_url, banner_url, badge_url, total_ownership
+insert into splits (id, chain, address, name, description, creator_address, logo_url, banner_url, badge_url, total_ownership, created_at, last_updated) values ($1, $2, nullif($3::varchar, ''), $4, $5, $6, $7, $8, $9, $10, now(), now()) returning id, version, last_updated, created_at, deleted, chain, l1_chain, address, name, description, creator_address, logo_url, banner_url, badge_url, total_ownership
 `
 
 type CreateSplitParams struct {
 	SplitID        persist.DBID    `db:"split_id" json:"split_id"`
 	Chain          persist.Chain   `db:"chain" json:"chain"`
-	Address        persist.Address `db:"address" json:"address"`
+	Address        string          `db:"address" json:"address"`
 	Name           string          `db:"name" json:"name"`
 	Description    string          `db:"description" json:"description"`
 	CreatorAddress persist.Address `db:"creator_address" json:"creator_address"`
@@ -353,6 +353,48 @@ func (q *Queries) CreateSplit(ctx context.Context, arg CreateSplitParams) (Split
 	return i, err
 }
 
+const createSplitAmountNotification = `-- name: CreateSplitAmountNotification :one
+INSERT INTO notifications (id, owner_id, action, data, event_ids, split_id, amount) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id, deleted, owner_id, version, last_updated, created_at, action, data, event_ids, split_id, seen, amount
+`
+
+type CreateSplitAmountNotificationParams struct {
+	ID       persist.DBID             `db:"id" json:"id"`
+	OwnerID  persist.DBID             `db:"owner_id" json:"owner_id"`
+	Action   persist.Action           `db:"action" json:"action"`
+	Data     persist.NotificationData `db:"data" json:"data"`
+	EventIds persist.DBIDList         `db:"event_ids" json:"event_ids"`
+	SplitID  persist.DBID             `db:"split_id" json:"split_id"`
+	Amount   int32                    `db:"amount" json:"amount"`
+}
+
+func (q *Queries) CreateSplitAmountNotification(ctx context.Context, arg CreateSplitAmountNotificationParams) (Notification, error) {
+	row := q.db.QueryRow(ctx, createSplitAmountNotification,
+		arg.ID,
+		arg.OwnerID,
+		arg.Action,
+		arg.Data,
+		arg.EventIds,
+		arg.SplitID,
+		arg.Amount,
+	)
+	var i Notification
+	err := row.Scan(
+		&i.ID,
+		&i.Deleted,
+		&i.OwnerID,
+		&i.Version,
+		&i.LastUpdated,
+		&i.CreatedAt,
+		&i.Action,
+		&i.Data,
+		&i.EventIds,
+		&i.SplitID,
+		&i.Seen,
+		&i.Amount,
+	)
+	return i, err
+}
+
 const createSplitEvent = `-- name: CreateSplitEvent :one
 INSERT INTO events (id, actor_id, action, resource_type_id, split_id, subject_id, data, external_id, group_id, caption) VALUES ($1, $2, $3, $4, $5, $5, $6, $7, $8, $9) RETURNING id, version, actor_id, resource_type_id, subject_id, user_id, action, data, deleted, last_updated, created_at, split_id, external_id, caption, group_id
 `
@@ -402,6 +444,46 @@ func (q *Queries) CreateSplitEvent(ctx context.Context, arg CreateSplitEventPara
 	return i, err
 }
 
+const createSplitNotification = `-- name: CreateSplitNotification :one
+INSERT INTO notifications (id, owner_id, action, data, event_ids, split_id) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id, deleted, owner_id, version, last_updated, created_at, action, data, event_ids, split_id, seen, amount
+`
+
+type CreateSplitNotificationParams struct {
+	ID       persist.DBID             `db:"id" json:"id"`
+	OwnerID  persist.DBID             `db:"owner_id" json:"owner_id"`
+	Action   persist.Action           `db:"action" json:"action"`
+	Data     persist.NotificationData `db:"data" json:"data"`
+	EventIds persist.DBIDList         `db:"event_ids" json:"event_ids"`
+	SplitID  persist.DBID             `db:"split_id" json:"split_id"`
+}
+
+func (q *Queries) CreateSplitNotification(ctx context.Context, arg CreateSplitNotificationParams) (Notification, error) {
+	row := q.db.QueryRow(ctx, createSplitNotification,
+		arg.ID,
+		arg.OwnerID,
+		arg.Action,
+		arg.Data,
+		arg.EventIds,
+		arg.SplitID,
+	)
+	var i Notification
+	err := row.Scan(
+		&i.ID,
+		&i.Deleted,
+		&i.OwnerID,
+		&i.Version,
+		&i.LastUpdated,
+		&i.CreatedAt,
+		&i.Action,
+		&i.Data,
+		&i.EventIds,
+		&i.SplitID,
+		&i.Seen,
+		&i.Amount,
+	)
+	return i, err
+}
+
 const createUserEvent = `-- name: CreateUserEvent :one
 INSERT INTO events (id, actor_id, action, resource_type_id, user_id, subject_id, data, group_id, caption) VALUES ($1, $2, $3, $4, $5, $5, $6, $7, $8) RETURNING id, version, actor_id, resource_type_id, subject_id, user_id, action, data, deleted, last_updated, created_at, split_id, external_id, caption, group_id
 `
@@ -498,6 +580,20 @@ func (q *Queries) DeletePushTokensByIDs(ctx context.Context, ids persist.DBIDLis
 	return err
 }
 
+const deleteSplitRecipientsExcept = `-- name: DeleteSplitRecipientsExcept :exec
+update recipients set deleted = true, last_updated = now() where split_id = $1 and deleted = false and not address = any($2::varchar[])
+`
+
+type DeleteSplitRecipientsExceptParams struct {
+	SplitID   persist.DBID `db:"split_id" json:"split_id"`
+	Addresses []string     `db:"addresses" json:"addresses"`
+}
+
+func (q *Queries) DeleteSplitRecipientsExcept(ctx context.Context, arg DeleteSplitRecipientsExceptParams) error {
+	_, err := q.db.Exec(ctx, deleteSplitRecipientsExcept, arg.SplitID, arg.Addresses)
+	return err
+}
+
 const deleteUserByID = `-- name: DeleteUserByID :exec
 update users set deleted = true where id = $1
 `
@@ -749,6 +845,42 @@ func (q *Queries) GetMostRecentNotificationByOwnerIDForAction(ctx context.Contex
 	return i, err
 }
 
+const getMostRecentNotificationByOwnerIDSplitIDForAction = `-- name: GetMostRecentNotificationByOwnerIDSplitIDForAction :one
+select id, deleted, owner_id, version, last_updated, created_at, action, data, event_ids, split_id, seen, amount from notifications
+where owner_id = $1
+  and split_id = $2
+  and action = $3
+  and deleted = false
+order by created_at desc
+limit 1
+`
+
+type GetMostRecentNotificationByOwnerIDSplitIDForActionParams struct {
+	OwnerID persist.DBID   `db:"owner_id" json:"owner_id"`
+	SplitID persist.DBID   `db:"split_id" json:"split_id"`
+	Action  persist.Action `db:"action" json:"action"`
+}
+
+func (q *Queries) GetMostRecentNotificationByOwnerIDSplitIDForAction(ctx context.Context, arg GetMostRecentNotificationByOwnerIDSplitIDForActionParams) (Notification, error) {
+	row := q.db.QueryRow(ctx, getMostRecentNotificationByOwnerIDSplitIDForAction, arg.OwnerID, arg.SplitID, arg.Action)
+	var i Notification
+	err := row.Scan(
+		&i.ID,
+		&i.Deleted,
+		&i.OwnerID,
+		&i.Version,
+		&i.LastUpdated,
+		&i.CreatedAt,
+		&i.Action,
+		&i.Data,
+		&i.EventIds,
+		&i.SplitID,
+		&i.Seen,
+		&i.Amount,
+	)
+	return i, err
+}
+
 const getNotificationByID = `-- name: GetNotificationByID :one
 SELECT id, deleted, owner_id, version, last_updated, created_at, action, data, event_ids, split_id, seen, amount FROM notifications WHERE id = $1 AND deleted = false
 `
@@ -1002,6 +1134,59 @@ func (q *Queries) GetRecentUnseenNotifications(ctx context.Context, arg GetRecen
 	return items, nil
 }
 
+const getRecipientByID = `-- name: GetRecipientByID :one
+select id, version, last_updated, created_at, deleted, split_id, address, ownership from recipients where id = $1 and deleted = false
+`
+
+func (q *Queries) GetRecipientByID(ctx context.Context, id persist.DBID) (Recipient, error) {
+	row := q.db.QueryRow(ctx, getRecipientByID, id)
+	var i Recipient
+	err := row.Scan(
+		&i.ID,
+		&i.Version,
+		&i.LastUpdated,
+		&i.CreatedAt,
+		&i.Deleted,
+		&i.SplitID,
+		&i.Address,
+		&i.Ownership,
+	)
+	return i, err
+}
+
+const getRecipientsBySplitID = `-- name: GetRecipientsBySplitID :many
+select id, version, last_updated, created_at, deleted, split_id, address, ownership from recipients where split_id = $1 and deleted = false order by ownership desc, address
+`
+
+func (q *Queries) GetRecipientsBySplitID(ctx context.Context, splitID persist.DBID) ([]Recipient, error) {
+	rows, err := q.db.Query(ctx, getRecipientsBySplitID, splitID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Recipient
+	for rows.Next() {
+		var i Recipient
+		if err := rows.Scan(
+			&i.ID,
+			&i.Version,
+			&i.LastUpdated,
+			&i.CreatedAt,
+			&i.Deleted,
+			&i.SplitID,
+			&i.Address,
+			&i.Ownership,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getSplitByChainAddress = `-- name: GetSplitByChainAddress :one
 SELECT id, version, last_updated, created_at, deleted, chain, l1_chain, address, name, description, creator_address, logo_url, banner_url, badge_url, total_ownership FROM splits WHERE address = $1 AND chain = $2 AND deleted = false
 `
@@ -1211,36 +1396,44 @@ func (q *Queries) GetSplitsByChainsAndAddresses(ctx context.Context, arg GetSpli
 }
 
 const getSplitsByRecipientAddress = `-- name: GetSplitsByRecipientAddress :many
-SELECT s.id, s.version, s.last_updated, s.created_at, s.deleted, s.chain, s.l1_chain, s.address, s.name, s.description, s.creator_address, s.logo_url, s.banner_url, s.badge_url, s.total_ownership FROM recipients r
+SELECT s.id, s.version, s.last_updated, s.created_at, s.deleted, s.chain, s.l1_chain, s.address, s.name, s.description, s.creator_address, s.logo_url, s.banner_url, s.badge_url, s.total_ownership, sum(r.ownership)::int AS ownership FROM recipients r
                     JOIN splits s ON s.id = r.split_id
-WHERE r.address = $1 AND s.deleted = false
+WHERE r.address = $1 AND r.deleted = false AND s.deleted = false
+GROUP BY s.id
+ORDER BY s.created_at, s.id
 `
 
-func (q *Queries) GetSplitsByRecipientAddress(ctx context.Context, address persist.Address) ([]Split, error) {
+type GetSplitsByRecipientAddressRow struct {
+	Split     Split `db:"split" json:"split"`
+	Ownership int32 `db:"ownership" json:"ownership"`
+}
+
+func (q *Queries) GetSplitsByRecipientAddress(ctx context.Context, address persist.Address) ([]GetSplitsByRecipientAddressRow, error) {
 	rows, err := q.db.Query(ctx, getSplitsByRecipientAddress, address)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Split
+	var items []GetSplitsByRecipientAddressRow
 	for rows.Next() {
-		var i Split
+		var i GetSplitsByRecipientAddressRow
 		if err := rows.Scan(
-			&i.ID,
-			&i.Version,
-			&i.LastUpdated,
-			&i.CreatedAt,
-			&i.Deleted,
-			&i.Chain,
-			&i.L1Chain,
-			&i.Address,
-			&i.Name,
-			&i.Description,
-			&i.CreatorAddress,
-			&i.LogoUrl,
-			&i.BannerUrl,
-			&i.BadgeUrl,
-			&i.TotalOwnership,
+			&i.Split.ID,
+			&i.Split.Version,
+			&i.Split.LastUpdated,
+			&i.Split.CreatedAt,
+			&i.Split.Deleted,
+			&i.Split.Chain,
+			&i.Split.L1Chain,
+			&i.Split.Address,
+			&i.Split.Name,
+			&i.Split.Description,
+			&i.Split.CreatorAddress,
+			&i.Split.LogoUrl,
+			&i.Split.BannerUrl,
+			&i.Split.BadgeUrl,
+			&i.Split.TotalOwnership,
+			&i.Ownership,
 		); err != nil {
 			return nil, err
 		}
@@ -1256,7 +1449,7 @@ const getTokenMetadatasByTokenIdentifiers = `-- name: GetTokenMetadatasByTokenId
 with params as (
     select unnest($1::address[]) as contract_address, unnest($2::chain[]) as chain
 )
-select m.id, m.deleted, m.created_at, m.last_updated, m.symbol, m.name, m.logo, m.thumbnail, m.chain, m.contract_address from params p
+select m.id, m.deleted, m.created_at, m.last_updated, m.symbol, m.name, m.logo, m.thumbnail, m.chain, m.contract_address, m.is_spam from params p
          join token_metadatas m on p.contract_address = m.contract_address and p.chain = m.chain
          where m.deleted = false
 `
@@ -1286,6 +1479,7 @@ func (q *Queries) GetTokenMetadatasByTokenIdentifiers(ctx context.Context, arg G
 			&i.Thumbnail,
 			&i.Chain,
 			&i.ContractAddress,
+			&i.IsSpam,
 		); err != nil {
 			return nil, err
 		}
@@ -2303,6 +2497,20 @@ func (q *Queries) UpdateSplitShares(ctx context.Context, arg UpdateSplitSharesPa
 	return err
 }
 
+const updateSplitTotalOwnership = `-- name: UpdateSplitTotalOwnership :exec
+update splits set total_ownership = $1, last_updated = now() where id = $2 and deleted = false
+`
+
+type UpdateSplitTotalOwnershipParams struct {
+	TotalOwnership int32        `db:"total_ownership" json:"total_ownership"`
+	ID             persist.DBID `db:"id" json:"id"`
+}
+
+func (q *Queries) UpdateSplitTotalOwnership(ctx context.Context, arg UpdateSplitTotalOwnershipParams) error {
+	_, err := q.db.Exec(ctx, updateSplitTotalOwnership, arg.TotalOwnership, arg.ID)
+	return err
+}
+
 const updateUserEmailUnsubscriptions = `-- name: UpdateUserEmailUnsubscriptions :exec
 UPDATE users SET email_unsubscriptions = $2 WHERE id = $1
 `
@@ -2437,3 +2645,26 @@ func (q *Queries) UpsertSession(ctx context.Context, arg UpsertSessionParams) (S
 	)
 	return i, err
 }
+
+const upsertSplitRecipients = `-- name: UpsertSplitRecipients :exec
+insert into recipients (id, split_id, address, ownership, created_at, last_updated)
+    select unnest($1::varchar[]), $2::varchar, unnest($3::varchar[]), unnest($4::int[]), now(), now()
+on conflict (split_id, address) where deleted = false do update set ownership = excluded.ownership, last_updated = now()
+`
+
+type UpsertSplitRecipientsParams struct {
+	Ids        []string `db:"ids" json:"ids"`
+	SplitID    string   `db:"split_id" json:"split_id"`
+	Addresses  []string `db:"addresses" json:"addresses"`
+	Ownerships []int32  `db:"ownerships" json:"ownerships"`
+}
+
+func (q *Queries) UpsertSplitRecipients(ctx context.Context, arg UpsertSplitRecipientsParams) error {
+	_, err := q.db.Exec(ctx, upsertSplitRecipients,
+		arg.Ids,
+		arg.SplitID,
+		arg.Addresses,
+		arg.Ownerships,
+	)
+	return err
+}
@@ -8,10 +8,253 @@ package coredb
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/SplitFi/go-splitfi/service/persist"
 )
 
+const countMemberSplitsByUserID = `-- name: CountMemberSplitsByUserID :one
+select count(distinct r.split_id)
+    from users u, unnest(u.wallets) as a(wallet_id)
+        join wallets w on w.id = a.wallet_id
+        join recipients r on r.address = w.address
+        join splits s on s.id = r.split_id
+    where u.id = $1
+      and u.deleted = false
+      and w.deleted = false
+      and r.deleted = false
+      and s.deleted = false
+`
+
+func (q *Queries) CountMemberSplitsByUserID(ctx context.Context, userID persist.DBID) (int64, error) {
+	row := q.db.QueryRow(ctx, countMemberSplitsByUserID, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const getMemberSplitsByUserIDPaginate = `-- name: GetMemberSplitsByUserIDPaginate :many
+select s.id, s.version, s.last_updated, s.created_at, s.deleted, s.chain, s.l1_chain, s.address, s.name, s.description, s.creator_address, s.logo_url, s.banner_url, s.badge_url, s.total_ownership, m.ownership, m.addresses
+    from splits s
+        join (select r.split_id, sum(r.ownership)::int as ownership, array_agg(distinct r.address)::varchar[] as addresses
+                from recipients r
+                where r.deleted = false
+                  and r.id in (select mr.id
+                                 from users u, unnest(u.wallets) as a(wallet_id)
+                                     join wallets w on w.id = a.wallet_id
+                                     join recipients mr on mr.address = w.address
+                                 where u.id = $1
+                                   and u.deleted = false
+                                   and w.deleted = false)
+                group by r.split_id) m on m.split_id = s.id
+    where s.deleted = false
+      and (s.created_at, s.id) < ($2, $3)
+      and (s.created_at, s.id) > ($4, $5)
+    order by case when $6::bool then (s.created_at, s.id) end asc,
+             case when not $6::bool then (s.created_at, s.id) end desc
+    limit $7
+`
+
+type GetMemberSplitsByUserIDPaginateParams struct {
+	UserID        persist.DBID `db:"user_id" json:"user_id"`
+	CurBeforeTime time.Time    `db:"cur_before_time" json:"cur_before_time"`
+	CurBeforeID   persist.DBID `db:"cur_before_id" json:"cur_before_id"`
+	CurAfterTime  time.Time    `db:"cur_after_time" json:"cur_after_time"`
+	CurAfterID    persist.DBID `db:"cur_after_id" json:"cur_after_id"`
+	PagingForward bool         `db:"paging_forward" json:"paging_forward"`
+	Limit         int32        `db:"limit" json:"limit"`
+}
+
+type GetMemberSplitsByUserIDPaginateRow struct {
+	Split     Split    `db:"split" json:"split"`
+	Ownership int32    `db:"ownership" json:"ownership"`
+	Addresses []string `db:"addresses" json:"addresses"`
+}
+
+// ownership is the user's share summed across every wallet of theirs that is a recipient of the split
+func (q *Queries) GetMemberSplitsByUserIDPaginate(ctx context.Context, arg GetMemberSplitsByUserIDPaginateParams) ([]GetMemberSplitsByUserIDPaginateRow, error) {
+	rows, err := q.db.Query(ctx, getMemberSplitsByUserIDPaginate,
+		arg.UserID,
+		arg.CurBeforeTime,
+		arg.CurBeforeID,
+		arg.CurAfterTime,
+		arg.CurAfterID,
+		arg.PagingForward,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetMemberSplitsByUserIDPaginateRow
+	for rows.Next() {
+		var i GetMemberSplitsByUserIDPaginateRow
+		if err := rows.Scan(
+			&i.Split.ID,
+			&i.Split.Version,
+			&i.Split.LastUpdated,
+			&i.Split.CreatedAt,
+			&i.Split.Deleted,
+			&i.Split.Chain,
+			&i.Split.L1Chain,
+			&i.Split.Address,
+			&i.Split.Name,
+			&i.Split.Description,
+			&i.Split.CreatorAddress,
+			&i.Split.LogoUrl,
+			&i.Split.BannerUrl,
+			&i.Split.BadgeUrl,
+			&i.Split.TotalOwnership,
+			&i.Ownership,
+			&i.Addresses,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRecipientUserIDsBySplitID = `-- name: GetRecipientUserIDsBySplitID :many
+select distinct u.id from users u, unnest(u.wallets) as a(wallet_id)
+    join wallets w on w.id = a.wallet_id
+    join recipients r on r.address = w.address
+where r.split_id = $1 and r.deleted = false and w.deleted = false and u.deleted = false
+`
+
+func (q *Queries) GetRecipientUserIDsBySplitID(ctx context.Context, splitID persist.DBID) ([]persist.DBID, error) {
+	rows, err := q.db.Query(ctx, getRecipientUserIDsBySplitID, splitID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []persist.DBID
+	for rows.Next() {
+		var id persist.DBID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSplitsByPositionPaginate = `-- name: GetSplitsByPositionPaginate :many
+select s.id, s.version, s.last_updated, s.created_at, s.deleted, s.chain, s.l1_chain, s.address, s.name, s.description, s.creator_address, s.logo_url, s.banner_url, s.badge_url, s.total_ownership
+from splits s
+         join unnest($1::varchar[]) with ordinality t(id, pos) using(id)
+where not s.deleted and t.pos > $2::int and t.pos < $3::int
+order by t.pos asc
+`
+
+type GetSplitsByPositionPaginateParams struct {
+	SplitIds     []string `db:"split_ids" json:"split_ids"`
+	CurAfterPos  int32    `db:"cur_after_pos" json:"cur_after_pos"`
+	CurBeforePos int32    `db:"cur_before_pos" json:"cur_before_pos"`
+}
+
+func (q *Queries) GetSplitsByPositionPaginate(ctx context.Context, arg GetSplitsByPositionPaginateParams) ([]Split, error) {
+	rows, err := q.db.Query(ctx, getSplitsByPositionPaginate, arg.SplitIds, arg.CurAfterPos, arg.CurBeforePos)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Split
+	for rows.Next() {
+		var i Split
+		if err := rows.Scan(
+			&i.ID,
+			&i.Version,
+			&i.LastUpdated,
+			&i.CreatedAt,
+			&i.Deleted,
+			&i.Chain,
+			&i.L1Chain,
+			&i.Address,
+			&i.Name,
+			&i.Description,
+			&i.CreatorAddress,
+			&i.LogoUrl,
+			&i.BannerUrl,
+			&i.BadgeUrl,
+			&i.TotalOwnership,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSplitsWithUserSettingsByUserID = `-- name: GetSplitsWithUserSettingsByUserID :many
+select s.id, s.version, s.last_updated, s.created_at, s.deleted, s.chain, s.l1_chain, s.address, s.name, s.description, s.creator_address, s.logo_url, s.banner_url, s.badge_url, s.total_ownership, us.position, coalesce(us.hidden, false)::bool as hidden
+    from splits s
+        join (select distinct r.split_id
+                from users u, unnest(u.wallets) as a(wallet_id)
+                    join wallets w on w.id = a.wallet_id
+                    join recipients r on r.address = w.address
+                where u.id = $1
+                  and u.deleted = false
+                  and w.deleted = false
+                  and r.deleted = false) m on m.split_id = s.id
+        left join split_user_settings us on us.split_id = s.id and us.user_id = $1 and us.deleted = false
+    where s.deleted = false
+    order by us.position collate "C" nulls last, s.created_at, s.id
+`
+
+type GetSplitsWithUserSettingsByUserIDRow struct {
+	Split    Split          `db:"split" json:"split"`
+	Position sql.NullString `db:"position" json:"position"`
+	Hidden   bool           `db:"hidden" json:"hidden"`
+}
+
+func (q *Queries) GetSplitsWithUserSettingsByUserID(ctx context.Context, userID persist.DBID) ([]GetSplitsWithUserSettingsByUserIDRow, error) {
+	rows, err := q.db.Query(ctx, getSplitsWithUserSettingsByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetSplitsWithUserSettingsByUserIDRow
+	for rows.Next() {
+		var i GetSplitsWithUserSettingsByUserIDRow
+		if err := rows.Scan(
+			&i.Split.ID,
+			&i.Split.Version,
+			&i.Split.LastUpdated,
+			&i.Split.CreatedAt,
+			&i.Split.Deleted,
+			&i.Split.Chain,
+			&i.Split.L1Chain,
+			&i.Split.Address,
+			&i.Split.Name,
+			&i.Split.Description,
+			&i.Split.CreatorAddress,
+			&i.Split.LogoUrl,
+			&i.Split.BannerUrl,
+			&i.Split.BadgeUrl,
+			&i.Split.TotalOwnership,
+			&i.Position,
+			&i.Hidden,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const splitRepoCreate = `-- name: SplitRepoCreate :one
 insert into splits (id, chain, address, name, description, creator_address, logo_url, banner_url, badge_url, total_ownership) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) returning id, version, last_updated, created_at, deleted, chain, l1_chain, address, name, description, creator_address, logo_url, banner_url, badge_url, total_ownership
 `
@@ -63,6 +306,18 @@ func (q *Queries) SplitRepoCreate(ctx context.Context, arg SplitRepoCreateParams
 	return i, err
 }
 
+const splitRepoDelete = `-- name: SplitRepoDelete :execrows
+update splits set deleted = true, last_updated = now() where splits.id = $1 and splits.deleted = false
+`
+
+func (q *Queries) SplitRepoDelete(ctx context.Context, splitID persist.DBID) (int64, error) {
+	result, err := q.db.Exec(ctx, splitRepoDelete, splitID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const splitRepoUpdate = `-- name: SplitRepoUpdate :execrows
 update splits set last_updated = now() where splits.id = $1
 `
@@ -74,3 +329,49 @@ func (q *Queries) SplitRepoUpdate(ctx context.Context, splitID persist.DBID) (in
 	}
 	return result.RowsAffected(), nil
 }
+
+const upsertSplitUserHidden = `-- name: UpsertSplitUserHidden :exec
+insert into split_user_settings (id, user_id, split_id, hidden, created_at, last_updated)
+values ($1, $2, $3, $4, now(), now())
+on conflict (user_id, split_id) where deleted = false do update set hidden = excluded.hidden, last_updated = now()
+`
+
+type UpsertSplitUserHiddenParams struct {
+	ID      persist.DBID `db:"id" json:"id"`
+	UserID  persist.DBID `db:"user_id" json:"user_id"`
+	SplitID persist.DBID `db:"split_id" json:"split_id"`
+	Hidden  bool         `db:"hidden" json:"hidden"`
+}
+
+func (q *Queries) UpsertSplitUserHidden(ctx context.Context, arg UpsertSplitUserHiddenParams) error {
+	_, err := q.db.Exec(ctx, upsertSplitUserHidden,
+		arg.ID,
+		arg.UserID,
+		arg.SplitID,
+		arg.Hidden,
+	)
+	return err
+}
+
+const upsertSplitUserPositions = `-- name: UpsertSplitUserPositions :exec
+insert into split_user_settings (id, user_id, split_id, position, created_at, last_updated)
+    select unnest($1::varchar[]), $2::varchar, unnest($3::varchar[]), unnest($4::varchar[]), now(), now()
+on conflict (user_id, split_id) where deleted = false do update set position = excluded.position, last_updated = now()
+`
+
+type UpsertSplitUserPositionsParams struct {
+	Ids       []string `db:"ids" json:"ids"`
+	UserID    string   `db:"user_id" json:"user_id"`
+	SplitIds  []string `db:"split_ids" json:"split_ids"`
+	Positions []string `db:"positions" json:"positions"`
+}
+
+func (q *Queries) UpsertSplitUserPositions(ctx context.Context, arg UpsertSplitUserPositionsParams) error {
+	_, err := q.db.Exec(ctx, upsertSplitUserPositions,
+		arg.Ids,
+		arg.UserID,
+		arg.SplitIds,
+		arg.Positions,
+	)
+	return err
+}
@@ -7,15 +7,142 @@ package coredb
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/SplitFi/go-splitfi/service/persist"
 )
 
+const getTokenByOwnerAndTokenChainAddress = `-- name: GetTokenByOwnerAndTokenChainAddress :one
+SELECT id, deleted, version, created_at, last_updated, chain, token_address, owner_address, balance FROM tokens WHERE owner_address = $1 AND token_address = $2 AND chain = $3 AND deleted = false
+`
+
+type GetTokenByOwnerAndTokenChainAddressParams struct {
+	OwnerAddress persist.Address `db:"owner_address" json:"owner_address"`
+	TokenAddress persist.Address `db:"token_address" json:"token_address"`
+	Chain        persist.Chain   `db:"chain" json:"chain"`
+}
+
+func (q *Queries) GetTokenByOwnerAndTokenChainAddress(ctx context.Context, arg GetTokenByOwnerAndTokenChainAddressParams) (Token, error) {
+	row := q.db.QueryRow(ctx, getTokenByOwnerAndTokenChainAddress, arg.OwnerAddress, arg.TokenAddress, arg.Chain)
+	var i Token
+	err := row.Scan(
+		&i.ID,
+		&i.Deleted,
+		&i.Version,
+		&i.CreatedAt,
+		&i.LastUpdated,
+		&i.Chain,
+		&i.TokenAddress,
+		&i.OwnerAddress,
+		&i.Balance,
+	)
+	return i, err
+}
+
+const getTokensByOwnerAddressAndChain = `-- name: GetTokensByOwnerAddressAndChain :many
+SELECT id, deleted, version, created_at, last_updated, chain, token_address, owner_address, balance FROM tokens WHERE owner_address = $1 AND chain = $2 AND deleted = false ORDER BY token_address
+`
+
+type GetTokensByOwnerAddressAndChainParams struct {
+	OwnerAddress persist.Address `db:"owner_address" json:"owner_address"`
+	Chain        persist.Chain   `db:"chain" json:"chain"`
+}
+
+func (q *Queries) GetTokensByOwnerAddressAndChain(ctx context.Context, arg GetTokensByOwnerAddressAndChainParams) ([]Token, error) {
+	rows, err := q.db.Query(ctx, getTokensByOwnerAddressAndChain, arg.OwnerAddress, arg.Chain)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Token
+	for rows.Next() {
+		var i Token
+		if err := rows.Scan(
+			&i.ID,
+			&i.Deleted,
+			&i.Version,
+			&i.CreatedAt,
+			&i.LastUpdated,
+			&i.Chain,
+			&i.TokenAddress,
+			&i.OwnerAddress,
+			&i.Balance,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTokensWithMetadataByOwnerAddressAndChain = `-- name: GetTokensWithMetadataByOwnerAddressAndChain :many
+SELECT tokens.id, tokens.deleted, tokens.version, tokens.created_at, tokens.last_updated, tokens.chain, tokens.token_address, tokens.owner_address, tokens.balance, m.symbol, m.name, m.logo, COALESCE(m.is_spam, false)::bool AS is_spam, p.decimals, p.usd_price::float8 AS usd_price
+FROM tokens
+         LEFT JOIN token_metadatas m ON m.chain = tokens.chain AND m.contract_address = tokens.token_address AND NOT m.deleted
+         LEFT JOIN token_prices p ON p.chain = tokens.chain AND p.token_address = tokens.token_address AND NOT p.deleted
+WHERE tokens.owner_address = $1 AND tokens.chain = $2 AND NOT tokens.deleted
+`
+
+type GetTokensWithMetadataByOwnerAddressAndChainParams struct {
+	OwnerAddress persist.Address `db:"owner_address" json:"owner_address"`
+	Chain        persist.Chain   `db:"chain" json:"chain"`
+}
+
+type GetTokensWithMetadataByOwnerAddressAndChainRow struct {
+	Token    Token           `db:"token" json:"token"`
+	Symbol   sql.NullString  `db:"symbol" json:"symbol"`
+	Name     sql.NullString  `db:"name" json:"name"`
+	Logo     sql.NullString  `db:"logo" json:"logo"`
+	IsSpam   bool            `db:"is_spam" json:"is_spam"`
+	Decimals sql.NullInt32   `db:"decimals" json:"decimals"`
+	UsdPrice sql.NullFloat64 `db:"usd_price" json:"usd_price"`
+}
+
+// tokens without metadata aren't spam, and tokens without a price have no decimals or price
+func (q *Queries) GetTokensWithMetadataByOwnerAddressAndChain(ctx context.Context, arg GetTokensWithMetadataByOwnerAddressAndChainParams) ([]GetTokensWithMetadataByOwnerAddressAndChainRow, error) {
+	rows, err := q.db.Query(ctx, getTokensWithMetadataByOwnerAddressAndChain, arg.OwnerAddress, arg.Chain)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTokensWithMetadataByOwnerAddressAndChainRow
+	for rows.Next() {
+		var i GetTokensWithMetadataByOwnerAddressAndChainRow
+		if err := rows.Scan(
+			&i.Token.ID,
+			&i.Token.Deleted,
+			&i.Token.Version,
+			&i.Token.CreatedAt,
+			&i.Token.LastUpdated,
+			&i.Token.Chain,
+			&i.Token.TokenAddress,
+			&i.Token.OwnerAddress,
+			&i.Token.Balance,
+			&i.Symbol,
+			&i.Name,
+			&i.Logo,
+			&i.IsSpam,
+			&i.Decimals,
+			&i.UsdPrice,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const upsertTokenMetadatas = `-- name: UpsertTokenMetadatas :many
 WITH token_metadatas_insert AS (
     INSERT INTO token_metadatas
         (
-         id, created_at, last_updated, deleted, name, symbol, chain, logo, thumbnail, contract_address
+         id, created_at, last_updated, deleted, name, symbol, chain, logo, thumbnail, contract_address, is_spam
             ) (SELECT UNNEST($1::varchar[])             AS id
                     , NOW()
                     , NOW()
@@ -25,7 +152,8 @@ WITH token_metadatas_insert AS (
                     , UNNEST($4::chain[])              AS chain
                     , UNNEST($5::varchar[])             AS logo
                     , UNNEST($6::varchar[])        AS thumbnail
-                    , UNNEST($7::address[]) AS contract_address)
+                    , UNNEST($7::address[]) AS contract_address
+                    , UNNEST($8::bool[])             AS is_spam)
         ON CONFLICT (chain, contract_address) WHERE deleted = FALSE
             DO UPDATE SET
                 last_updated = excluded.last_updated
@@ -33,8 +161,9 @@ WITH token_metadatas_insert AS (
                 , symbol = COALESCE(NULLIF(excluded.symbol, ''), NULLIF(token_metadatas.symbol, ''))
                 , logo = COALESCE(NULLIF(excluded.logo, ''), NULLIF(token_metadatas.logo, ''))
                 , thumbnail = COALESCE(NULLIF(excluded.thumbnail, ''), NULLIF(token_metadatas.thumbnail, ''))
-        RETURNING id, deleted, created_at, last_updated, symbol, name, logo, thumbnail, chain, contract_address)
-SELECT token_metadatas.id, token_metadatas.deleted, token_metadatas.created_at, token_metadatas.last_updated, token_metadatas.symbol, token_metadatas.name, token_metadatas.logo, token_metadatas.thumbnail, token_metadatas.chain, token_metadatas.contract_address, (prior_state.id IS NULL)::bool is_new_metadata
+                , is_spam = excluded.is_spam
+        RETURNING id, deleted, created_at, last_updated, symbol, name, logo, thumbnail, chain, contract_address, is_spam)
+SELECT token_metadatas.id, token_metadatas.deleted, token_metadatas.created_at, token_metadatas.last_updated, token_metadatas.symbol, token_metadatas.name, token_metadatas.logo, token_metadatas.thumbnail, token_metadatas.chain, token_metadatas.contract_address, token_metadatas.is_spam, (prior_state.id IS NULL)::bool is_new_metadata
 FROM token_metadatas_insert token_metadatas
          LEFT JOIN token_metadatas prior_state ON token_metadatas.chain = prior_state.chain AND
                                                   token_metadatas.contract_address = prior_state.contract_address AND
@@ -49,6 +178,7 @@ type UpsertTokenMetadatasParams struct {
 	Logo            []string          `db:"logo" json:"logo"`
 	Thumbnail       []string          `db:"thumbnail" json:"thumbnail"`
 	ContractAddress []persist.Address `db:"contract_address" json:"contract_address"`
+	IsSpam          []bool            `db:"is_spam" json:"is_spam"`
 }
 
 type UpsertTokenMetadatasRow struct {
@@ -66,6 +196,7 @@ func (q *Queries) UpsertTokenMetadatas(ctx context.Context, arg UpsertTokenMetad
 		arg.Logo,
 		arg.Thumbnail,
 		arg.ContractAddress,
+		arg.IsSpam,
 	)
 	if err != nil {
 		return nil, err
@@ -85,6 +216,7 @@ func (q *Queries) UpsertTokenMetadatas(ctx context.Context, arg UpsertTokenMetad
 			&i.TokenMetadata.Thumbnail,
 			&i.TokenMetadata.Chain,
 			&i.TokenMetadata.ContractAddress,
+			&i.TokenMetadata.IsSpam,
 			&i.IsNewMetadata,
 		); err != nil {
 			return nil, err
@@ -122,7 +254,7 @@ WITH tokens_insert AS (
                 balance = excluded.quantity
                 , version = excluded.version
                 , last_updated = excluded.last_updated RETURNING id, deleted, version, created_at, last_updated, chain, token_address, owner_address, balance)
-SELECT tokens.id, tokens.deleted, tokens.version, tokens.created_at, tokens.last_updated, tokens.chain, tokens.token_address, tokens.owner_address, tokens.balance, token_metadatas.id, token_metadatas.deleted, token_metadatas.created_at, token_metadatas.last_updated, token_metadatas.symbol, token_metadatas.name, token_metadatas.logo, token_metadatas.thumbnail, token_metadatas.chain, token_metadatas.contract_address
+SELECT tokens.id, tokens.deleted, tokens.version, tokens.created_at, tokens.last_updated, tokens.chain, tokens.token_address, tokens.owner_address, tokens.balance, token_metadatas.id, token_metadatas.deleted, token_metadatas.created_at, token_metadatas.last_updated, token_metadatas.symbol, token_metadatas.name, token_metadatas.logo, token_metadatas.thumbnail, token_metadatas.chain, token_metadatas.contract_address, token_metadatas.is_spam
 FROM tokens_insert tokens
          JOIN token_metadatas
               ON tokens.token_address = token_metadatas.contract_address AND tokens.chain = token_metadatas.chain AND
@@ -185,6 +317,7 @@ func (q *Queries) UpsertTokens(ctx context.Context, arg UpsertTokensParams) ([]U
 			&i.TokenMetadata.Thumbnail,
 			&i.TokenMetadata.Chain,
 			&i.TokenMetadata.ContractAddress,
+			&i.TokenMetadata.IsSpam,
 		); err != nil {
 			return nil, err
 		}
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS recipients_split_id_address_idx;
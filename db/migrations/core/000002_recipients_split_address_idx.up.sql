@@ -0,0 +1 @@
+CREATE UNIQUE INDEX IF NOT EXISTS recipients_split_id_address_idx ON recipients (split_id, address) WHERE deleted = false;
@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS split_ledger_entries
+(
+    id                character varying(255) PRIMARY KEY,
+    version           integer                  NOT NULL DEFAULT 0,
+    created_at        timestamp WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_updated      timestamp WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted           boolean                  NOT NULL DEFAULT FALSE,
+    split_id          character varying(255)   NOT NULL REFERENCES splits ON DELETE CASCADE,
+    entry_type        character varying(32)    NOT NULL,
+    chain             integer                  NOT NULL,
+    token_address     character varying(255)   NOT NULL,
+    recipient_address character varying(255)   NOT NULL,
+    amount            character varying(255)   NOT NULL,
+    split_balance     character varying(255)   NOT NULL,
+    tx_hash           character varying(255)   NOT NULL,
+    block_number      bigint                   NOT NULL DEFAULT 0
+);
+
+-- Transfers can be delivered more than once, so entries are unique per transaction
+CREATE UNIQUE INDEX IF NOT EXISTS split_ledger_entries_tx_hash_idx ON split_ledger_entries (tx_hash, split_id, token_address, recipient_address, entry_type) WHERE deleted = false;
+
+CREATE INDEX IF NOT EXISTS split_ledger_entries_split_id_created_at_idx ON split_ledger_entries (split_id, created_at, id) WHERE deleted = false;
+
+CREATE INDEX IF NOT EXISTS split_ledger_entries_recipient_address_created_at_idx ON split_ledger_entries (recipient_address, created_at, id) WHERE deleted = false;
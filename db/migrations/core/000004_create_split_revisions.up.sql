@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS split_revisions
+(
+    id              character varying(255) PRIMARY KEY,
+    version         integer                  NOT NULL DEFAULT 0,
+    created_at      timestamp WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_updated    timestamp WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted         boolean                  NOT NULL DEFAULT FALSE,
+    split_id        character varying(255)   NOT NULL REFERENCES splits ON DELETE CASCADE,
+    revision        integer                  NOT NULL,
+    actor_id        character varying(255),
+    source          character varying(32)    NOT NULL,
+    name            character varying        NOT NULL DEFAULT ''::character varying,
+    description     character varying        NOT NULL DEFAULT ''::character varying,
+    logo_url        character varying,
+    banner_url      character varying,
+    badge_url       character varying,
+    total_ownership integer                  NOT NULL,
+    recipients      jsonb                    NOT NULL DEFAULT '[]'::jsonb
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS split_revisions_split_id_revision_idx ON split_revisions (split_id, revision) WHERE deleted = false;
+
+CREATE INDEX IF NOT EXISTS split_revisions_split_id_created_at_idx ON split_revisions (split_id, created_at, id) WHERE deleted = false;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS split_drafts;
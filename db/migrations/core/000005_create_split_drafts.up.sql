@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS split_drafts
+(
+    id           character varying(255) PRIMARY KEY,
+    version      integer                  NOT NULL DEFAULT 0,
+    created_at   timestamp WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_updated timestamp WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted      boolean                  NOT NULL DEFAULT FALSE,
+    split_id     character varying(255)   NOT NULL REFERENCES splits ON DELETE CASCADE,
+    edit_id      character varying(255)   NOT NULL,
+    owner_id     character varying(255)   NOT NULL REFERENCES users ON DELETE CASCADE,
+    name         character varying,
+    description  character varying,
+    expires_at   timestamp WITH TIME ZONE NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS split_drafts_split_id_edit_id_idx ON split_drafts (split_id, edit_id) WHERE deleted = false;
+
+CREATE INDEX IF NOT EXISTS split_drafts_expires_at_idx ON split_drafts (expires_at) WHERE deleted = false;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS split_template_recipients;
+DROP TABLE IF EXISTS split_templates;
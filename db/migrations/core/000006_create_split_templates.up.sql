@@ -0,0 +1,31 @@
+CREATE TABLE IF NOT EXISTS split_templates
+(
+    id              character varying(255) PRIMARY KEY,
+    version         integer                  NOT NULL DEFAULT 0,
+    created_at      timestamp WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_updated    timestamp WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted         boolean                  NOT NULL DEFAULT FALSE,
+    owner_id        character varying(255)   NOT NULL REFERENCES users ON DELETE CASCADE,
+    name            character varying        NOT NULL DEFAULT ''::character varying,
+    description     character varying        NOT NULL DEFAULT ''::character varying,
+    logo_url        character varying,
+    banner_url      character varying,
+    badge_url       character varying,
+    total_ownership integer                  NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS split_templates_owner_id_idx ON split_templates (owner_id) WHERE deleted = false;
+
+CREATE TABLE IF NOT EXISTS split_template_recipients
+(
+    id           character varying(255) PRIMARY KEY,
+    version      integer                  NOT NULL DEFAULT 0,
+    created_at   timestamp WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_updated timestamp WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted      boolean                  NOT NULL DEFAULT FALSE,
+    template_id  character varying(255)   NOT NULL REFERENCES split_templates ON DELETE CASCADE,
+    address      character varying(255)   NOT NULL,
+    ownership    integer                  NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS split_template_recipients_template_id_address_idx ON split_template_recipients (template_id, address) WHERE deleted = false;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS split_deletion_approvals;
+DROP TABLE IF EXISTS split_deletion_requests;
@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS split_deletion_requests
+(
+    id           character varying(255) PRIMARY KEY,
+    version      integer                  NOT NULL DEFAULT 0,
+    created_at   timestamp WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_updated timestamp WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted      boolean                  NOT NULL DEFAULT FALSE,
+    split_id     character varying(255)   NOT NULL REFERENCES splits ON DELETE CASCADE,
+    requester_id character varying(255)   NOT NULL REFERENCES users ON DELETE CASCADE,
+    quorum       integer                  NOT NULL,
+    completed_at timestamp WITH TIME ZONE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS split_deletion_requests_pending_split_id_idx ON split_deletion_requests (split_id) WHERE deleted = false AND completed_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS split_deletion_approvals
+(
+    id           character varying(255) PRIMARY KEY,
+    version      integer                  NOT NULL DEFAULT 0,
+    created_at   timestamp WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_updated timestamp WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted      boolean                  NOT NULL DEFAULT FALSE,
+    request_id   character varying(255)   NOT NULL REFERENCES split_deletion_requests ON DELETE CASCADE,
+    approver_id  character varying(255)   NOT NULL REFERENCES users ON DELETE CASCADE,
+    address      character varying(255)   NOT NULL,
+    ownership    integer                  NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS split_deletion_approvals_request_id_address_idx ON split_deletion_approvals (request_id, address) WHERE deleted = false;
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS split_user_settings
+(
+    id           character varying(255) PRIMARY KEY,
+    version      integer                  NOT NULL DEFAULT 0,
+    created_at   timestamp WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_updated timestamp WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted      boolean                  NOT NULL DEFAULT FALSE,
+    user_id      character varying(255)   NOT NULL REFERENCES users ON DELETE CASCADE,
+    split_id     character varying(255)   NOT NULL REFERENCES splits ON DELETE CASCADE,
+    position     character varying(255),
+    hidden       boolean                  NOT NULL DEFAULT FALSE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS split_user_settings_user_id_split_id_idx ON split_user_settings (user_id, split_id) WHERE deleted = false;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS recipients_address_idx;
+DROP MATERIALIZED VIEW IF EXISTS user_relevance;
+DROP MATERIALIZED VIEW IF EXISTS split_relevance;
@@ -0,0 +1,44 @@
+-- Relevance scores used to rank search results. Entities without a score yet (e.g. created since the last refresh)
+-- fall back to the row with a null id, which holds the lowest possible score.
+CREATE MATERIALIZED VIEW IF NOT EXISTS split_relevance AS
+(
+WITH scores AS (SELECT s.id,
+                       (SELECT COUNT(*)
+                        FROM recipients r
+                        WHERE r.split_id = s.id
+                          AND r.deleted = FALSE) +
+                       (SELECT COUNT(DISTINCT e.actor_id)
+                        FROM events e
+                        WHERE e.split_id = s.id
+                          AND e.action = 'ViewedSplit'
+                          AND e.deleted = FALSE
+                          AND e.created_at > NOW() - INTERVAL '30 days') AS score
+                FROM splits s
+                WHERE s.deleted = FALSE)
+SELECT id, LN(2 + score)::float8 AS score
+FROM scores
+UNION ALL
+SELECT NULL AS id, LN(2)::float8 AS score
+    );
+
+CREATE UNIQUE INDEX IF NOT EXISTS split_relevance_id_idx ON split_relevance (id);
+
+CREATE MATERIALIZED VIEW IF NOT EXISTS user_relevance AS
+(
+WITH scores AS (SELECT u.id, COUNT(DISTINCT r.split_id) AS score
+                FROM users u
+                         JOIN wallets w ON w.id = ANY (u.wallets) AND w.deleted = FALSE
+                         JOIN recipients r ON r.address = w.address AND r.deleted = FALSE
+                         JOIN splits s ON s.id = r.split_id AND s.deleted = FALSE
+                WHERE u.deleted = FALSE
+                  AND u.universal = FALSE
+                GROUP BY u.id)
+SELECT id, LN(2 + score)::float8 AS score
+FROM scores
+UNION ALL
+SELECT NULL AS id, LN(2)::float8 AS score
+    );
+
+CREATE UNIQUE INDEX IF NOT EXISTS user_relevance_id_idx ON user_relevance (id);
+
+CREATE INDEX IF NOT EXISTS recipients_address_idx ON recipients (address) WHERE deleted = FALSE;
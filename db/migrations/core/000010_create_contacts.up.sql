@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS contacts
+(
+    id           character varying(255) PRIMARY KEY,
+    version      integer                  NOT NULL DEFAULT 0,
+    created_at   timestamp WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_updated timestamp WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted      boolean                  NOT NULL DEFAULT FALSE,
+    owner_id     character varying(255)   NOT NULL REFERENCES users ON DELETE CASCADE,
+    chain        integer                  NOT NULL,
+    address      character varying(255)   NOT NULL,
+    label        character varying        NOT NULL,
+    notes        character varying        NOT NULL DEFAULT ''::character varying
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS contacts_owner_id_chain_address_idx ON contacts (owner_id, chain, address) WHERE deleted = false;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS split_inflow_rollups;
+DROP TABLE IF EXISTS split_inflows;
+DROP TABLE IF EXISTS token_prices;
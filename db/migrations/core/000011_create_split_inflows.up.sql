@@ -0,0 +1,50 @@
+CREATE TABLE IF NOT EXISTS token_prices
+(
+    id            character varying(255) PRIMARY KEY,
+    version       integer                  NOT NULL DEFAULT 0,
+    created_at    timestamp WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_updated  timestamp WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted       boolean                  NOT NULL DEFAULT FALSE,
+    chain         integer                  NOT NULL,
+    token_address character varying(255)   NOT NULL,
+    decimals      integer                  NOT NULL,
+    usd_price     numeric                  NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS token_prices_chain_token_address_idx ON token_prices (chain, token_address) WHERE deleted = false;
+
+-- Every transfer into a split, valued in USD at the token's price when the transfer was processed
+CREATE TABLE IF NOT EXISTS split_inflows
+(
+    id            character varying(255) PRIMARY KEY,
+    version       integer                  NOT NULL DEFAULT 0,
+    created_at    timestamp WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_updated  timestamp WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted       boolean                  NOT NULL DEFAULT FALSE,
+    split_id      character varying(255)   NOT NULL REFERENCES splits ON DELETE CASCADE,
+    chain         integer                  NOT NULL,
+    token_address character varying(255)   NOT NULL,
+    payer_address character varying(255)   NOT NULL,
+    amount        numeric(78, 0)           NOT NULL,
+    usd_value     numeric,
+    tx_hash       character varying(255)   NOT NULL,
+    block_number  bigint                   NOT NULL DEFAULT 0
+);
+
+-- Transfers can be delivered more than once, so inflows are unique per transaction
+CREATE UNIQUE INDEX IF NOT EXISTS split_inflows_tx_hash_idx ON split_inflows (tx_hash, split_id, token_address, payer_address) WHERE deleted = false;
+
+-- Daily totals of split_inflows, which analytics are served from
+CREATE TABLE IF NOT EXISTS split_inflow_rollups
+(
+    split_id      character varying(255)   NOT NULL REFERENCES splits ON DELETE CASCADE,
+    day           date                     NOT NULL,
+    chain         integer                  NOT NULL,
+    token_address character varying(255)   NOT NULL,
+    payer_address character varying(255)   NOT NULL,
+    amount        numeric(78, 0)           NOT NULL DEFAULT 0,
+    usd_value     numeric                  NOT NULL DEFAULT 0,
+    inflow_count  integer                  NOT NULL DEFAULT 0,
+    last_updated  timestamp WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (split_id, day, chain, token_address, payer_address)
+);
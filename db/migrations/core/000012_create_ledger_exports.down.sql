@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS split_inflows_split_id_created_at_idx;
+DROP TABLE IF EXISTS ledger_exports;
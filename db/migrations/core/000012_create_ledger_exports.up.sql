@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS ledger_exports
+(
+    id                character varying(255) PRIMARY KEY,
+    version           integer                  NOT NULL DEFAULT 0,
+    created_at        timestamp WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_updated      timestamp WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted           boolean                  NOT NULL DEFAULT FALSE,
+    requester_id      character varying(255)   NOT NULL REFERENCES users ON DELETE CASCADE,
+    -- exactly one of split_id and recipient_address is set
+    split_id          character varying(255) REFERENCES splits ON DELETE CASCADE,
+    recipient_address character varying(255),
+    format            character varying(32)    NOT NULL,
+    from_time         timestamp WITH TIME ZONE NOT NULL,
+    to_time           timestamp WITH TIME ZONE NOT NULL,
+    status            character varying(32)    NOT NULL DEFAULT 'pending',
+    object_name       character varying(255),
+    row_count         integer                  NOT NULL DEFAULT 0,
+    error             character varying,
+    completed_at      timestamp WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS ledger_exports_requester_id_idx ON ledger_exports (requester_id, created_at DESC) WHERE deleted = false;
+
+CREATE INDEX IF NOT EXISTS split_inflows_split_id_created_at_idx ON split_inflows (split_id, created_at) WHERE deleted = false;
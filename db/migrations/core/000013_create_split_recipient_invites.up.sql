@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS split_recipient_invites
+(
+    id            character varying(255) PRIMARY KEY,
+    version       integer                  NOT NULL DEFAULT 0,
+    created_at    timestamp WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_updated  timestamp WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted       boolean                  NOT NULL DEFAULT FALSE,
+    split_id      character varying(255)   NOT NULL REFERENCES splits ON DELETE CASCADE,
+    recipient_id  character varying(255)   NOT NULL REFERENCES recipients ON DELETE CASCADE,
+    inviter_id    character varying(255)   NOT NULL REFERENCES users ON DELETE CASCADE,
+    email_address character varying(255)   NOT NULL,
+    status        character varying(32)    NOT NULL DEFAULT 'pending',
+    invitee_id    character varying(255) REFERENCES users ON DELETE SET NULL,
+    last_sent_at  timestamp WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    accepted_at   timestamp WITH TIME ZONE
+);
+
+-- A recipient has at most one invite waiting to be accepted
+CREATE UNIQUE INDEX IF NOT EXISTS split_recipient_invites_pending_idx ON split_recipient_invites (recipient_id) WHERE status = 'pending' AND deleted = false;
+
+CREATE INDEX IF NOT EXISTS split_recipient_invites_recipient_id_idx ON split_recipient_invites (recipient_id, created_at DESC) WHERE deleted = false;
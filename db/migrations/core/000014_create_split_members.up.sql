@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS split_members
+(
+    id           character varying(255) PRIMARY KEY,
+    version      integer                  NOT NULL DEFAULT 0,
+    created_at   timestamp WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_updated timestamp WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted      boolean                  NOT NULL DEFAULT FALSE,
+    split_id     character varying(255)   NOT NULL REFERENCES splits ON DELETE CASCADE,
+    user_id      character varying(255)   NOT NULL REFERENCES users ON DELETE CASCADE,
+    role         character varying(32)    NOT NULL,
+    granted_by   character varying(255) REFERENCES users ON DELETE SET NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS split_members_split_id_user_id_idx ON split_members (split_id, user_id) WHERE deleted = false;
+
+CREATE INDEX IF NOT EXISTS split_members_user_id_idx ON split_members (user_id) WHERE deleted = false;
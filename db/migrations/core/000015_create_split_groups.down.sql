@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS split_group_members;
+DROP TABLE IF EXISTS split_groups;
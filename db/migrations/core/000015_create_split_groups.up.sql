@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS split_groups
+(
+    id           character varying(255) PRIMARY KEY,
+    version      integer                  NOT NULL DEFAULT 0,
+    created_at   timestamp WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_updated timestamp WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted      boolean                  NOT NULL DEFAULT FALSE,
+    l1_chain     integer                  NOT NULL,
+    name         character varying        NOT NULL DEFAULT '',
+    description  character varying        NOT NULL DEFAULT '',
+    creator_id   character varying(255) REFERENCES users ON DELETE SET NULL
+);
+
+CREATE TABLE IF NOT EXISTS split_group_members
+(
+    id           character varying(255) PRIMARY KEY,
+    version      integer                  NOT NULL DEFAULT 0,
+    created_at   timestamp WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_updated timestamp WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted      boolean                  NOT NULL DEFAULT FALSE,
+    group_id     character varying(255)   NOT NULL REFERENCES split_groups ON DELETE CASCADE,
+    split_id     character varying(255)   NOT NULL REFERENCES splits ON DELETE CASCADE,
+    chain        integer                  NOT NULL
+);
+
+-- A split belongs to at most one group, and a group has at most one split per chain
+CREATE UNIQUE INDEX IF NOT EXISTS split_group_members_split_id_idx ON split_group_members (split_id) WHERE deleted = false;
+
+CREATE UNIQUE INDEX IF NOT EXISTS split_group_members_group_id_chain_idx ON split_group_members (group_id, chain) WHERE deleted = false;
@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS split_media
+(
+    id             character varying(255) PRIMARY KEY,
+    version        integer                  NOT NULL DEFAULT 0,
+    created_at     timestamp WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_updated   timestamp WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted        boolean                  NOT NULL DEFAULT FALSE,
+    split_id       character varying(255)   NOT NULL REFERENCES splits ON DELETE CASCADE,
+    kind           character varying(16)    NOT NULL,
+    uploader_id    character varying(255)   NOT NULL REFERENCES users ON DELETE CASCADE,
+    content_type   character varying(64)    NOT NULL,
+    content_length bigint                   NOT NULL,
+    status         character varying(32)    NOT NULL DEFAULT 'pending',
+    error          character varying,
+    width          integer,
+    height         integer,
+    blurhash       character varying,
+    aspect_ratio   double precision,
+    small_url      character varying,
+    medium_url     character varying,
+    large_url      character varying,
+    completed_at   timestamp WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS split_media_split_id_kind_idx ON split_media (split_id, kind, completed_at DESC) WHERE status = 'complete' AND deleted = false;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS notifications_owner_id_split_id_action_idx;
+
+DROP TABLE IF EXISTS split_follows;
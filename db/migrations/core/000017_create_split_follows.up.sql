@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS split_follows
+(
+    id           character varying(255) PRIMARY KEY,
+    version      integer                  NOT NULL DEFAULT 0,
+    created_at   timestamp WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_updated timestamp WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted      boolean                  NOT NULL DEFAULT FALSE,
+    split_id     character varying(255)   NOT NULL REFERENCES splits ON DELETE CASCADE,
+    user_id      character varying(255)   NOT NULL REFERENCES users ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS split_follows_split_id_user_id_idx ON split_follows (split_id, user_id) WHERE deleted = false;
+
+CREATE INDEX IF NOT EXISTS split_follows_user_id_created_at_idx ON split_follows (user_id, created_at, id) WHERE deleted = false;
+
+CREATE INDEX IF NOT EXISTS notifications_owner_id_split_id_action_idx ON notifications (owner_id, split_id, action, created_at DESC) WHERE deleted = false;
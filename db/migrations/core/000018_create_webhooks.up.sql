@@ -0,0 +1,43 @@
+CREATE TABLE IF NOT EXISTS webhooks
+(
+    id           character varying(255) PRIMARY KEY,
+    version      integer                  NOT NULL DEFAULT 0,
+    created_at   timestamp WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_updated timestamp WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted      boolean                  NOT NULL DEFAULT FALSE,
+    owner_id     character varying(255)   NOT NULL REFERENCES users ON DELETE CASCADE,
+    -- webhooks without a split receive events for every split their owner follows or is a member of
+    split_id     character varying(255) REFERENCES splits ON DELETE CASCADE,
+    url          character varying        NOT NULL,
+    secret       character varying(255)   NOT NULL,
+    event_types  character varying(64)[]  NOT NULL,
+    enabled      boolean                  NOT NULL DEFAULT TRUE
+);
+
+CREATE INDEX IF NOT EXISTS webhooks_owner_id_idx ON webhooks (owner_id, created_at) WHERE deleted = false;
+
+CREATE INDEX IF NOT EXISTS webhooks_split_id_idx ON webhooks (split_id) WHERE deleted = false;
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries
+(
+    id              character varying(255) PRIMARY KEY,
+    version         integer                  NOT NULL DEFAULT 0,
+    created_at      timestamp WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_updated    timestamp WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted         boolean                  NOT NULL DEFAULT FALSE,
+    webhook_id      character varying(255)   NOT NULL REFERENCES webhooks ON DELETE CASCADE,
+    -- null for test pings
+    event_id        character varying(255),
+    event_type      character varying(64)    NOT NULL,
+    payload         jsonb                    NOT NULL,
+    status          character varying(32)    NOT NULL DEFAULT 'pending',
+    attempts        integer                  NOT NULL DEFAULT 0,
+    response_status integer,
+    error           character varying,
+    next_attempt_at timestamp WITH TIME ZONE,
+    completed_at    timestamp WITH TIME ZONE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS webhook_deliveries_webhook_id_event_id_idx ON webhook_deliveries (webhook_id, event_id) WHERE event_id IS NOT NULL AND deleted = false;
+
+CREATE INDEX IF NOT EXISTS webhook_deliveries_webhook_id_created_at_idx ON webhook_deliveries (webhook_id, created_at, id) WHERE deleted = false;
@@ -0,0 +1 @@
+ALTER TABLE token_metadatas DROP COLUMN IF EXISTS is_spam;
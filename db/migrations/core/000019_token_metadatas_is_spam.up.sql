@@ -0,0 +1,2 @@
+-- Tokens flagged as spam by the provider their metadata was fetched from
+ALTER TABLE token_metadatas ADD COLUMN IF NOT EXISTS is_spam boolean NOT NULL DEFAULT false;
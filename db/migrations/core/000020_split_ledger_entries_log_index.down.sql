@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS split_ledger_entries_tx_hash_idx;
+CREATE UNIQUE INDEX IF NOT EXISTS split_ledger_entries_tx_hash_idx ON split_ledger_entries (tx_hash, split_id, token_address, recipient_address, entry_type) WHERE deleted = false;
+
+ALTER TABLE split_ledger_entries DROP COLUMN IF EXISTS log_index;
@@ -0,0 +1,6 @@
+-- A transaction can make several transfers of the same token to the same recipient, so entries are told apart by
+-- the transfer's log index as well
+ALTER TABLE split_ledger_entries ADD COLUMN IF NOT EXISTS log_index integer NOT NULL DEFAULT 0;
+
+DROP INDEX IF EXISTS split_ledger_entries_tx_hash_idx;
+CREATE UNIQUE INDEX IF NOT EXISTS split_ledger_entries_tx_hash_idx ON split_ledger_entries (tx_hash, log_index, split_id, token_address, recipient_address, entry_type) WHERE deleted = false;
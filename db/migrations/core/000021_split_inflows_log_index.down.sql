@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS split_inflows_tx_hash_idx;
+CREATE UNIQUE INDEX IF NOT EXISTS split_inflows_tx_hash_idx ON split_inflows (tx_hash, split_id, token_address, payer_address) WHERE deleted = false;
+
+ALTER TABLE split_inflows DROP COLUMN IF EXISTS log_index;
@@ -0,0 +1,6 @@
+-- A transaction can make several transfers of the same token from the same payer, so inflows are told apart by the
+-- transfer's log index as well
+ALTER TABLE split_inflows ADD COLUMN IF NOT EXISTS log_index integer NOT NULL DEFAULT 0;
+
+DROP INDEX IF EXISTS split_inflows_tx_hash_idx;
+CREATE UNIQUE INDEX IF NOT EXISTS split_inflows_tx_hash_idx ON split_inflows (tx_hash, log_index, split_id, token_address, payer_address) WHERE deleted = false;
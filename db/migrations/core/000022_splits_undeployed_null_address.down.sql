@@ -0,0 +1,2 @@
+-- Undeployed splits are left without an address, since more than one of them can't share an empty one
+SELECT 1;
@@ -0,0 +1,3 @@
+-- Splits that haven't been deployed have no address. They used to be stored with an empty one, which collides with
+-- split_address_chain_idx once a second undeployed split is created on the same chain.
+UPDATE splits SET address = NULL WHERE address = '';
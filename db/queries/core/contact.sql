@@ -0,0 +1,31 @@
+-- name: UpsertContact :one
+insert into contacts (id, owner_id, chain, address, label, notes, created_at, last_updated)
+values (@id, @owner_id, @chain, @address, @label, @notes, now(), now())
+on conflict (owner_id, chain, address) where deleted = false
+    do update set label = excluded.label, notes = excluded.notes, last_updated = now()
+returning *;
+
+-- name: UpsertContacts :exec
+insert into contacts (id, owner_id, chain, address, label, notes, created_at, last_updated)
+    select unnest(@ids::varchar[]), @owner_id::varchar, unnest(@chains::int[]), unnest(@addresses::varchar[]), unnest(@labels::varchar[]), unnest(@notes::varchar[]), now(), now()
+on conflict (owner_id, chain, address) where deleted = false
+    do update set label = excluded.label, notes = excluded.notes, last_updated = now();
+
+-- name: GetContactByID :one
+select * from contacts where id = $1 and deleted = false;
+
+-- name: GetContactsByOwnerID :many
+select * from contacts where owner_id = $1 and deleted = false order by lower(label), chain, address;
+
+-- name: SearchContactsByOwnerID :many
+select * from contacts
+where owner_id = @owner_id and deleted = false
+  and (label ilike '%' || @query::varchar || '%' or address ilike @query::varchar || '%')
+order by label ilike @query::varchar || '%' desc, lower(label), chain, address
+limit sqlc.arg('limit');
+
+-- name: DeleteContact :exec
+update contacts set deleted = true, last_updated = now() where id = $1 and deleted = false;
+
+-- name: GetContactByOwnerChainAddressBatch :batchone
+select * from contacts where owner_id = @owner_id and chain = @chain and address = @address and deleted = false;
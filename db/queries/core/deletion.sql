@@ -0,0 +1,18 @@
+-- name: CreateSplitDeletionRequest :one
+insert into split_deletion_requests (id, split_id, requester_id, quorum, created_at, last_updated)
+values (@id, @split_id, @requester_id, @quorum, now(), now())
+returning *;
+
+-- name: GetPendingSplitDeletionRequest :one
+select * from split_deletion_requests where split_id = $1 and completed_at is null and deleted = false;
+
+-- name: CompleteSplitDeletionRequests :exec
+update split_deletion_requests set completed_at = now(), last_updated = now() where split_id = $1 and completed_at is null and deleted = false;
+
+-- name: InsertSplitDeletionApprovals :exec
+insert into split_deletion_approvals (id, request_id, approver_id, address, ownership, created_at, last_updated)
+    select unnest(@ids::varchar[]), @request_id::varchar, @approver_id::varchar, unnest(@addresses::varchar[]), unnest(@ownerships::int[]), now(), now()
+on conflict (request_id, address) where deleted = false do nothing;
+
+-- name: GetSplitDeletionApprovals :many
+select * from split_deletion_approvals where request_id = $1 and deleted = false order by created_at, id;
@@ -0,0 +1,19 @@
+-- name: UpsertSplitDraft :one
+insert into split_drafts (id, split_id, edit_id, owner_id, name, description, expires_at, created_at, last_updated)
+values (@id, @split_id, @edit_id, @owner_id, sqlc.narg('name'), sqlc.narg('description'), @expires_at, now(), now())
+on conflict (split_id, edit_id) where deleted = false do update set
+    name = coalesce(excluded.name, split_drafts.name),
+    description = coalesce(excluded.description, split_drafts.description),
+    expires_at = excluded.expires_at,
+    last_updated = now()
+where split_drafts.owner_id = excluded.owner_id
+returning *;
+
+-- name: GetSplitDraft :one
+select * from split_drafts where split_id = @split_id and edit_id = @edit_id and owner_id = @owner_id and expires_at > now() and deleted = false;
+
+-- name: DeleteSplitDraft :exec
+update split_drafts set deleted = true, last_updated = now() where id = $1;
+
+-- name: DeleteExpiredSplitDrafts :exec
+update split_drafts set deleted = true, last_updated = now() where expires_at <= now() and deleted = false;
@@ -0,0 +1,61 @@
+-- name: InsertSplitInflows :many
+-- returns the inflows that were new, leaving out transfers that were already recorded
+with inflows as (
+    select unnest(@ids::varchar[]) as id
+         , unnest(@split_ids::varchar[]) as split_id
+         , unnest(@chains::int[]) as chain
+         , unnest(@token_addresses::varchar[]) as token_address
+         , unnest(@payer_addresses::varchar[]) as payer_address
+         , unnest(@amounts::varchar[])::numeric as amount
+         , unnest(@tx_hashes::varchar[]) as tx_hash
+         , unnest(@block_numbers::bigint[]) as block_number
+         , unnest(@log_indexes::int[]) as log_index
+),
+inserted as (
+    insert into split_inflows (id, split_id, chain, token_address, payer_address, amount, usd_value, tx_hash, block_number, log_index, created_at, last_updated)
+    select i.id, i.split_id, i.chain, i.token_address, i.payer_address, i.amount, i.amount / power(10::numeric, p.decimals) * p.usd_price, i.tx_hash, i.block_number, i.log_index, now(), now()
+    from inflows i
+        left join token_prices p on p.chain = i.chain and p.token_address = i.token_address and p.deleted = false
+    on conflict (tx_hash, log_index, split_id, token_address, payer_address) where deleted = false do nothing
+    returning id, split_id, chain, token_address, payer_address, amount, usd_value, created_at
+),
+rollups as (
+    insert into split_inflow_rollups (split_id, day, chain, token_address, payer_address, amount, usd_value, inflow_count, last_updated)
+        select split_id, created_at::date, chain, token_address, payer_address, sum(amount), coalesce(sum(usd_value), 0), count(*), now()
+        from inserted
+        group by split_id, created_at::date, chain, token_address, payer_address
+    on conflict (split_id, day, chain, token_address, payer_address) do update
+        set amount = split_inflow_rollups.amount + excluded.amount
+          , usd_value = split_inflow_rollups.usd_value + excluded.usd_value
+          , inflow_count = split_inflow_rollups.inflow_count + excluded.inflow_count
+          , last_updated = now()
+)
+select id, split_id from inserted;
+
+-- name: GetSplitInflowTokenTotals :many
+select chain, token_address, sum(amount)::varchar as amount, sum(usd_value)::float8 as usd_value, sum(inflow_count)::int as inflow_count
+from split_inflow_rollups
+where split_id = any(@split_ids::varchar[]) and day >= @since::date
+group by chain, token_address
+order by usd_value desc, inflow_count desc, chain, token_address;
+
+-- name: GetSplitInflowTopPayers :many
+select payer_address, sum(usd_value)::float8 as usd_value, sum(inflow_count)::int as inflow_count
+from split_inflow_rollups
+where split_id = any(@split_ids::varchar[]) and day >= @since::date
+group by payer_address
+order by usd_value desc, inflow_count desc, payer_address
+limit sqlc.arg('limit');
+
+-- name: GetSplitInflowTimeSeries :many
+select date_trunc(@bucket::varchar, day)::timestamptz as bucket_start, sum(usd_value)::float8 as usd_value, sum(inflow_count)::int as inflow_count
+from split_inflow_rollups
+where split_id = any(@split_ids::varchar[]) and day >= @since::date
+group by bucket_start
+order by bucket_start;
+
+-- name: UpsertTokenPrice :exec
+insert into token_prices (id, chain, token_address, decimals, usd_price, created_at, last_updated)
+values (@id, @chain, @token_address, @decimals, @usd_price::float8, now(), now())
+on conflict (chain, token_address) where deleted = false
+    do update set decimals = excluded.decimals, usd_price = excluded.usd_price, last_updated = now();
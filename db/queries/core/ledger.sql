@@ -0,0 +1,49 @@
+-- name: InsertSplitLedgerEntries :many
+-- entries that were already recorded are skipped and aren't returned
+insert into split_ledger_entries (id, split_id, entry_type, chain, token_address, recipient_address, amount, split_balance, tx_hash, block_number, log_index, created_at, last_updated)
+    select unnest(@ids::varchar[])
+         , unnest(@split_ids::varchar[])
+         , unnest(@entry_types::varchar[])
+         , unnest(@chains::int[])
+         , unnest(@token_addresses::varchar[])
+         , unnest(@recipient_addresses::varchar[])
+         , unnest(@amounts::varchar[])
+         , unnest(@split_balances::varchar[])
+         , unnest(@tx_hashes::varchar[])
+         , unnest(@block_numbers::bigint[])
+         , unnest(@log_indexes::int[])
+         , now()
+         , now()
+on conflict (tx_hash, log_index, split_id, token_address, recipient_address, entry_type) where deleted = false do nothing
+returning id, split_id, entry_type, tx_hash;
+
+-- name: GetSplitLedgerEntriesPaginate :many
+select * from split_ledger_entries where split_id = @split_id and deleted = false
+    and (created_at, id) < (@cur_before_time, @cur_before_id)
+    and (created_at, id) > (@cur_after_time, @cur_after_id)
+order by case when @paging_forward::bool then (created_at, id) end asc,
+         case when not @paging_forward::bool then (created_at, id) end desc
+limit @limit;
+
+-- name: CountSplitLedgerEntries :one
+select count(*) from split_ledger_entries where split_id = $1 and deleted = false;
+
+-- name: GetLedgerEntriesByRecipientAddressesPaginate :many
+select * from split_ledger_entries where recipient_address = any(@recipient_addresses::varchar[]) and deleted = false
+    and (created_at, id) < (@cur_before_time, @cur_before_id)
+    and (created_at, id) > (@cur_after_time, @cur_after_id)
+order by case when @paging_forward::bool then (created_at, id) end asc,
+         case when not @paging_forward::bool then (created_at, id) end desc
+limit @limit;
+
+-- name: CountLedgerEntriesByRecipientAddresses :one
+select count(*) from split_ledger_entries where recipient_address = any(@recipient_addresses::varchar[]) and deleted = false;
+
+-- name: GetSplitLedgerEntriesByRecipientAddresses :many
+select * from split_ledger_entries where split_id = @split_id and recipient_address = any(@recipient_addresses::varchar[]) and deleted = false
+order by created_at, id;
+
+-- name: GetSplitDistributionEntriesByTxHash :many
+select * from split_ledger_entries
+where split_id = @split_id and tx_hash = @tx_hash and entry_type = 'distribution' and deleted = false
+order by token_address, recipient_address;
@@ -0,0 +1,45 @@
+-- name: InsertLedgerExport :one
+insert into ledger_exports (id, requester_id, split_id, recipient_address, format, from_time, to_time, status, created_at, last_updated)
+values (@id, @requester_id, nullif(@split_id::varchar, ''), nullif(@recipient_address::varchar, ''), @format, @from_time, @to_time, 'pending', now(), now())
+returning *;
+
+-- name: GetLedgerExportByID :one
+select * from ledger_exports where id = @id and deleted = false;
+
+-- name: CompleteLedgerExport :one
+update ledger_exports
+set status = 'complete', object_name = @object_name, row_count = @row_count, completed_at = now(), last_updated = now()
+where id = @id and deleted = false
+returning *;
+
+-- name: FailLedgerExport :one
+update ledger_exports
+set status = 'failed', error = @error, completed_at = now(), last_updated = now()
+where id = @id and deleted = false
+returning *;
+
+-- name: CountSplitLedgerExportRows :one
+select ((select count(*) from split_inflows i where i.split_id = @split_id and i.deleted = false and i.created_at >= @from_time and i.created_at < @to_time)
+      + (select count(*) from split_ledger_entries e where e.split_id = @split_id and e.deleted = false and e.created_at >= @from_time and e.created_at < @to_time))::bigint as total;
+
+-- name: CountRecipientLedgerExportRows :one
+select count(*) from split_ledger_entries
+where recipient_address = @recipient_address and deleted = false and created_at >= @from_time and created_at < @to_time;
+
+-- name: GetSplitInflowsForExport :many
+select * from split_inflows
+where split_id = @split_id and deleted = false and created_at >= @from_time and created_at < @to_time
+order by created_at, id;
+
+-- name: GetSplitLedgerEntriesForExport :many
+select * from split_ledger_entries
+where split_id = @split_id and deleted = false and created_at >= @from_time and created_at < @to_time
+order by created_at, id;
+
+-- name: GetRecipientLedgerEntriesForExport :many
+select * from split_ledger_entries
+where recipient_address = @recipient_address and deleted = false and created_at >= @from_time and created_at < @to_time
+order by created_at, id;
+
+-- name: GetTokenPrices :many
+select * from token_prices where deleted = false;
@@ -58,16 +58,19 @@ SELECT s.* FROM users u, unnest(u.wallets)
 
 -- name: GetSplitsByUserIDBatch :batchmany
 select s.*
-    from users u, unnest(u.wallets)
-    with ordinality as a(wallet_id, wallet_ord)
-        join wallets w on w.id = a.wallet_id
-        join recipients r on r.address = w.address
-        join splits s on s.id = r.split_id
-    where u.id = $1
-      and u.deleted = false
-      and w.deleted = false
-      and r.deleted = false
-      and s.deleted = false;
+    from splits s
+        join (select distinct r.split_id
+                from users u, unnest(u.wallets) as a(wallet_id)
+                    join wallets w on w.id = a.wallet_id
+                    join recipients r on r.address = w.address
+                where u.id = $1
+                  and u.deleted = false
+                  and w.deleted = false
+                  and r.deleted = false) m on m.split_id = s.id
+        left join split_user_settings us on us.split_id = s.id and us.user_id = $1 and us.deleted = false
+    where s.deleted = false
+      and coalesce(us.hidden, false) = false
+    order by us.position collate "C" nulls last, s.created_at, s.id;
 
 -- name: GetSplitByIdBatch :batchone
 SELECT * FROM splits WHERE id = $1 AND deleted = false;
@@ -82,9 +85,11 @@ SELECT * FROM splits WHERE address = $1 AND chain = $2 AND deleted = false;
 SELECT * FROM splits WHERE chain = any(@chains::int[]) OR contract_address = any(@addresses::varchar[]) AND deleted = false;
 
 -- name: GetSplitsByRecipientAddress :many
-SELECT s.* FROM recipients r
+SELECT sqlc.embed(s), sum(r.ownership)::int AS ownership FROM recipients r
                     JOIN splits s ON s.id = r.split_id
-WHERE r.address = $1 AND s.deleted = false;
+WHERE r.address = $1 AND r.deleted = false AND s.deleted = false
+GROUP BY s.id
+ORDER BY s.created_at, s.id;
 
 -- name: GetWalletByID :one
 SELECT * FROM wallets WHERE id = $1 AND deleted = false;
@@ -253,6 +258,15 @@ where owner_id = $1
 order by created_at desc
 limit 1;
 
+-- name: GetMostRecentNotificationByOwnerIDSplitIDForAction :one
+select * from notifications
+where owner_id = $1
+  and split_id = $2
+  and action = $3
+  and deleted = false
+order by created_at desc
+limit 1;
+
 -- name: GetNotificationsByOwnerIDForActionAfter :many
 SELECT * FROM notifications
 WHERE owner_id = $1 AND action = $2 AND deleted = false AND created_at > @created_after
@@ -300,6 +314,12 @@ INSERT INTO notifications (id, owner_id, action, data, event_ids) VALUES ($1, $2
 -- name: CreateViewSplitNotification :one
 INSERT INTO notifications (id, owner_id, action, data, event_ids, split_id) VALUES ($1, $2, $3, $4, $5, $6) RETURNING *;
 
+-- name: CreateSplitNotification :one
+INSERT INTO notifications (id, owner_id, action, data, event_ids, split_id) VALUES ($1, $2, $3, $4, $5, $6) RETURNING *;
+
+-- name: CreateSplitAmountNotification :one
+INSERT INTO notifications (id, owner_id, action, data, event_ids, split_id, amount) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING *;
+
 -- name: UpdateNotification :exec
 UPDATE notifications SET data = $2, event_ids = event_ids || $3, amount = $4, last_updated = now(), seen = false WHERE id = $1 AND deleted = false AND NOT amount = $4;
 
@@ -382,7 +402,7 @@ update user_roles set deleted = true, last_updated = now() where user_id = $1 an
 select role from user_roles where user_id = $1 and deleted = false;
 
 -- name: CreateSplit :one
-insert into splits (id, chain, address, name, description, creator_address, logo_url, banner_url, badge_url, total_ownership, created_at, last_updated) values (@split_id, @chain, @address, @name, @description, @creator_address, @logo_url, @banner_url, @badge_url, @total_ownership, now(), now()) returning *;
+insert into splits (id, chain, address, name, description, creator_address, logo_url, banner_url, badge_url, total_ownership, created_at, last_updated) values (@split_id, @chain, nullif(@address::varchar, ''), @name, @description, @creator_address, @logo_url, @banner_url, @badge_url, @total_ownership, now(), now()) returning *;
 
 /*
 // name: UpdateSplitHidden :one
@@ -398,6 +418,23 @@ with updates as (
 )
 update recipients r set ownership = updates.ownership, last_updated = now() from updates where r.split_id = updates.split_id and r.address = updates.recipient_address;
 
+-- name: GetRecipientByID :one
+select * from recipients where id = $1 and deleted = false;
+
+-- name: GetRecipientsBySplitID :many
+select * from recipients where split_id = $1 and deleted = false order by ownership desc, address;
+
+-- name: UpsertSplitRecipients :exec
+insert into recipients (id, split_id, address, ownership, created_at, last_updated)
+    select unnest(@ids::varchar[]), @split_id::varchar, unnest(@addresses::varchar[]), unnest(@ownerships::int[]), now(), now()
+on conflict (split_id, address) where deleted = false do update set ownership = excluded.ownership, last_updated = now();
+
+-- name: DeleteSplitRecipientsExcept :exec
+update recipients set deleted = true, last_updated = now() where split_id = @split_id and deleted = false and not address = any(@addresses::varchar[]);
+
+-- name: UpdateSplitTotalOwnership :exec
+update splits set total_ownership = @total_ownership, last_updated = now() where id = @id and deleted = false;
+
 -- name: UpdateUserExperience :exec
 update users set user_experiences = user_experiences || @experience where id = @user_id;
 
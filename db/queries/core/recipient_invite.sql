@@ -0,0 +1,26 @@
+-- name: UpsertRecipientInvite :one
+insert into split_recipient_invites (id, split_id, recipient_id, inviter_id, email_address)
+values (@id, @split_id, @recipient_id, @inviter_id, lower(@email_address))
+on conflict (recipient_id) where status = 'pending' and deleted = false
+    do update set inviter_id = excluded.inviter_id, email_address = excluded.email_address, last_sent_at = now(), last_updated = now()
+returning *;
+
+-- name: GetRecipientInviteByID :one
+select * from split_recipient_invites where id = $1 and deleted = false;
+
+-- name: GetLatestRecipientInvite :one
+-- Revoked invites are skipped so a recipient shows as uninvited once its invite is revoked
+select * from split_recipient_invites
+where recipient_id = $1 and status <> 'revoked' and deleted = false
+order by created_at desc
+limit 1;
+
+-- name: AcceptRecipientInvite :one
+update split_recipient_invites set status = 'accepted', invitee_id = @invitee_id, accepted_at = now(), last_updated = now()
+where id = @id and status = 'pending' and deleted = false
+returning *;
+
+-- name: RevokeRecipientInvite :one
+update split_recipient_invites set status = 'revoked', last_updated = now()
+where id = $1 and status = 'pending' and deleted = false
+returning *;
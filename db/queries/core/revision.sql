@@ -0,0 +1,24 @@
+-- name: CreateSplitRevision :one
+with bumped as (
+    update splits set version = coalesce(version, 0) + 1 where id = @split_id and deleted = false returning *
+)
+insert into split_revisions (id, split_id, revision, actor_id, source, name, description, logo_url, banner_url, badge_url, total_ownership, recipients, created_at, last_updated)
+select @id::varchar, s.id, s.version, sqlc.narg('actor_id')::varchar, @source::varchar, s.name, s.description, s.logo_url, s.banner_url, s.badge_url, s.total_ownership,
+    coalesce((select jsonb_agg(jsonb_build_object('address', r.address, 'ownership', r.ownership) order by r.address) from recipients r where r.split_id = s.id and r.deleted = false), '[]'::jsonb),
+    now(), now()
+from bumped s
+returning *;
+
+-- name: GetSplitRevision :one
+select * from split_revisions where split_id = $1 and revision = $2 and deleted = false;
+
+-- name: GetSplitRevisionsPaginate :many
+select * from split_revisions where split_id = @split_id and deleted = false
+    and (created_at, id) < (@cur_before_time, @cur_before_id)
+    and (created_at, id) > (@cur_after_time, @cur_after_id)
+order by case when @paging_forward::bool then (created_at, id) end asc,
+         case when not @paging_forward::bool then (created_at, id) end desc
+limit @limit;
+
+-- name: CountSplitRevisions :one
+select count(*) from split_revisions where split_id = $1 and deleted = false;
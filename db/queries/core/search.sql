@@ -1,49 +1,66 @@
-/*
 -- name: SearchUsers :many
 with min_content_score as (
     select score from user_relevance where id is null
+),
+address_matches as (
+    select distinct u.id from users u
+        join wallets w on w.id = any(u.wallets) and w.deleted = false
+    where u.deleted = false
+      and (w.address = any(@addresses::varchar[]) or websearch_to_tsquery('simple', @query) @@ w.fts_address)
 )
-select u.* from users u left join user_relevance on u.id = user_relevance.id,
-                -- Adding the search condition to the wallet join statement is a very helpful optimization, but we can't use
-                -- "simple_full_query" at this point in the statement, so we're repeating the "websearch_to_tsquery..." part here
-                unnest(u.wallets) as wallet_id left join wallets w on w.id = wallet_id and w.deleted = false and websearch_to_tsquery('simple', @query) @@ w.fts_address,
-                to_tsquery('simple', websearch_to_tsquery('simple', @query)::text || ':*') simple_partial_query,
-                websearch_to_tsquery('simple', @query) simple_full_query,
-                websearch_to_tsquery('english', @query) english_full_query,
-                min_content_score,
-                greatest(
-                        ts_rank_cd(concat('{', @username_weight::float4, ', 1, 1, 1}')::float4[], u.fts_username, simple_partial_query, 1),
-                        ts_rank_cd(concat('{', @bio_weight::float4, ', 1, 1, 1}')::float4[], u.fts_bio_english, english_full_query, 1),
-                        ts_rank_cd('{1, 1, 1, 1}', w.fts_address, simple_full_query) * 1000000000
-                    ) as match_score,
-                coalesce(user_relevance.score, min_content_score.score) as content_score
+select u.* from users u left join user_relevance on u.id = user_relevance.id
+    left join address_matches on address_matches.id = u.id,
+    to_tsquery('simple', websearch_to_tsquery('simple', @query)::text || ':*') simple_partial_query,
+    min_content_score,
+    greatest(
+        ts_rank_cd(concat('{', @username_weight::float4, ', 1, 1, 1}')::float4[], u.fts_username, simple_partial_query, 1),
+        -- An exact address match should always outrank a username match
+        case when address_matches.id is not null then 1000000000 else 0 end
+    ) as match_score,
+    coalesce(user_relevance.score, min_content_score.score) as content_score
 where (
-            simple_partial_query @@ u.fts_username or
-            simple_full_query @@ w.fts_address
+        simple_partial_query @@ u.fts_username or
+        address_matches.id is not null
     )
   and u.universal = false and u.deleted = false
-group by (u.id, content_score * match_score, content_score, match_score)
 order by content_score * match_score desc, content_score desc, match_score desc, length(u.username_idempotent) asc
 limit sqlc.arg('limit');
 
-name: SearchSplits :many
+-- name: SearchSplits :many
 with min_content_score as (
     select score from split_relevance where id is null
+),
+address_matches as (
+    -- A split's own address ranks above one of its recipients' addresses
+    select id, max(rank) as rank from (
+        select s.id, 2 as rank from splits s where s.address = any(@addresses::varchar[]) and s.deleted = false
+        union all
+        select r.split_id as id, 1 as rank from recipients r where r.address = any(@addresses::varchar[]) and r.deleted = false
+    ) matches
+    group by id
 )
-select splits.* from splits left join split_relevance on split_relevance.id = splits.id,
+select s.* from splits s left join split_relevance on split_relevance.id = s.id
+    left join address_matches on address_matches.id = s.id,
     to_tsquery('simple', websearch_to_tsquery('simple', @query)::text || ':*') simple_partial_query,
     websearch_to_tsquery('english', @query) english_full_query,
     min_content_score,
     greatest(
-        ts_rank_cd(concat('{', @name_weight::float4, ', 1, 1, 1}')::float4[], fts_name, simple_partial_query, 1),
-        ts_rank_cd(concat('{', @description_weight::float4, ', 1, 1, 1}')::float4[], fts_description_english, english_full_query, 1)
-        ) as match_score,
+        ts_rank_cd(concat('{', @name_weight::float4, ', 1, 1, 1}')::float4[], s.fts_name, simple_partial_query, 1),
+        ts_rank_cd(concat('{', @description_weight::float4, ', 1, 1, 1}')::float4[], s.fts_description_english, english_full_query, 1),
+        coalesce(address_matches.rank, 0) * 1000000000
+    ) as match_score,
     coalesce(split_relevance.score, min_content_score.score) as content_score
 where (
-    simple_partial_query @@ fts_name or
-    english_full_query @@ fts_description_english
+        simple_partial_query @@ s.fts_name or
+        english_full_query @@ s.fts_description_english or
+        address_matches.id is not null
     )
-    and deleted = false and hidden = false
-order by content_score * match_score desc, content_score desc, match_score desc
+  and s.deleted = false
+order by content_score * match_score desc, content_score desc, match_score desc, s.created_at desc
 limit sqlc.arg('limit');
-*/
\ No newline at end of file
+
+-- name: RefreshSplitRelevance :exec
+refresh materialized view concurrently split_relevance;
+
+-- name: RefreshUserRelevance :exec
+refresh materialized view concurrently user_relevance;
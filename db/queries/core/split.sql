@@ -4,8 +4,77 @@ insert into splits (id, chain, address, name, description, creator_address, logo
 -- name: SplitRepoUpdate :execrows
 update splits set last_updated = now() where splits.id = @split_id;
 
-/*
-TODO delete either by quorum or by controller
-name: SplitRepoDelete :exec
-update splits set deleted = true where splits.id = @split_id and (select count(*) from splits g where g.owner_user_id = @owner_user_id and g.deleted = false and not g.id = @split_id) > 0 and not coalesce((select featured_split::varchar from users u where u.id = @owner_user_id), '') = @split_id;
-*/
\ No newline at end of file
+-- name: SplitRepoDelete :execrows
+update splits set deleted = true, last_updated = now() where splits.id = @split_id and splits.deleted = false;
+
+-- name: GetRecipientUserIDsBySplitID :many
+select distinct u.id from users u, unnest(u.wallets) as a(wallet_id)
+    join wallets w on w.id = a.wallet_id
+    join recipients r on r.address = w.address
+where r.split_id = $1 and r.deleted = false and w.deleted = false and u.deleted = false;
+
+-- name: GetMemberSplitsByUserIDPaginate :many
+-- ownership is the user's share summed across every wallet of theirs that is a recipient of the split
+select sqlc.embed(s), m.ownership, m.addresses
+    from splits s
+        join (select r.split_id, sum(r.ownership)::int as ownership, array_agg(distinct r.address)::varchar[] as addresses
+                from recipients r
+                where r.deleted = false
+                  and r.id in (select mr.id
+                                 from users u, unnest(u.wallets) as a(wallet_id)
+                                     join wallets w on w.id = a.wallet_id
+                                     join recipients mr on mr.address = w.address
+                                 where u.id = @user_id
+                                   and u.deleted = false
+                                   and w.deleted = false)
+                group by r.split_id) m on m.split_id = s.id
+    where s.deleted = false
+      and (s.created_at, s.id) < (@cur_before_time, @cur_before_id)
+      and (s.created_at, s.id) > (@cur_after_time, @cur_after_id)
+    order by case when @paging_forward::bool then (s.created_at, s.id) end asc,
+             case when not @paging_forward::bool then (s.created_at, s.id) end desc
+    limit @limit;
+
+-- name: CountMemberSplitsByUserID :one
+select count(distinct r.split_id)
+    from users u, unnest(u.wallets) as a(wallet_id)
+        join wallets w on w.id = a.wallet_id
+        join recipients r on r.address = w.address
+        join splits s on s.id = r.split_id
+    where u.id = $1
+      and u.deleted = false
+      and w.deleted = false
+      and r.deleted = false
+      and s.deleted = false;
+
+-- name: GetSplitsWithUserSettingsByUserID :many
+select sqlc.embed(s), us.position, coalesce(us.hidden, false)::bool as hidden
+    from splits s
+        join (select distinct r.split_id
+                from users u, unnest(u.wallets) as a(wallet_id)
+                    join wallets w on w.id = a.wallet_id
+                    join recipients r on r.address = w.address
+                where u.id = @user_id
+                  and u.deleted = false
+                  and w.deleted = false
+                  and r.deleted = false) m on m.split_id = s.id
+        left join split_user_settings us on us.split_id = s.id and us.user_id = @user_id and us.deleted = false
+    where s.deleted = false
+    order by us.position collate "C" nulls last, s.created_at, s.id;
+
+-- name: GetSplitsByPositionPaginate :many
+select s.*
+from splits s
+         join unnest(@split_ids::varchar[]) with ordinality t(id, pos) using(id)
+where not s.deleted and t.pos > @cur_after_pos::int and t.pos < @cur_before_pos::int
+order by t.pos asc;
+
+-- name: UpsertSplitUserPositions :exec
+insert into split_user_settings (id, user_id, split_id, position, created_at, last_updated)
+    select unnest(@ids::varchar[]), @user_id::varchar, unnest(@split_ids::varchar[]), unnest(@positions::varchar[]), now(), now()
+on conflict (user_id, split_id) where deleted = false do update set position = excluded.position, last_updated = now();
+
+-- name: UpsertSplitUserHidden :exec
+insert into split_user_settings (id, user_id, split_id, hidden, created_at, last_updated)
+values (@id, @user_id, @split_id, @hidden, now(), now())
+on conflict (user_id, split_id) where deleted = false do update set hidden = excluded.hidden, last_updated = now();
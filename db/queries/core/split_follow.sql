@@ -0,0 +1,44 @@
+-- name: FollowSplit :exec
+insert into split_follows (id, split_id, user_id)
+values (@id, @split_id, @user_id)
+on conflict (split_id, user_id) where deleted = false do nothing;
+
+-- name: UnfollowSplit :execrows
+update split_follows set deleted = true, last_updated = now() where split_id = @split_id and user_id = @user_id and deleted = false;
+
+-- name: GetFollowedSplitsByUserIDPaginate :many
+-- splits are ordered by when the user followed them
+select sqlc.embed(s), f.id as follow_id, f.created_at as followed_at
+    from split_follows f
+        join splits s on s.id = f.split_id
+    where f.user_id = @user_id
+      and f.deleted = false
+      and s.deleted = false
+      and (f.created_at, f.id) < (@cur_before_time, @cur_before_id)
+      and (f.created_at, f.id) > (@cur_after_time, @cur_after_id)
+    order by case when @paging_forward::bool then (f.created_at, f.id) end asc,
+             case when not @paging_forward::bool then (f.created_at, f.id) end desc
+    limit @limit;
+
+-- name: CountFollowedSplitsByUserID :one
+select count(*)
+    from split_follows f
+        join splits s on s.id = f.split_id
+    where f.user_id = $1
+      and f.deleted = false
+      and s.deleted = false;
+
+-- name: GetSplitWatcherUserIDs :many
+-- watchers are the users who follow a split, receive from it with one of their wallets, or have a role on it
+select u.id from split_follows f
+    join users u on u.id = f.user_id
+where f.split_id = @split_id and f.deleted = false and u.deleted = false
+union
+select u.id from users u, unnest(u.wallets) as a(wallet_id)
+    join wallets w on w.id = a.wallet_id
+    join recipients r on r.address = w.address
+where r.split_id = @split_id and r.deleted = false and w.deleted = false and u.deleted = false
+union
+select u.id from split_members m
+    join users u on u.id = m.user_id
+where m.split_id = @split_id and m.deleted = false and u.deleted = false;
@@ -0,0 +1,46 @@
+-- name: InsertSplitGroup :one
+insert into split_groups (id, l1_chain, name, description, creator_id)
+values (@id, @l1_chain, @name, @description, @creator_id)
+returning *;
+
+-- name: GetSplitGroupByID :one
+select * from split_groups where id = $1 and deleted = false;
+
+-- name: GetSplitGroupBySplitID :one
+select g.* from split_groups g
+    join split_group_members m on m.group_id = g.id
+where m.split_id = $1 and m.deleted = false and g.deleted = false;
+
+-- name: UpdateSplitGroupInfo :one
+update split_groups set name = case when @name_set::bool then @name else name end, description = case when @description_set::bool then @description else description end, last_updated = now() where id = @id and deleted = false
+returning *;
+
+-- name: DeleteSplitGroup :exec
+update split_groups set deleted = true, last_updated = now() where id = $1 and deleted = false;
+
+-- name: AddSplitGroupMember :exec
+insert into split_group_members (id, group_id, split_id, chain) values (@id, @group_id, @split_id, @chain);
+
+-- name: RemoveSplitGroupMember :execrows
+update split_group_members set deleted = true, last_updated = now() where group_id = @group_id and split_id = @split_id and deleted = false;
+
+-- name: GetSplitsByGroupID :many
+select s.* from splits s
+    join split_group_members m on m.split_id = s.id
+where m.group_id = $1 and m.deleted = false and s.deleted = false
+order by s.chain, s.id;
+
+-- name: GetSplitGroupLedgerEntriesPaginate :many
+select l.* from split_ledger_entries l
+    join split_group_members m on m.split_id = l.split_id
+where m.group_id = @group_id and m.deleted = false and l.deleted = false
+    and (l.created_at, l.id) < (@cur_before_time, @cur_before_id)
+    and (l.created_at, l.id) > (@cur_after_time, @cur_after_id)
+order by case when @paging_forward::bool then (l.created_at, l.id) end asc,
+         case when not @paging_forward::bool then (l.created_at, l.id) end desc
+limit @limit;
+
+-- name: CountSplitGroupLedgerEntries :one
+select count(*) from split_ledger_entries l
+    join split_group_members m on m.split_id = l.split_id
+where m.group_id = $1 and m.deleted = false and l.deleted = false;
@@ -0,0 +1,39 @@
+-- name: InsertSplitMediaUpload :one
+insert into split_media (id, split_id, kind, uploader_id, content_type, content_length)
+values (@id, @split_id, @kind, @uploader_id, @content_type, @content_length)
+returning *;
+
+-- name: GetSplitMediaByID :one
+select * from split_media where id = $1 and deleted = false;
+
+-- name: GetCurrentSplitMedia :one
+select * from split_media where split_id = @split_id and kind = @kind and status = 'complete' and deleted = false
+order by completed_at desc
+limit 1;
+
+-- name: CompleteSplitMedia :one
+update split_media
+set status = 'complete'
+  , width = @width
+  , height = @height
+  , blurhash = @blurhash
+  , aspect_ratio = @aspect_ratio
+  , small_url = @small_url
+  , medium_url = @medium_url
+  , large_url = @large_url
+  , completed_at = now()
+  , last_updated = now()
+where id = @id and status = 'pending' and deleted = false
+returning *;
+
+-- name: FailSplitMedia :one
+update split_media set status = 'failed', error = @error, last_updated = now() where id = @id and status = 'pending' and deleted = false
+returning *;
+
+-- name: UpdateSplitImageUrl :exec
+update splits
+set logo_url = case when @kind::varchar = 'logo' then @url::varchar else logo_url end
+  , banner_url = case when @kind::varchar = 'banner' then @url::varchar else banner_url end
+  , badge_url = case when @kind::varchar = 'badge' then @url::varchar else badge_url end
+  , last_updated = now()
+where id = @id and deleted = false;
@@ -0,0 +1,30 @@
+-- name: UpsertSplitMember :one
+insert into split_members (id, split_id, user_id, role, granted_by)
+values (@id, @split_id, @user_id, @role, @granted_by)
+on conflict (split_id, user_id) where deleted = false
+    do update set role = excluded.role, granted_by = excluded.granted_by, last_updated = now()
+returning *;
+
+-- name: DeleteSplitMember :execrows
+update split_members set deleted = true, last_updated = now() where split_id = @split_id and user_id = @user_id and deleted = false;
+
+-- name: GetSplitMember :one
+select * from split_members where split_id = @split_id and user_id = @user_id and deleted = false;
+
+-- name: GetSplitMembersBySplitID :many
+select m.* from split_members m
+    join users u on u.id = m.user_id
+where m.split_id = $1 and m.deleted = false and u.deleted = false
+order by m.created_at, m.id;
+
+-- name: GetSplitViewerUserIDs :many
+-- viewers are the users with at least the viewer role on a split: those who receive from it with one of their wallets,
+-- and those who were granted a role on it
+select u.id from users u, unnest(u.wallets) as a(wallet_id)
+    join wallets w on w.id = a.wallet_id
+    join recipients r on r.address = w.address
+where r.split_id = @split_id and r.deleted = false and w.deleted = false and u.deleted = false
+union
+select u.id from split_members m
+    join users u on u.id = m.user_id
+where m.split_id = @split_id and m.deleted = false and u.deleted = false;
@@ -0,0 +1,23 @@
+-- name: CreateSplitTemplate :one
+insert into split_templates (id, owner_id, name, description, logo_url, banner_url, badge_url, total_ownership, created_at, last_updated)
+values (@id, @owner_id, @name, @description, @logo_url, @banner_url, @badge_url, @total_ownership, now(), now())
+returning *;
+
+-- name: InsertSplitTemplateRecipients :exec
+insert into split_template_recipients (id, template_id, address, ownership, created_at, last_updated)
+    select unnest(@ids::varchar[]), @template_id::varchar, unnest(@addresses::varchar[]), unnest(@ownerships::int[]), now(), now();
+
+-- name: GetSplitTemplateByID :one
+select * from split_templates where id = $1 and deleted = false;
+
+-- name: GetSplitTemplatesByOwnerID :many
+select * from split_templates where owner_id = $1 and deleted = false order by created_at desc, id desc;
+
+-- name: GetSplitTemplateRecipients :many
+select * from split_template_recipients where template_id = $1 and deleted = false order by ownership desc, address;
+
+-- name: DeleteSplitTemplate :exec
+with deleted_template as (
+    update split_templates set deleted = true, last_updated = now() where split_templates.id = @id and deleted = false returning id
+)
+update split_template_recipients set deleted = true, last_updated = now() where template_id in (select id from deleted_template) and deleted = false;
@@ -2,7 +2,7 @@
 WITH token_metadatas_insert AS (
     INSERT INTO token_metadatas
         (
-         id, created_at, last_updated, deleted, name, symbol, chain, logo, thumbnail, contract_address
+         id, created_at, last_updated, deleted, name, symbol, chain, logo, thumbnail, contract_address, is_spam
             ) (SELECT UNNEST(@dbid::varchar[])             AS id
                     , NOW()
                     , NOW()
@@ -12,7 +12,8 @@ WITH token_metadatas_insert AS (
                     , UNNEST(@chain::chain[])              AS chain
                     , UNNEST(@logo::varchar[])             AS logo
                     , UNNEST(@thumbnail::varchar[])        AS thumbnail
-                    , UNNEST(@contract_address::address[]) AS contract_address)
+                    , UNNEST(@contract_address::address[]) AS contract_address
+                    , UNNEST(@is_spam::bool[])             AS is_spam)
         ON CONFLICT (chain, contract_address) WHERE deleted = FALSE
             DO UPDATE SET
                 last_updated = excluded.last_updated
@@ -20,6 +21,7 @@ WITH token_metadatas_insert AS (
                 , symbol = COALESCE(NULLIF(excluded.symbol, ''), NULLIF(token_metadatas.symbol, ''))
                 , logo = COALESCE(NULLIF(excluded.logo, ''), NULLIF(token_metadatas.logo, ''))
                 , thumbnail = COALESCE(NULLIF(excluded.thumbnail, ''), NULLIF(token_metadatas.thumbnail, ''))
+                , is_spam = excluded.is_spam
         RETURNING *)
 SELECT sqlc.embed(token_metadatas), (prior_state.id IS NULL)::bool is_new_metadata
 FROM token_metadatas_insert token_metadatas
@@ -64,3 +66,17 @@ FROM tokens_insert tokens
                                          tokens.chain = prior_state.chain AND
                                          NOT prior_state.deleted
 WHERE prior_state.id IS NULL;
+
+-- name: GetTokensByOwnerAddressAndChain :many
+SELECT * FROM tokens WHERE owner_address = $1 AND chain = $2 AND deleted = false ORDER BY token_address;
+
+-- name: GetTokensWithMetadataByOwnerAddressAndChain :many
+-- tokens without metadata aren't spam, and tokens without a price have no decimals or price
+SELECT sqlc.embed(tokens), m.symbol, m.name, m.logo, COALESCE(m.is_spam, false)::bool AS is_spam, p.decimals, p.usd_price::float8 AS usd_price
+FROM tokens
+         LEFT JOIN token_metadatas m ON m.chain = tokens.chain AND m.contract_address = tokens.token_address AND NOT m.deleted
+         LEFT JOIN token_prices p ON p.chain = tokens.chain AND p.token_address = tokens.token_address AND NOT p.deleted
+WHERE tokens.owner_address = @owner_address AND tokens.chain = @chain AND NOT tokens.deleted;
+
+-- name: GetTokenByOwnerAndTokenChainAddress :one
+SELECT * FROM tokens WHERE owner_address = $1 AND token_address = $2 AND chain = $3 AND deleted = false;
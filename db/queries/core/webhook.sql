@@ -0,0 +1,67 @@
+-- name: CreateWebhook :one
+insert into webhooks (id, owner_id, split_id, url, secret, event_types)
+values (@id, @owner_id, nullif(@split_id::varchar, ''), @url, @secret, @event_types)
+returning *;
+
+-- name: GetWebhookByID :one
+select * from webhooks where id = @id and deleted = false;
+
+-- name: GetWebhooksByOwnerID :many
+select * from webhooks where owner_id = @owner_id and deleted = false order by created_at, id;
+
+-- name: UpdateWebhook :one
+update webhooks
+set url = case when @url_set::bool then @url else url end,
+    event_types = case when @event_types_set::bool then @event_types else event_types end,
+    enabled = case when @enabled_set::bool then @enabled else enabled end,
+    last_updated = now()
+where id = @id and deleted = false
+returning *;
+
+-- name: DeleteWebhook :execrows
+update webhooks set deleted = true, last_updated = now() where id = @id and deleted = false;
+
+-- name: GetWebhooksForSplitEvent :many
+-- webhooks are only sent events from splits their owner can currently view, whether or not they're for one split
+select * from webhooks
+where deleted = false
+  and enabled
+  and @event_type::varchar = any(event_types)
+  and owner_id = any(@viewer_ids::varchar[])
+  and (split_id = @split_id or split_id is null)
+order by created_at, id;
+
+-- name: InsertWebhookDelivery :one
+-- a webhook is only sent each event once, so this returns no rows if the event was already delivered
+insert into webhook_deliveries (id, webhook_id, event_id, event_type, payload)
+values (@id, @webhook_id, nullif(@event_id::varchar, ''), @event_type, @payload)
+on conflict (webhook_id, event_id) where event_id is not null and deleted = false do nothing
+returning *;
+
+-- name: GetWebhookDeliveryByID :one
+select * from webhook_deliveries where id = @id and deleted = false;
+
+-- name: RecordWebhookDeliveryAttempt :one
+update webhook_deliveries
+set attempts = attempts + 1,
+    status = @status,
+    response_status = @response_status,
+    error = @error,
+    next_attempt_at = @next_attempt_at,
+    completed_at = case when @status <> 'pending' then now() end,
+    last_updated = now()
+where id = @id and deleted = false
+returning *;
+
+-- name: GetWebhookDeliveriesPaginate :many
+select * from webhook_deliveries
+where webhook_id = @webhook_id
+  and deleted = false
+  and (created_at, id) < (@cur_before_time, @cur_before_id)
+  and (created_at, id) > (@cur_after_time, @cur_after_id)
+order by case when @paging_forward::bool then (created_at, id) end asc,
+         case when not @paging_forward::bool then (created_at, id) end desc
+limit @limit;
+
+-- name: CountWebhookDeliveries :one
+select count(*) from webhook_deliveries where webhook_id = $1 and deleted = false;
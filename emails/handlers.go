@@ -38,6 +38,11 @@ func handlersInitServer(router *gin.Engine, loaders *dataloader.Loaders, queries
 	verificationLimiter := limiters.NewKeyRateLimiter(limiterCtx, limiterCache, "verification", 1, time.Second*5)
 	sendGroup.POST("/verification", middleware.IPRateLimited(verificationLimiter), sendVerificationEmail(loaders, queries, s))
 
+	// Invites are only sent by the backend, which authenticates with the task secret
+	inviteAuthHandler := middleware.BasicHeaderAuthRequired(env.GetString("EMAILS_TASK_SECRET"))
+	recipientInviteLimiter := limiters.NewKeyRateLimiter(limiterCtx, limiterCache, "recipient-invite", 10, time.Second)
+	sendGroup.POST("/recipient-invite", inviteAuthHandler, middleware.IPRateLimited(recipientInviteLimiter), sendRecipientInviteEmail(queries, s))
+
 	router.POST("/subscriptions", updateSubscriptions(queries))
 	router.POST("/unsubscribe", unsubscribe(queries))
 	router.POST("/resubscribe", resubscribe(queries))
@@ -29,6 +29,7 @@ import (
 func init() {
 	env.RegisterValidation("FROM_EMAIL", "required", "email")
 	env.RegisterValidation("SENDGRID_VERIFICATION_TEMPLATE_ID", "required")
+	env.RegisterValidation("SENDGRID_RECIPIENT_INVITE_TEMPLATE_ID", "required")
 	env.RegisterValidation("PUBSUB_NOTIFICATIONS_EMAILS_SUBSCRIPTION", "required")
 	env.RegisterValidation("PUBSUB_DIGEST_EMAILS_SUBSCRIPTION", "required")
 
@@ -104,6 +105,79 @@ func sendVerificationEmail(dataloaders *dataloader.Loaders, queries *coredb.Quer
 	}
 }
 
+// recipientInviteLoginTokenTTL is how long the login link in an invite is valid for
+const recipientInviteLoginTokenTTL = 72 * time.Hour
+
+func sendRecipientInviteEmail(queries *coredb.Queries, s *sendgrid.Client) gin.HandlerFunc {
+
+	return func(c *gin.Context) {
+		var input emails.RecipientInviteEmailInput
+		err := c.ShouldBindJSON(&input)
+		if err != nil {
+			util.ErrResponse(c, http.StatusBadRequest, err)
+			return
+		}
+
+		invite, err := queries.GetRecipientInviteByID(c, input.InviteID)
+		if err != nil {
+			util.ErrResponse(c, http.StatusBadRequest, err)
+			return
+		}
+
+		if persist.RecipientInviteStatus(invite.Status) != persist.RecipientInviteStatusPending {
+			logger.For(c).Infof("not sending invite %s with status %s", invite.ID, invite.Status)
+			c.Status(http.StatusOK)
+			return
+		}
+
+		split, err := queries.GetSplitById(c, invite.SplitID)
+		if err != nil {
+			util.ErrResponse(c, http.StatusBadRequest, err)
+			return
+		}
+
+		inviter, err := queries.GetUserById(c, invite.InviterID)
+		if err != nil {
+			util.ErrResponse(c, http.StatusBadRequest, err)
+			return
+		}
+
+		data := map[string]interface{}{
+			"splitName":       split.Name,
+			"inviterUsername": inviter.Username.String,
+			"inviteId":        invite.ID,
+		}
+
+		// Invitees who already have an account get a link that logs them straight in
+		if invitee, err := queries.GetUserByVerifiedEmailAddress(c, invite.EmailAddress.String()); err == nil {
+			j, err := auth.GenerateOneTimeLoginToken(c, invitee.ID, "recipient_invite", recipientInviteLoginTokenTTL)
+			if err != nil {
+				util.ErrResponse(c, http.StatusInternalServerError, err)
+				return
+			}
+			data["loginToken"] = j
+		}
+
+		from := mail.NewEmail("SpltFi", env.GetString("FROM_EMAIL"))
+		to := mail.NewEmail("", invite.EmailAddress.String())
+		m := mail.NewV3Mail()
+		m.SetFrom(from)
+		p := mail.NewPersonalization()
+		m.SetTemplateID(env.GetString("SENDGRID_RECIPIENT_INVITE_TEMPLATE_ID"))
+		p.DynamicTemplateData = data
+		m.AddPersonalizations(p)
+		p.AddTos(to)
+
+		_, err = s.Send(m)
+		if err != nil {
+			util.ErrResponse(c, http.StatusInternalServerError, err)
+			return
+		}
+
+		c.Status(http.StatusOK)
+	}
+}
+
 type notificationsEmailDynamicTemplateData struct {
 	Notifications    []notifications.UserFacingNotificationData `json:"notifications"`
 	Username         string                                     `json:"username"`
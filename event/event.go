@@ -12,6 +12,7 @@ import (
 	sentryutil "github.com/SplitFi/go-splitfi/service/sentry"
 	"github.com/SplitFi/go-splitfi/service/task"
 	"github.com/SplitFi/go-splitfi/service/tracing"
+	"github.com/SplitFi/go-splitfi/service/webhook"
 	"github.com/SplitFi/go-splitfi/util"
 	"github.com/SplitFi/go-splitfi/validate"
 	"github.com/getsentry/sentry-go"
@@ -38,8 +39,23 @@ func AddTo(ctx *gin.Context, disableDataloaderCaching bool, notif *notifications
 	notificationHandler := newNotificationHandler(notif, disableDataloaderCaching, queries)
 	sender.addDelayedHandler(notifications, persist.ActionUserFollowedUsers, notificationHandler)
 	sender.addDelayedHandler(notifications, persist.ActionViewedSplit, notificationHandler)
+	sender.addGroupHandler(notifications, persist.ActionSplitUpdated, notificationHandler)
+
+	recipientsNotificationHandler := newSplitRecipientsNotificationHandler(notif, queries)
+	sender.addDelayedHandler(notifications, persist.ActionSplitDeletionRequested, recipientsNotificationHandler)
+	sender.addDelayedHandler(notifications, persist.ActionSplitDeleted, recipientsNotificationHandler)
+
+	watchersNotificationHandler := newSplitWatchersNotificationHandler(notif, queries)
+	sender.addDelayedHandler(notifications, persist.ActionSplitReceivedFunds, watchersNotificationHandler)
+
+	webhooks := newEventDispatcher()
+	webhookHandler := newWebhookHandler(queries, taskClient)
+	for action := range persist.WebhookEventTypes {
+		sender.addDelayedHandler(webhooks, action, webhookHandler)
+	}
 
 	sender.notifications = notifications
+	sender.webhooks = webhooks
 	ctx.Set(eventSenderContextKey, &sender)
 }
 
@@ -120,6 +136,7 @@ func dispatchDelayed(ctx context.Context, event db.Event) error {
 
 	eg, ctx := errgroup.WithContext(ctx)
 	eg.Go(func() error { return sender.notifications.dispatchDelayed(ctx, *persistedEvent) })
+	eg.Go(func() error { return sender.webhooks.dispatchDelayed(ctx, *persistedEvent) })
 	return eg.Wait()
 }
 
@@ -217,6 +234,7 @@ type registedActions map[persist.Action]struct{}
 
 type eventSender struct {
 	notifications *eventDispatcher
+	webhooks      *eventDispatcher
 	registry      map[sendType]registedActions
 	queries       *db.Queries
 	eventRepo     postgres.EventRepository
@@ -340,65 +358,114 @@ type groupHandler interface {
 
 // notificationHandlers handles events for consumption as notifications.
 type notificationHandler struct {
+	queries              *db.Queries
 	dataloaders          *dataloader.Loaders
 	notificationHandlers *notifications.NotificationHandlers
 }
 
 func newNotificationHandler(notifiers *notifications.NotificationHandlers, disableDataloaderCaching bool, queries *db.Queries) *notificationHandler {
 	return &notificationHandler{
+		queries:              queries,
 		notificationHandlers: notifiers,
 		dataloaders:          dataloader.NewLoaders(context.Background(), queries, disableDataloaderCaching, tracing.DataloaderPreFetchHook, tracing.DataloaderPostFetchHook),
 	}
 }
 
-func (h notificationHandler) handleDelayed(ctx context.Context, persistedEvent db.Event) error {
-	owner, err := h.findOwnerForNotificationFromEvent(ctx, persistedEvent)
+// handleGroup sends a single notification for every event in the group, so that an edit session
+// made up of several changes only notifies once
+func (h notificationHandler) handleGroup(ctx context.Context, groupID string, action persist.Action) (interface{}, error) {
+	events, err := h.queries.GetEventsInGroup(ctx, persist.StrPtrToNullStr(&groupID))
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// if no user found to notify, don't notify
-	if owner == "" {
-		return nil
+	if len(events) == 0 {
+		return nil, nil
 	}
 
-	// Don't notify the user on self events
-	if persist.DBID(persist.NullStrToStr(persistedEvent.ActorID)) == owner {
-		return nil
+	// The first event in the group is representative of the rest
+	first := events[0]
+
+	owners, err := h.findOwnersForNotificationFromEvent(ctx, first)
+	if err != nil {
+		return nil, err
+	}
+
+	eventIDs := make(persist.DBIDList, len(events))
+	for i, e := range events {
+		eventIDs[i] = e.ID
+	}
+
+	for _, owner := range owners {
+		// Don't notify the user on self events
+		if persist.DBID(persist.NullStrToStr(first.ActorID)) == owner {
+			continue
+		}
+
+		err = h.notificationHandlers.Notifications.Dispatch(ctx, db.Notification{
+			OwnerID:  owner,
+			Action:   action,
+			Data:     h.createNotificationDataForEvent(first),
+			EventIds: eventIDs,
+			SplitID:  first.SplitID,
+		})
+		if err != nil {
+			return nil, err
+		}
 	}
 
+	return events, nil
+}
+
+func (h notificationHandler) handleDelayed(ctx context.Context, persistedEvent db.Event) error {
 	// Don't notify the user on un-authed views
 	if persistedEvent.Action == persist.ActionViewedSplit && persistedEvent.ActorID.String == "" {
 		return nil
 	}
 
-	return h.notificationHandlers.Notifications.Dispatch(ctx, db.Notification{
-		OwnerID:  owner,
-		Action:   persistedEvent.Action,
-		Data:     h.createNotificationDataForEvent(persistedEvent),
-		EventIds: persist.DBIDList{persistedEvent.ID},
-		SplitID:  persistedEvent.SplitID,
-		//TokenID:  persistedEvent.TokenID,
-	})
+	owners, err := h.findOwnersForNotificationFromEvent(ctx, persistedEvent)
+	if err != nil {
+		return err
+	}
+
+	for _, owner := range owners {
+		// Don't notify the user on self events
+		if persist.DBID(persist.NullStrToStr(persistedEvent.ActorID)) == owner {
+			continue
+		}
+
+		err := h.notificationHandlers.Notifications.Dispatch(ctx, db.Notification{
+			OwnerID:  owner,
+			Action:   persistedEvent.Action,
+			Data:     h.createNotificationDataForEvent(persistedEvent),
+			EventIds: persist.DBIDList{persistedEvent.ID},
+			SplitID:  persistedEvent.SplitID,
+			//TokenID:  persistedEvent.TokenID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
-func (h notificationHandler) findOwnerForNotificationFromEvent(ctx context.Context, event db.Event) (persist.DBID, error) {
+// findOwnersForNotificationFromEvent returns the users to notify of an event. Events on a split notify everyone with
+// at least the viewer role on it.
+func (h notificationHandler) findOwnersForNotificationFromEvent(ctx context.Context, event db.Event) ([]persist.DBID, error) {
 	switch event.ResourceTypeID {
 	case persist.ResourceTypeSplit:
-		// TODO return the creator user id
-		//split, err := h.dataloaders.GetSplitByIdBatch.Load(event.SplitID)
-		//if err != nil {
-		//	return "", err
-		//}
-		// return split.CreatorAddress, nil
-		return persist.DBID("1"), nil
+		return h.queries.GetSplitViewerUserIDs(ctx, event.SplitID)
 	case persist.ResourceTypeUser:
-		return event.SubjectID, nil
+		return []persist.DBID{event.SubjectID}, nil
 	case persist.ResourceTypeToken:
-		return persist.DBID(event.ActorID.String), nil
+		if event.ActorID.String == "" {
+			return nil, nil
+		}
+		return []persist.DBID{persist.DBID(event.ActorID.String)}, nil
 	}
 
-	return "", fmt.Errorf("no owner found for event: %s", event.Action)
+	return nil, fmt.Errorf("no owner found for event: %s", event.Action)
 }
 
 func (h notificationHandler) createNotificationDataForEvent(event db.Event) (data persist.NotificationData) {
@@ -428,6 +495,100 @@ func (h notificationHandler) createNotificationDataForEvent(event db.Event) (dat
 	return
 }
 
+// splitRecipientsNotificationHandler notifies every user with a wallet that receives from the event's split
+type splitRecipientsNotificationHandler struct {
+	queries              *db.Queries
+	notificationHandlers *notifications.NotificationHandlers
+}
+
+func newSplitRecipientsNotificationHandler(notifiers *notifications.NotificationHandlers, queries *db.Queries) *splitRecipientsNotificationHandler {
+	return &splitRecipientsNotificationHandler{
+		queries:              queries,
+		notificationHandlers: notifiers,
+	}
+}
+
+func (h splitRecipientsNotificationHandler) handleDelayed(ctx context.Context, persistedEvent db.Event) error {
+	owners, err := h.queries.GetRecipientUserIDsBySplitID(ctx, persistedEvent.SplitID)
+	if err != nil {
+		return err
+	}
+
+	data := persist.NotificationData{SplitName: util.FromPointer(persistedEvent.Data.SplitName)}
+
+	for _, owner := range owners {
+		// Don't notify the user on self events
+		if persist.DBID(persist.NullStrToStr(persistedEvent.ActorID)) == owner {
+			continue
+		}
+
+		err := h.notificationHandlers.Notifications.Dispatch(ctx, db.Notification{
+			OwnerID:  owner,
+			Action:   persistedEvent.Action,
+			Data:     data,
+			EventIds: persist.DBIDList{persistedEvent.ID},
+			SplitID:  persistedEvent.SplitID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// splitWatchersNotificationHandler notifies every user who follows the event's split, receives from it or has a role on it
+type splitWatchersNotificationHandler struct {
+	queries              *db.Queries
+	notificationHandlers *notifications.NotificationHandlers
+}
+
+func newSplitWatchersNotificationHandler(notifiers *notifications.NotificationHandlers, queries *db.Queries) *splitWatchersNotificationHandler {
+	return &splitWatchersNotificationHandler{
+		queries:              queries,
+		notificationHandlers: notifiers,
+	}
+}
+
+func (h splitWatchersNotificationHandler) handleDelayed(ctx context.Context, persistedEvent db.Event) error {
+	owners, err := h.queries.GetSplitWatcherUserIDs(ctx, persistedEvent.SplitID)
+	if err != nil {
+		return err
+	}
+
+	if len(owners) == 0 {
+		return nil
+	}
+
+	split, err := h.queries.GetSplitById(ctx, persistedEvent.SplitID)
+	if err != nil {
+		return err
+	}
+
+	data := persist.NotificationData{SplitName: split.Name}
+
+	for _, owner := range owners {
+		// Don't notify the user on self events
+		if persist.DBID(persist.NullStrToStr(persistedEvent.ActorID)) == owner {
+			continue
+		}
+
+		err := h.notificationHandlers.Notifications.Dispatch(ctx, db.Notification{
+			OwnerID:  owner,
+			Action:   persistedEvent.Action,
+			Data:     data,
+			EventIds: persist.DBIDList{persistedEvent.ID},
+			SplitID:  persistedEvent.SplitID,
+			Amount:   int32(persistedEvent.Data.SplitInflowCount),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // followerNotificationHandler handles events for consumption as notifications.
 type followerNotificationHandler struct {
 	notificationHandlers *notifications.NotificationHandlers
@@ -470,3 +631,20 @@ func (h announcementNotificationHandler) handleDelayed(ctx context.Context, pers
 		},
 	})
 }
+
+// webhookHandler sends events to the webhooks subscribed to them
+type webhookHandler struct {
+	queries    *db.Queries
+	taskClient *task.Client
+}
+
+func newWebhookHandler(queries *db.Queries, taskClient *task.Client) *webhookHandler {
+	return &webhookHandler{
+		queries:    queries,
+		taskClient: taskClient,
+	}
+}
+
+func (h webhookHandler) handleDelayed(ctx context.Context, persistedEvent db.Event) error {
+	return webhook.DispatchEvent(ctx, h.queries, h.taskClient, persistedEvent)
+}
@@ -14,6 +14,7 @@ import (
 )
 
 type Loaders struct {
+	GetContactByOwnerChainAddressBatch  *GetContactByOwnerChainAddressBatch
 	GetNotificationByIDBatch            *GetNotificationByIDBatch
 	GetSplitByChainAddressBatch         *GetSplitByChainAddressBatch
 	GetSplitByIdBatch                   *GetSplitByIdBatch
@@ -31,6 +32,7 @@ type Loaders struct {
 func NewLoaders(ctx context.Context, q *coredb.Queries, disableCaching bool, preFetchHook PreFetchHook, postFetchHook PostFetchHook) *Loaders {
 	loaders := &Loaders{}
 
+	loaders.GetContactByOwnerChainAddressBatch = newGetContactByOwnerChainAddressBatch(ctx, 100, time.Duration(2000000), !disableCaching, true, loadGetContactByOwnerChainAddressBatch(q), preFetchHook, postFetchHook)
 	loaders.GetNotificationByIDBatch = newGetNotificationByIDBatch(ctx, 100, time.Duration(2000000), !disableCaching, true, loadGetNotificationByIDBatch(q), preFetchHook, postFetchHook)
 	loaders.GetSplitByChainAddressBatch = newGetSplitByChainAddressBatch(ctx, 100, time.Duration(2000000), !disableCaching, true, loadGetSplitByChainAddressBatch(q), preFetchHook, postFetchHook)
 	loaders.GetSplitByIdBatch = newGetSplitByIdBatch(ctx, 100, time.Duration(2000000), !disableCaching, true, loadGetSplitByIdBatch(q), preFetchHook, postFetchHook)
@@ -98,6 +100,25 @@ func NewLoaders(ctx context.Context, q *coredb.Queries, disableCaching bool, pre
 	return loaders
 }
 
+func loadGetContactByOwnerChainAddressBatch(q *coredb.Queries) func(context.Context, *GetContactByOwnerChainAddressBatch, []coredb.GetContactByOwnerChainAddressBatchParams) ([]coredb.Contact, []error) {
+	return func(ctx context.Context, d *GetContactByOwnerChainAddressBatch, params []coredb.GetContactByOwnerChainAddressBatchParams) ([]coredb.Contact, []error) {
+		results := make([]coredb.Contact, len(params))
+		errors := make([]error, len(params))
+
+		b := q.GetContactByOwnerChainAddressBatch(ctx, params)
+		defer b.Close()
+
+		b.QueryRow(func(i int, r coredb.Contact, err error) {
+			results[i], errors[i] = r, err
+			if errors[i] == pgx.ErrNoRows {
+				errors[i] = d.getNotFoundError(params[i])
+			}
+		})
+
+		return results, errors
+	}
+}
+
 func loadGetNotificationByIDBatch(q *coredb.Queries) func(context.Context, *GetNotificationByIDBatch, []persist.DBID) ([]coredb.Notification, []error) {
 	return func(ctx context.Context, d *GetNotificationByIDBatch, params []persist.DBID) ([]coredb.Notification, []error) {
 		results := make([]coredb.Notification, len(params))
@@ -28,6 +28,43 @@ type notFoundErrorProvider[TKey any] interface {
 type PreFetchHook func(context.Context, string) context.Context
 type PostFetchHook func(context.Context, string)
 
+// GetContactByOwnerChainAddressBatch batches and caches requests
+type GetContactByOwnerChainAddressBatch struct {
+	generator.Dataloader[coredb.GetContactByOwnerChainAddressBatchParams, coredb.Contact]
+}
+
+// newGetContactByOwnerChainAddressBatch creates a new GetContactByOwnerChainAddressBatch with the given settings, functions, and options
+func newGetContactByOwnerChainAddressBatch(
+	ctx context.Context,
+	maxBatchSize int,
+	batchTimeout time.Duration,
+	cacheResults bool,
+	publishResults bool,
+	fetch func(context.Context, *GetContactByOwnerChainAddressBatch, []coredb.GetContactByOwnerChainAddressBatchParams) ([]coredb.Contact, []error),
+	preFetchHook PreFetchHook,
+	postFetchHook PostFetchHook,
+) *GetContactByOwnerChainAddressBatch {
+	d := &GetContactByOwnerChainAddressBatch{}
+
+	fetchWithHooks := func(ctx context.Context, keys []coredb.GetContactByOwnerChainAddressBatchParams) ([]coredb.Contact, []error) {
+		// Allow the preFetchHook to modify and return a new context
+		if preFetchHook != nil {
+			ctx = preFetchHook(ctx, "GetContactByOwnerChainAddressBatch")
+		}
+
+		results, errors := fetch(ctx, d, keys)
+
+		if postFetchHook != nil {
+			postFetchHook(ctx, "GetContactByOwnerChainAddressBatch")
+		}
+
+		return results, errors
+	}
+
+	d.Dataloader = *generator.NewDataloader(ctx, maxBatchSize, batchTimeout, cacheResults, publishResults, fetchWithHooks)
+	return d
+}
+
 // GetNotificationByIDBatch batches and caches requests
 type GetNotificationByIDBatch struct {
 	generator.Dataloader[persist.DBID, coredb.Notification]
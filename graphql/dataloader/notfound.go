@@ -14,6 +14,10 @@ func (*GetSplitByChainAddressBatch) getNotFoundError(key coredb.GetSplitByChainA
 	return persist.ErrSplitNotFoundByAddress{Address: key.Address}
 }
 
+func (*GetContactByOwnerChainAddressBatch) getNotFoundError(key coredb.GetContactByOwnerChainAddressBatchParams) error {
+	return pgx.ErrNoRows
+}
+
 func (*GetNotificationByIDBatch) getNotFoundError(key persist.DBID) error {
 	return pgx.ErrNoRows
 }
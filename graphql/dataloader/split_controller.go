@@ -0,0 +1,33 @@
+package dataloader
+
+import (
+	"context"
+	"time"
+
+	"github.com/SplitFi/go-splitfi/cmd/dataloaders/generator"
+	"github.com/SplitFi/go-splitfi/service/persist"
+)
+
+// GetSplitControllerBatch batches and caches lookups of the address controlling a deployed split, keyed by the
+// split's address. Controllers are read from chain rather than the database, so it isn't generated with the other
+// loaders; it's created per request alongside them so that checking a user's role on a split several times only
+// reads the chain once.
+type GetSplitControllerBatch struct {
+	generator.Dataloader[persist.Address, persist.Address]
+}
+
+// NewGetSplitControllerBatch creates a new GetSplitControllerBatch that looks up each controller with fetch
+func NewGetSplitControllerBatch(ctx context.Context, disableCaching bool, fetch func(context.Context, persist.Address) (persist.Address, error)) *GetSplitControllerBatch {
+	fetchAll := func(ctx context.Context, keys []persist.Address) ([]persist.Address, []error) {
+		results := make([]persist.Address, len(keys))
+		errors := make([]error, len(keys))
+		for i, key := range keys {
+			results[i], errors[i] = fetch(ctx, key)
+		}
+		return results, errors
+	}
+
+	return &GetSplitControllerBatch{
+		Dataloader: *generator.NewDataloader(ctx, 100, time.Duration(2000000), !disableCaching, false, fetchAll),
+	}
+}
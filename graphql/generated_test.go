@@ -84,6 +84,38 @@ func (v *CreateSplitInput) GetDescription() *string { return v.Description }
 // GetLogo returns CreateSplitInput.Logo, and is useful for accessing the field via an interface.
 func (v *CreateSplitInput) GetLogo() *string { return v.Logo }
 
+type CreateSplitTemplateInput struct {
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+	LogoURL     *string `json:"logoURL"`
+	BannerURL   *string `json:"bannerURL"`
+	BadgeURL    *string `json:"badgeURL"`
+	// In parts per million, at most 1000000. Recipients' ownership must add up to exactly this amount.
+	TotalOwnership int                           `json:"totalOwnership"`
+	Recipients     []SplitTemplateRecipientInput `json:"recipients"`
+}
+
+// GetName returns CreateSplitTemplateInput.Name, and is useful for accessing the field via an interface.
+func (v *CreateSplitTemplateInput) GetName() string { return v.Name }
+
+// GetDescription returns CreateSplitTemplateInput.Description, and is useful for accessing the field via an interface.
+func (v *CreateSplitTemplateInput) GetDescription() *string { return v.Description }
+
+// GetLogoURL returns CreateSplitTemplateInput.LogoURL, and is useful for accessing the field via an interface.
+func (v *CreateSplitTemplateInput) GetLogoURL() *string { return v.LogoURL }
+
+// GetBannerURL returns CreateSplitTemplateInput.BannerURL, and is useful for accessing the field via an interface.
+func (v *CreateSplitTemplateInput) GetBannerURL() *string { return v.BannerURL }
+
+// GetBadgeURL returns CreateSplitTemplateInput.BadgeURL, and is useful for accessing the field via an interface.
+func (v *CreateSplitTemplateInput) GetBadgeURL() *string { return v.BadgeURL }
+
+// GetTotalOwnership returns CreateSplitTemplateInput.TotalOwnership, and is useful for accessing the field via an interface.
+func (v *CreateSplitTemplateInput) GetTotalOwnership() int { return v.TotalOwnership }
+
+// GetRecipients returns CreateSplitTemplateInput.Recipients, and is useful for accessing the field via an interface.
+func (v *CreateSplitTemplateInput) GetRecipients() []SplitTemplateRecipientInput { return v.Recipients }
+
 type CreateUserInput struct {
 	Username *string `json:"username"`
 	Email    *string `json:"email"`
@@ -95,6 +127,21 @@ func (v *CreateUserInput) GetUsername() *string { return v.Username }
 // GetEmail returns CreateUserInput.Email, and is useful for accessing the field via an interface.
 func (v *CreateUserInput) GetEmail() *string { return v.Email }
 
+type CreateWebhookInput struct {
+	Url        string             `json:"url"`
+	SplitId    *persist.DBID      `json:"splitId"`
+	EventTypes []WebhookEventType `json:"eventTypes"`
+}
+
+// GetUrl returns CreateWebhookInput.Url, and is useful for accessing the field via an interface.
+func (v *CreateWebhookInput) GetUrl() string { return v.Url }
+
+// GetSplitId returns CreateWebhookInput.SplitId, and is useful for accessing the field via an interface.
+func (v *CreateWebhookInput) GetSplitId() *persist.DBID { return v.SplitId }
+
+// GetEventTypes returns CreateWebhookInput.EventTypes, and is useful for accessing the field via an interface.
+func (v *CreateWebhookInput) GetEventTypes() []WebhookEventType { return v.EventTypes }
+
 type DebugAuth struct {
 	AsUsername         *string             `json:"asUsername"`
 	UserId             *persist.DBID       `json:"userId"`
@@ -133,6 +180,29 @@ func (v *EoaAuth) GetMessage() string { return v.Message }
 // GetSignature returns EoaAuth.Signature, and is useful for accessing the field via an interface.
 func (v *EoaAuth) GetSignature() string { return v.Signature }
 
+type ExportLedgerInput struct {
+	SplitId          *persist.DBID      `json:"splitId"`
+	RecipientAddress *string            `json:"recipientAddress"`
+	From             string             `json:"from"`
+	To               string             `json:"to"`
+	Format           LedgerExportFormat `json:"format"`
+}
+
+// GetSplitId returns ExportLedgerInput.SplitId, and is useful for accessing the field via an interface.
+func (v *ExportLedgerInput) GetSplitId() *persist.DBID { return v.SplitId }
+
+// GetRecipientAddress returns ExportLedgerInput.RecipientAddress, and is useful for accessing the field via an interface.
+func (v *ExportLedgerInput) GetRecipientAddress() *string { return v.RecipientAddress }
+
+// GetFrom returns ExportLedgerInput.From, and is useful for accessing the field via an interface.
+func (v *ExportLedgerInput) GetFrom() string { return v.From }
+
+// GetTo returns ExportLedgerInput.To, and is useful for accessing the field via an interface.
+func (v *ExportLedgerInput) GetTo() string { return v.To }
+
+// GetFormat returns ExportLedgerInput.Format, and is useful for accessing the field via an interface.
+func (v *ExportLedgerInput) GetFormat() LedgerExportFormat { return v.Format }
+
 type GnosisSafeAuth struct {
 	Address string `json:"address"`
 	Nonce   string `json:"nonce"`
@@ -148,6 +218,40 @@ func (v *GnosisSafeAuth) GetNonce() string { return v.Nonce }
 // GetMessage returns GnosisSafeAuth.Message, and is useful for accessing the field via an interface.
 func (v *GnosisSafeAuth) GetMessage() string { return v.Message }
 
+type GrantSplitRoleInput struct {
+	SplitId persist.DBID `json:"splitId"`
+	UserId  persist.DBID `json:"userId"`
+	Role    SplitRole    `json:"role"`
+}
+
+// GetSplitId returns GrantSplitRoleInput.SplitId, and is useful for accessing the field via an interface.
+func (v *GrantSplitRoleInput) GetSplitId() persist.DBID { return v.SplitId }
+
+// GetUserId returns GrantSplitRoleInput.UserId, and is useful for accessing the field via an interface.
+func (v *GrantSplitRoleInput) GetUserId() persist.DBID { return v.UserId }
+
+// GetRole returns GrantSplitRoleInput.Role, and is useful for accessing the field via an interface.
+func (v *GrantSplitRoleInput) GetRole() SplitRole { return v.Role }
+
+// An amount of a token, in base units, that a simulated split receives on top of its current balances
+type HypotheticalInflowInput struct {
+	TokenAddress string `json:"tokenAddress"`
+	Amount       string `json:"amount"`
+}
+
+// GetTokenAddress returns HypotheticalInflowInput.TokenAddress, and is useful for accessing the field via an interface.
+func (v *HypotheticalInflowInput) GetTokenAddress() string { return v.TokenAddress }
+
+// GetAmount returns HypotheticalInflowInput.Amount, and is useful for accessing the field via an interface.
+func (v *HypotheticalInflowInput) GetAmount() string { return v.Amount }
+
+type LedgerExportFormat string
+
+const (
+	LedgerExportFormatCsv  LedgerExportFormat = "CSV"
+	LedgerExportFormatJson LedgerExportFormat = "JSON"
+)
+
 type MagicLinkAuth struct {
 	Token string `json:"token"`
 }
@@ -184,6 +288,49 @@ func (v *PublishSplitInput) GetEditId() string { return v.EditId }
 // GetCaption returns PublishSplitInput.Caption, and is useful for accessing the field via an interface.
 func (v *PublishSplitInput) GetCaption() *string { return v.Caption }
 
+type RevokeSplitRoleInput struct {
+	SplitId persist.DBID `json:"splitId"`
+	UserId  persist.DBID `json:"userId"`
+}
+
+// GetSplitId returns RevokeSplitRoleInput.SplitId, and is useful for accessing the field via an interface.
+func (v *RevokeSplitRoleInput) GetSplitId() persist.DBID { return v.SplitId }
+
+// GetUserId returns RevokeSplitRoleInput.UserId, and is useful for accessing the field via an interface.
+func (v *RevokeSplitRoleInput) GetUserId() persist.DBID { return v.UserId }
+
+// Sets a recipient's ownership in a simulated change to a split, in parts per million
+type SimulatedShareInput struct {
+	RecipientAddress string `json:"recipientAddress"`
+	Ownership        int    `json:"ownership"`
+}
+
+// GetRecipientAddress returns SimulatedShareInput.RecipientAddress, and is useful for accessing the field via an interface.
+func (v *SimulatedShareInput) GetRecipientAddress() string { return v.RecipientAddress }
+
+// GetOwnership returns SimulatedShareInput.Ownership, and is useful for accessing the field via an interface.
+func (v *SimulatedShareInput) GetOwnership() int { return v.Ownership }
+
+type SplitRole string
+
+const (
+	SplitRoleViewer     SplitRole = "VIEWER"
+	SplitRoleEditor     SplitRole = "EDITOR"
+	SplitRoleController SplitRole = "CONTROLLER"
+)
+
+type SplitTemplateRecipientInput struct {
+	Address string `json:"address"`
+	// In parts per million. Must be greater than zero.
+	Ownership int `json:"ownership"`
+}
+
+// GetAddress returns SplitTemplateRecipientInput.Address, and is useful for accessing the field via an interface.
+func (v *SplitTemplateRecipientInput) GetAddress() string { return v.Address }
+
+// GetOwnership returns SplitTemplateRecipientInput.Ownership, and is useful for accessing the field via an interface.
+func (v *SplitTemplateRecipientInput) GetOwnership() int { return v.Ownership }
+
 type UpdateSplitInput struct {
 	SplitId     persist.DBID   `json:"splitId"`
 	Name        *string        `json:"name"`
@@ -227,6 +374,16 @@ const (
 	UserExperienceTypeUpsellmintmemento4                UserExperienceType = "UpsellMintMemento4"
 )
 
+type WebhookEventType string
+
+const (
+	WebhookEventTypeInflow             WebhookEventType = "INFLOW"
+	WebhookEventTypeDistribution       WebhookEventType = "DISTRIBUTION"
+	WebhookEventTypeRecipientsChanged  WebhookEventType = "RECIPIENTS_CHANGED"
+	WebhookEventTypeControllersChanged WebhookEventType = "CONTROLLERS_CHANGED"
+	WebhookEventTypePing               WebhookEventType = "PING"
+)
+
 // __addUserWalletMutationInput is used internally by genqlient
 type __addUserWalletMutationInput struct {
 	ChainAddress  ChainAddressInput `json:"chainAddress"`
@@ -239,6 +396,18 @@ func (v *__addUserWalletMutationInput) GetChainAddress() ChainAddressInput { ret
 // GetAuthMechanism returns __addUserWalletMutationInput.AuthMechanism, and is useful for accessing the field via an interface.
 func (v *__addUserWalletMutationInput) GetAuthMechanism() AuthMechanism { return v.AuthMechanism }
 
+// __createSplitFromTemplateMutationInput is used internally by genqlient
+type __createSplitFromTemplateMutationInput struct {
+	TemplateId persist.DBID `json:"templateId"`
+	Chain      Chain        `json:"chain"`
+}
+
+// GetTemplateId returns __createSplitFromTemplateMutationInput.TemplateId, and is useful for accessing the field via an interface.
+func (v *__createSplitFromTemplateMutationInput) GetTemplateId() persist.DBID { return v.TemplateId }
+
+// GetChain returns __createSplitFromTemplateMutationInput.Chain, and is useful for accessing the field via an interface.
+func (v *__createSplitFromTemplateMutationInput) GetChain() Chain { return v.Chain }
+
 // __createSplitMutationInput is used internally by genqlient
 type __createSplitMutationInput struct {
 	Input CreateSplitInput `json:"input"`
@@ -247,6 +416,14 @@ type __createSplitMutationInput struct {
 // GetInput returns __createSplitMutationInput.Input, and is useful for accessing the field via an interface.
 func (v *__createSplitMutationInput) GetInput() CreateSplitInput { return v.Input }
 
+// __createSplitTemplateMutationInput is used internally by genqlient
+type __createSplitTemplateMutationInput struct {
+	Input CreateSplitTemplateInput `json:"input"`
+}
+
+// GetInput returns __createSplitTemplateMutationInput.Input, and is useful for accessing the field via an interface.
+func (v *__createSplitTemplateMutationInput) GetInput() CreateSplitTemplateInput { return v.Input }
+
 // __createUserMutationInput is used internally by genqlient
 type __createUserMutationInput struct {
 	AuthMechanism AuthMechanism   `json:"authMechanism"`
@@ -259,6 +436,30 @@ func (v *__createUserMutationInput) GetAuthMechanism() AuthMechanism { return v.
 // GetInput returns __createUserMutationInput.Input, and is useful for accessing the field via an interface.
 func (v *__createUserMutationInput) GetInput() CreateUserInput { return v.Input }
 
+// __createWebhookMutationInput is used internally by genqlient
+type __createWebhookMutationInput struct {
+	Input CreateWebhookInput `json:"input"`
+}
+
+// GetInput returns __createWebhookMutationInput.Input, and is useful for accessing the field via an interface.
+func (v *__createWebhookMutationInput) GetInput() CreateWebhookInput { return v.Input }
+
+// __exportLedgerMutationInput is used internally by genqlient
+type __exportLedgerMutationInput struct {
+	Input ExportLedgerInput `json:"input"`
+}
+
+// GetInput returns __exportLedgerMutationInput.Input, and is useful for accessing the field via an interface.
+func (v *__exportLedgerMutationInput) GetInput() ExportLedgerInput { return v.Input }
+
+// __grantSplitRoleMutationInput is used internally by genqlient
+type __grantSplitRoleMutationInput struct {
+	Input GrantSplitRoleInput `json:"input"`
+}
+
+// GetInput returns __grantSplitRoleMutationInput.Input, and is useful for accessing the field via an interface.
+func (v *__grantSplitRoleMutationInput) GetInput() GrantSplitRoleInput { return v.Input }
+
 // __loginMutationInput is used internally by genqlient
 type __loginMutationInput struct {
 	AuthMechanism AuthMechanism `json:"authMechanism"`
@@ -291,6 +492,32 @@ type __removeUserWalletsMutationInput struct {
 // GetWalletIds returns __removeUserWalletsMutationInput.WalletIds, and is useful for accessing the field via an interface.
 func (v *__removeUserWalletsMutationInput) GetWalletIds() []persist.DBID { return v.WalletIds }
 
+// __revokeSplitRoleMutationInput is used internally by genqlient
+type __revokeSplitRoleMutationInput struct {
+	Input RevokeSplitRoleInput `json:"input"`
+}
+
+// GetInput returns __revokeSplitRoleMutationInput.Input, and is useful for accessing the field via an interface.
+func (v *__revokeSplitRoleMutationInput) GetInput() RevokeSplitRoleInput { return v.Input }
+
+// __simulateSplitChangeQueryInput is used internally by genqlient
+type __simulateSplitChangeQueryInput struct {
+	SplitId             persist.DBID              `json:"splitId"`
+	NewShares           []SimulatedShareInput     `json:"newShares"`
+	HypotheticalInflows []HypotheticalInflowInput `json:"hypotheticalInflows"`
+}
+
+// GetSplitId returns __simulateSplitChangeQueryInput.SplitId, and is useful for accessing the field via an interface.
+func (v *__simulateSplitChangeQueryInput) GetSplitId() persist.DBID { return v.SplitId }
+
+// GetNewShares returns __simulateSplitChangeQueryInput.NewShares, and is useful for accessing the field via an interface.
+func (v *__simulateSplitChangeQueryInput) GetNewShares() []SimulatedShareInput { return v.NewShares }
+
+// GetHypotheticalInflows returns __simulateSplitChangeQueryInput.HypotheticalInflows, and is useful for accessing the field via an interface.
+func (v *__simulateSplitChangeQueryInput) GetHypotheticalInflows() []HypotheticalInflowInput {
+	return v.HypotheticalInflows
+}
+
 // __updateSplitMutationInput is used internally by genqlient
 type __updateSplitMutationInput struct {
 	Input UpdateSplitInput `json:"input"`
@@ -646,6 +873,227 @@ func (v *addUserWalletMutationResponse) __premarshalJSON() (*__premarshaladdUser
 	return &retval, nil
 }
 
+// createSplitFromTemplateMutationCreateSplitFromTemplateCreateSplitPayload includes the requested fields of the GraphQL type CreateSplitPayload.
+type createSplitFromTemplateMutationCreateSplitFromTemplateCreateSplitPayload struct {
+	Typename *string                                                                        `json:"__typename"`
+	Split    *createSplitFromTemplateMutationCreateSplitFromTemplateCreateSplitPayloadSplit `json:"split"`
+}
+
+// GetTypename returns createSplitFromTemplateMutationCreateSplitFromTemplateCreateSplitPayload.Typename, and is useful for accessing the field via an interface.
+func (v *createSplitFromTemplateMutationCreateSplitFromTemplateCreateSplitPayload) GetTypename() *string {
+	return v.Typename
+}
+
+// GetSplit returns createSplitFromTemplateMutationCreateSplitFromTemplateCreateSplitPayload.Split, and is useful for accessing the field via an interface.
+func (v *createSplitFromTemplateMutationCreateSplitFromTemplateCreateSplitPayload) GetSplit() *createSplitFromTemplateMutationCreateSplitFromTemplateCreateSplitPayloadSplit {
+	return v.Split
+}
+
+// createSplitFromTemplateMutationCreateSplitFromTemplateCreateSplitPayloadOrError includes the requested fields of the GraphQL interface CreateSplitPayloadOrError.
+//
+// createSplitFromTemplateMutationCreateSplitFromTemplateCreateSplitPayloadOrError is implemented by the following types:
+// createSplitFromTemplateMutationCreateSplitFromTemplateCreateSplitPayload
+// createSplitFromTemplateMutationCreateSplitFromTemplateErrInvalidInput
+// createSplitFromTemplateMutationCreateSplitFromTemplateErrNotAuthorized
+type createSplitFromTemplateMutationCreateSplitFromTemplateCreateSplitPayloadOrError interface {
+	implementsGraphQLInterfacecreateSplitFromTemplateMutationCreateSplitFromTemplateCreateSplitPayloadOrError()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() *string
+}
+
+func (v *createSplitFromTemplateMutationCreateSplitFromTemplateCreateSplitPayload) implementsGraphQLInterfacecreateSplitFromTemplateMutationCreateSplitFromTemplateCreateSplitPayloadOrError() {
+}
+func (v *createSplitFromTemplateMutationCreateSplitFromTemplateErrInvalidInput) implementsGraphQLInterfacecreateSplitFromTemplateMutationCreateSplitFromTemplateCreateSplitPayloadOrError() {
+}
+func (v *createSplitFromTemplateMutationCreateSplitFromTemplateErrNotAuthorized) implementsGraphQLInterfacecreateSplitFromTemplateMutationCreateSplitFromTemplateCreateSplitPayloadOrError() {
+}
+
+func __unmarshalcreateSplitFromTemplateMutationCreateSplitFromTemplateCreateSplitPayloadOrError(b []byte, v *createSplitFromTemplateMutationCreateSplitFromTemplateCreateSplitPayloadOrError) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "CreateSplitPayload":
+		*v = new(createSplitFromTemplateMutationCreateSplitFromTemplateCreateSplitPayload)
+		return json.Unmarshal(b, *v)
+	case "ErrInvalidInput":
+		*v = new(createSplitFromTemplateMutationCreateSplitFromTemplateErrInvalidInput)
+		return json.Unmarshal(b, *v)
+	case "ErrNotAuthorized":
+		*v = new(createSplitFromTemplateMutationCreateSplitFromTemplateErrNotAuthorized)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing CreateSplitPayloadOrError.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for createSplitFromTemplateMutationCreateSplitFromTemplateCreateSplitPayloadOrError: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalcreateSplitFromTemplateMutationCreateSplitFromTemplateCreateSplitPayloadOrError(v *createSplitFromTemplateMutationCreateSplitFromTemplateCreateSplitPayloadOrError) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *createSplitFromTemplateMutationCreateSplitFromTemplateCreateSplitPayload:
+		typename = "CreateSplitPayload"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*createSplitFromTemplateMutationCreateSplitFromTemplateCreateSplitPayload
+		}{typename, v}
+		return json.Marshal(result)
+	case *createSplitFromTemplateMutationCreateSplitFromTemplateErrInvalidInput:
+		typename = "ErrInvalidInput"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*createSplitFromTemplateMutationCreateSplitFromTemplateErrInvalidInput
+		}{typename, v}
+		return json.Marshal(result)
+	case *createSplitFromTemplateMutationCreateSplitFromTemplateErrNotAuthorized:
+		typename = "ErrNotAuthorized"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*createSplitFromTemplateMutationCreateSplitFromTemplateErrNotAuthorized
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for createSplitFromTemplateMutationCreateSplitFromTemplateCreateSplitPayloadOrError: "%T"`, v)
+	}
+}
+
+// createSplitFromTemplateMutationCreateSplitFromTemplateCreateSplitPayloadSplit includes the requested fields of the GraphQL type Split.
+type createSplitFromTemplateMutationCreateSplitFromTemplateCreateSplitPayloadSplit struct {
+	Dbid persist.DBID `json:"dbid"`
+}
+
+// GetDbid returns createSplitFromTemplateMutationCreateSplitFromTemplateCreateSplitPayloadSplit.Dbid, and is useful for accessing the field via an interface.
+func (v *createSplitFromTemplateMutationCreateSplitFromTemplateCreateSplitPayloadSplit) GetDbid() persist.DBID {
+	return v.Dbid
+}
+
+// createSplitFromTemplateMutationCreateSplitFromTemplateErrInvalidInput includes the requested fields of the GraphQL type ErrInvalidInput.
+type createSplitFromTemplateMutationCreateSplitFromTemplateErrInvalidInput struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns createSplitFromTemplateMutationCreateSplitFromTemplateErrInvalidInput.Typename, and is useful for accessing the field via an interface.
+func (v *createSplitFromTemplateMutationCreateSplitFromTemplateErrInvalidInput) GetTypename() *string {
+	return v.Typename
+}
+
+// GetMessage returns createSplitFromTemplateMutationCreateSplitFromTemplateErrInvalidInput.Message, and is useful for accessing the field via an interface.
+func (v *createSplitFromTemplateMutationCreateSplitFromTemplateErrInvalidInput) GetMessage() string {
+	return v.Message
+}
+
+// createSplitFromTemplateMutationCreateSplitFromTemplateErrNotAuthorized includes the requested fields of the GraphQL type ErrNotAuthorized.
+type createSplitFromTemplateMutationCreateSplitFromTemplateErrNotAuthorized struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns createSplitFromTemplateMutationCreateSplitFromTemplateErrNotAuthorized.Typename, and is useful for accessing the field via an interface.
+func (v *createSplitFromTemplateMutationCreateSplitFromTemplateErrNotAuthorized) GetTypename() *string {
+	return v.Typename
+}
+
+// GetMessage returns createSplitFromTemplateMutationCreateSplitFromTemplateErrNotAuthorized.Message, and is useful for accessing the field via an interface.
+func (v *createSplitFromTemplateMutationCreateSplitFromTemplateErrNotAuthorized) GetMessage() string {
+	return v.Message
+}
+
+// createSplitFromTemplateMutationResponse is returned by createSplitFromTemplateMutation on success.
+type createSplitFromTemplateMutationResponse struct {
+	CreateSplitFromTemplate *createSplitFromTemplateMutationCreateSplitFromTemplateCreateSplitPayloadOrError `json:"-"`
+}
+
+// GetCreateSplitFromTemplate returns createSplitFromTemplateMutationResponse.CreateSplitFromTemplate, and is useful for accessing the field via an interface.
+func (v *createSplitFromTemplateMutationResponse) GetCreateSplitFromTemplate() *createSplitFromTemplateMutationCreateSplitFromTemplateCreateSplitPayloadOrError {
+	return v.CreateSplitFromTemplate
+}
+
+func (v *createSplitFromTemplateMutationResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*createSplitFromTemplateMutationResponse
+		CreateSplitFromTemplate json.RawMessage `json:"createSplitFromTemplate"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.createSplitFromTemplateMutationResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.CreateSplitFromTemplate
+		src := firstPass.CreateSplitFromTemplate
+		if len(src) != 0 && string(src) != "null" {
+			*dst = new(createSplitFromTemplateMutationCreateSplitFromTemplateCreateSplitPayloadOrError)
+			err = __unmarshalcreateSplitFromTemplateMutationCreateSplitFromTemplateCreateSplitPayloadOrError(
+				src, *dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal createSplitFromTemplateMutationResponse.CreateSplitFromTemplate: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalcreateSplitFromTemplateMutationResponse struct {
+	CreateSplitFromTemplate json.RawMessage `json:"createSplitFromTemplate"`
+}
+
+func (v *createSplitFromTemplateMutationResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *createSplitFromTemplateMutationResponse) __premarshalJSON() (*__premarshalcreateSplitFromTemplateMutationResponse, error) {
+	var retval __premarshalcreateSplitFromTemplateMutationResponse
+
+	{
+
+		dst := &retval.CreateSplitFromTemplate
+		src := v.CreateSplitFromTemplate
+		if src != nil {
+			var err error
+			*dst, err = __marshalcreateSplitFromTemplateMutationCreateSplitFromTemplateCreateSplitPayloadOrError(
+				src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"unable to marshal createSplitFromTemplateMutationResponse.CreateSplitFromTemplate: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
 // createSplitMutationCreateSplitCreateSplitPayload includes the requested fields of the GraphQL type CreateSplitPayload.
 type createSplitMutationCreateSplitCreateSplitPayload struct {
 	Typename *string                                                `json:"__typename"`
@@ -865,38 +1313,259 @@ func (v *createSplitMutationResponse) __premarshalJSON() (*__premarshalcreateSpl
 	return &retval, nil
 }
 
-// createUserMutationCreateUserCreateUserPayload includes the requested fields of the GraphQL type CreateUserPayload.
-type createUserMutationCreateUserCreateUserPayload struct {
-	Typename *string                                              `json:"__typename"`
-	Viewer   *createUserMutationCreateUserCreateUserPayloadViewer `json:"viewer"`
+// createSplitTemplateMutationCreateSplitTemplateCreateSplitTemplatePayload includes the requested fields of the GraphQL type CreateSplitTemplatePayload.
+type createSplitTemplateMutationCreateSplitTemplateCreateSplitTemplatePayload struct {
+	Typename *string                                                                                        `json:"__typename"`
+	Template *createSplitTemplateMutationCreateSplitTemplateCreateSplitTemplatePayloadTemplateSplitTemplate `json:"template"`
 }
 
-// GetTypename returns createUserMutationCreateUserCreateUserPayload.Typename, and is useful for accessing the field via an interface.
-func (v *createUserMutationCreateUserCreateUserPayload) GetTypename() *string { return v.Typename }
+// GetTypename returns createSplitTemplateMutationCreateSplitTemplateCreateSplitTemplatePayload.Typename, and is useful for accessing the field via an interface.
+func (v *createSplitTemplateMutationCreateSplitTemplateCreateSplitTemplatePayload) GetTypename() *string {
+	return v.Typename
+}
 
-// GetViewer returns createUserMutationCreateUserCreateUserPayload.Viewer, and is useful for accessing the field via an interface.
-func (v *createUserMutationCreateUserCreateUserPayload) GetViewer() *createUserMutationCreateUserCreateUserPayloadViewer {
-	return v.Viewer
+// GetTemplate returns createSplitTemplateMutationCreateSplitTemplateCreateSplitTemplatePayload.Template, and is useful for accessing the field via an interface.
+func (v *createSplitTemplateMutationCreateSplitTemplateCreateSplitTemplatePayload) GetTemplate() *createSplitTemplateMutationCreateSplitTemplateCreateSplitTemplatePayloadTemplateSplitTemplate {
+	return v.Template
 }
 
-// createUserMutationCreateUserCreateUserPayloadOrError includes the requested fields of the GraphQL interface CreateUserPayloadOrError.
+// createSplitTemplateMutationCreateSplitTemplateCreateSplitTemplatePayloadOrError includes the requested fields of the GraphQL interface CreateSplitTemplatePayloadOrError.
 //
-// createUserMutationCreateUserCreateUserPayloadOrError is implemented by the following types:
-// createUserMutationCreateUserCreateUserPayload
-// createUserMutationCreateUserErrAuthenticationFailed
-// createUserMutationCreateUserErrDoesNotOwnRequiredToken
-// createUserMutationCreateUserErrInvalidInput
-// createUserMutationCreateUserErrUserAlreadyExists
-// createUserMutationCreateUserErrUsernameNotAvailable
-type createUserMutationCreateUserCreateUserPayloadOrError interface {
-	implementsGraphQLInterfacecreateUserMutationCreateUserCreateUserPayloadOrError()
+// createSplitTemplateMutationCreateSplitTemplateCreateSplitTemplatePayloadOrError is implemented by the following types:
+// createSplitTemplateMutationCreateSplitTemplateCreateSplitTemplatePayload
+// createSplitTemplateMutationCreateSplitTemplateErrInvalidInput
+// createSplitTemplateMutationCreateSplitTemplateErrNotAuthorized
+type createSplitTemplateMutationCreateSplitTemplateCreateSplitTemplatePayloadOrError interface {
+	implementsGraphQLInterfacecreateSplitTemplateMutationCreateSplitTemplateCreateSplitTemplatePayloadOrError()
 	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
 	GetTypename() *string
 }
 
-func (v *createUserMutationCreateUserCreateUserPayload) implementsGraphQLInterfacecreateUserMutationCreateUserCreateUserPayloadOrError() {
+func (v *createSplitTemplateMutationCreateSplitTemplateCreateSplitTemplatePayload) implementsGraphQLInterfacecreateSplitTemplateMutationCreateSplitTemplateCreateSplitTemplatePayloadOrError() {
 }
-func (v *createUserMutationCreateUserErrAuthenticationFailed) implementsGraphQLInterfacecreateUserMutationCreateUserCreateUserPayloadOrError() {
+func (v *createSplitTemplateMutationCreateSplitTemplateErrInvalidInput) implementsGraphQLInterfacecreateSplitTemplateMutationCreateSplitTemplateCreateSplitTemplatePayloadOrError() {
+}
+func (v *createSplitTemplateMutationCreateSplitTemplateErrNotAuthorized) implementsGraphQLInterfacecreateSplitTemplateMutationCreateSplitTemplateCreateSplitTemplatePayloadOrError() {
+}
+
+func __unmarshalcreateSplitTemplateMutationCreateSplitTemplateCreateSplitTemplatePayloadOrError(b []byte, v *createSplitTemplateMutationCreateSplitTemplateCreateSplitTemplatePayloadOrError) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "CreateSplitTemplatePayload":
+		*v = new(createSplitTemplateMutationCreateSplitTemplateCreateSplitTemplatePayload)
+		return json.Unmarshal(b, *v)
+	case "ErrInvalidInput":
+		*v = new(createSplitTemplateMutationCreateSplitTemplateErrInvalidInput)
+		return json.Unmarshal(b, *v)
+	case "ErrNotAuthorized":
+		*v = new(createSplitTemplateMutationCreateSplitTemplateErrNotAuthorized)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing CreateSplitTemplatePayloadOrError.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for createSplitTemplateMutationCreateSplitTemplateCreateSplitTemplatePayloadOrError: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalcreateSplitTemplateMutationCreateSplitTemplateCreateSplitTemplatePayloadOrError(v *createSplitTemplateMutationCreateSplitTemplateCreateSplitTemplatePayloadOrError) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *createSplitTemplateMutationCreateSplitTemplateCreateSplitTemplatePayload:
+		typename = "CreateSplitTemplatePayload"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*createSplitTemplateMutationCreateSplitTemplateCreateSplitTemplatePayload
+		}{typename, v}
+		return json.Marshal(result)
+	case *createSplitTemplateMutationCreateSplitTemplateErrInvalidInput:
+		typename = "ErrInvalidInput"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*createSplitTemplateMutationCreateSplitTemplateErrInvalidInput
+		}{typename, v}
+		return json.Marshal(result)
+	case *createSplitTemplateMutationCreateSplitTemplateErrNotAuthorized:
+		typename = "ErrNotAuthorized"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*createSplitTemplateMutationCreateSplitTemplateErrNotAuthorized
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for createSplitTemplateMutationCreateSplitTemplateCreateSplitTemplatePayloadOrError: "%T"`, v)
+	}
+}
+
+// createSplitTemplateMutationCreateSplitTemplateCreateSplitTemplatePayloadTemplateSplitTemplate includes the requested fields of the GraphQL type SplitTemplate.
+type createSplitTemplateMutationCreateSplitTemplateCreateSplitTemplatePayloadTemplateSplitTemplate struct {
+	Dbid persist.DBID `json:"dbid"`
+}
+
+// GetDbid returns createSplitTemplateMutationCreateSplitTemplateCreateSplitTemplatePayloadTemplateSplitTemplate.Dbid, and is useful for accessing the field via an interface.
+func (v *createSplitTemplateMutationCreateSplitTemplateCreateSplitTemplatePayloadTemplateSplitTemplate) GetDbid() persist.DBID {
+	return v.Dbid
+}
+
+// createSplitTemplateMutationCreateSplitTemplateErrInvalidInput includes the requested fields of the GraphQL type ErrInvalidInput.
+type createSplitTemplateMutationCreateSplitTemplateErrInvalidInput struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns createSplitTemplateMutationCreateSplitTemplateErrInvalidInput.Typename, and is useful for accessing the field via an interface.
+func (v *createSplitTemplateMutationCreateSplitTemplateErrInvalidInput) GetTypename() *string {
+	return v.Typename
+}
+
+// GetMessage returns createSplitTemplateMutationCreateSplitTemplateErrInvalidInput.Message, and is useful for accessing the field via an interface.
+func (v *createSplitTemplateMutationCreateSplitTemplateErrInvalidInput) GetMessage() string {
+	return v.Message
+}
+
+// createSplitTemplateMutationCreateSplitTemplateErrNotAuthorized includes the requested fields of the GraphQL type ErrNotAuthorized.
+type createSplitTemplateMutationCreateSplitTemplateErrNotAuthorized struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns createSplitTemplateMutationCreateSplitTemplateErrNotAuthorized.Typename, and is useful for accessing the field via an interface.
+func (v *createSplitTemplateMutationCreateSplitTemplateErrNotAuthorized) GetTypename() *string {
+	return v.Typename
+}
+
+// GetMessage returns createSplitTemplateMutationCreateSplitTemplateErrNotAuthorized.Message, and is useful for accessing the field via an interface.
+func (v *createSplitTemplateMutationCreateSplitTemplateErrNotAuthorized) GetMessage() string {
+	return v.Message
+}
+
+// createSplitTemplateMutationResponse is returned by createSplitTemplateMutation on success.
+type createSplitTemplateMutationResponse struct {
+	CreateSplitTemplate *createSplitTemplateMutationCreateSplitTemplateCreateSplitTemplatePayloadOrError `json:"-"`
+}
+
+// GetCreateSplitTemplate returns createSplitTemplateMutationResponse.CreateSplitTemplate, and is useful for accessing the field via an interface.
+func (v *createSplitTemplateMutationResponse) GetCreateSplitTemplate() *createSplitTemplateMutationCreateSplitTemplateCreateSplitTemplatePayloadOrError {
+	return v.CreateSplitTemplate
+}
+
+func (v *createSplitTemplateMutationResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*createSplitTemplateMutationResponse
+		CreateSplitTemplate json.RawMessage `json:"createSplitTemplate"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.createSplitTemplateMutationResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.CreateSplitTemplate
+		src := firstPass.CreateSplitTemplate
+		if len(src) != 0 && string(src) != "null" {
+			*dst = new(createSplitTemplateMutationCreateSplitTemplateCreateSplitTemplatePayloadOrError)
+			err = __unmarshalcreateSplitTemplateMutationCreateSplitTemplateCreateSplitTemplatePayloadOrError(
+				src, *dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal createSplitTemplateMutationResponse.CreateSplitTemplate: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalcreateSplitTemplateMutationResponse struct {
+	CreateSplitTemplate json.RawMessage `json:"createSplitTemplate"`
+}
+
+func (v *createSplitTemplateMutationResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *createSplitTemplateMutationResponse) __premarshalJSON() (*__premarshalcreateSplitTemplateMutationResponse, error) {
+	var retval __premarshalcreateSplitTemplateMutationResponse
+
+	{
+
+		dst := &retval.CreateSplitTemplate
+		src := v.CreateSplitTemplate
+		if src != nil {
+			var err error
+			*dst, err = __marshalcreateSplitTemplateMutationCreateSplitTemplateCreateSplitTemplatePayloadOrError(
+				src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"unable to marshal createSplitTemplateMutationResponse.CreateSplitTemplate: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// createUserMutationCreateUserCreateUserPayload includes the requested fields of the GraphQL type CreateUserPayload.
+type createUserMutationCreateUserCreateUserPayload struct {
+	Typename *string                                              `json:"__typename"`
+	Viewer   *createUserMutationCreateUserCreateUserPayloadViewer `json:"viewer"`
+}
+
+// GetTypename returns createUserMutationCreateUserCreateUserPayload.Typename, and is useful for accessing the field via an interface.
+func (v *createUserMutationCreateUserCreateUserPayload) GetTypename() *string { return v.Typename }
+
+// GetViewer returns createUserMutationCreateUserCreateUserPayload.Viewer, and is useful for accessing the field via an interface.
+func (v *createUserMutationCreateUserCreateUserPayload) GetViewer() *createUserMutationCreateUserCreateUserPayloadViewer {
+	return v.Viewer
+}
+
+// createUserMutationCreateUserCreateUserPayloadOrError includes the requested fields of the GraphQL interface CreateUserPayloadOrError.
+//
+// createUserMutationCreateUserCreateUserPayloadOrError is implemented by the following types:
+// createUserMutationCreateUserCreateUserPayload
+// createUserMutationCreateUserErrAuthenticationFailed
+// createUserMutationCreateUserErrDoesNotOwnRequiredToken
+// createUserMutationCreateUserErrInvalidInput
+// createUserMutationCreateUserErrUserAlreadyExists
+// createUserMutationCreateUserErrUsernameNotAvailable
+type createUserMutationCreateUserCreateUserPayloadOrError interface {
+	implementsGraphQLInterfacecreateUserMutationCreateUserCreateUserPayloadOrError()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() *string
+}
+
+func (v *createUserMutationCreateUserCreateUserPayload) implementsGraphQLInterfacecreateUserMutationCreateUserCreateUserPayloadOrError() {
+}
+func (v *createUserMutationCreateUserErrAuthenticationFailed) implementsGraphQLInterfacecreateUserMutationCreateUserCreateUserPayloadOrError() {
 }
 func (v *createUserMutationCreateUserErrDoesNotOwnRequiredToken) implementsGraphQLInterfacecreateUserMutationCreateUserCreateUserPayloadOrError() {
 }
@@ -1194,36 +1863,45 @@ func (v *createUserMutationResponse) __premarshalJSON() (*__premarshalcreateUser
 	return &retval, nil
 }
 
-// getAuthNonceMutationGetAuthNonce includes the requested fields of the GraphQL type AuthNonce.
-type getAuthNonceMutationGetAuthNonce struct {
-	Typename *string `json:"__typename"`
-	Nonce    *string `json:"nonce"`
-	Message  *string `json:"message"`
+// createWebhookMutationCreateWebhookCreateWebhookPayload includes the requested fields of the GraphQL type CreateWebhookPayload.
+type createWebhookMutationCreateWebhookCreateWebhookPayload struct {
+	Typename *string                                                        `json:"__typename"`
+	Webhook  *createWebhookMutationCreateWebhookCreateWebhookPayloadWebhook `json:"webhook"`
 }
 
-// GetTypename returns getAuthNonceMutationGetAuthNonce.Typename, and is useful for accessing the field via an interface.
-func (v *getAuthNonceMutationGetAuthNonce) GetTypename() *string { return v.Typename }
-
-// GetNonce returns getAuthNonceMutationGetAuthNonce.Nonce, and is useful for accessing the field via an interface.
-func (v *getAuthNonceMutationGetAuthNonce) GetNonce() *string { return v.Nonce }
+// GetTypename returns createWebhookMutationCreateWebhookCreateWebhookPayload.Typename, and is useful for accessing the field via an interface.
+func (v *createWebhookMutationCreateWebhookCreateWebhookPayload) GetTypename() *string {
+	return v.Typename
+}
 
-// GetMessage returns getAuthNonceMutationGetAuthNonce.Message, and is useful for accessing the field via an interface.
-func (v *getAuthNonceMutationGetAuthNonce) GetMessage() *string { return v.Message }
+// GetWebhook returns createWebhookMutationCreateWebhookCreateWebhookPayload.Webhook, and is useful for accessing the field via an interface.
+func (v *createWebhookMutationCreateWebhookCreateWebhookPayload) GetWebhook() *createWebhookMutationCreateWebhookCreateWebhookPayloadWebhook {
+	return v.Webhook
+}
 
-// getAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError includes the requested fields of the GraphQL interface GetAuthNoncePayloadOrError.
+// createWebhookMutationCreateWebhookCreateWebhookPayloadOrError includes the requested fields of the GraphQL interface CreateWebhookPayloadOrError.
 //
-// getAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError is implemented by the following types:
-// getAuthNonceMutationGetAuthNonce
-type getAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError interface {
-	implementsGraphQLInterfacegetAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError()
+// createWebhookMutationCreateWebhookCreateWebhookPayloadOrError is implemented by the following types:
+// createWebhookMutationCreateWebhookCreateWebhookPayload
+// createWebhookMutationCreateWebhookErrInvalidInput
+// createWebhookMutationCreateWebhookErrNotAuthorized
+// createWebhookMutationCreateWebhookErrSplitNotFound
+type createWebhookMutationCreateWebhookCreateWebhookPayloadOrError interface {
+	implementsGraphQLInterfacecreateWebhookMutationCreateWebhookCreateWebhookPayloadOrError()
 	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
 	GetTypename() *string
 }
 
-func (v *getAuthNonceMutationGetAuthNonce) implementsGraphQLInterfacegetAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError() {
+func (v *createWebhookMutationCreateWebhookCreateWebhookPayload) implementsGraphQLInterfacecreateWebhookMutationCreateWebhookCreateWebhookPayloadOrError() {
+}
+func (v *createWebhookMutationCreateWebhookErrInvalidInput) implementsGraphQLInterfacecreateWebhookMutationCreateWebhookCreateWebhookPayloadOrError() {
+}
+func (v *createWebhookMutationCreateWebhookErrNotAuthorized) implementsGraphQLInterfacecreateWebhookMutationCreateWebhookCreateWebhookPayloadOrError() {
+}
+func (v *createWebhookMutationCreateWebhookErrSplitNotFound) implementsGraphQLInterfacecreateWebhookMutationCreateWebhookCreateWebhookPayloadOrError() {
 }
 
-func __unmarshalgetAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError(b []byte, v *getAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError) error {
+func __unmarshalcreateWebhookMutationCreateWebhookCreateWebhookPayloadOrError(b []byte, v *createWebhookMutationCreateWebhookCreateWebhookPayloadOrError) error {
 	if string(b) == "null" {
 		return nil
 	}
@@ -1237,60 +1915,147 @@ func __unmarshalgetAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError(b []b
 	}
 
 	switch tn.TypeName {
-	case "AuthNonce":
-		*v = new(getAuthNonceMutationGetAuthNonce)
+	case "CreateWebhookPayload":
+		*v = new(createWebhookMutationCreateWebhookCreateWebhookPayload)
+		return json.Unmarshal(b, *v)
+	case "ErrInvalidInput":
+		*v = new(createWebhookMutationCreateWebhookErrInvalidInput)
+		return json.Unmarshal(b, *v)
+	case "ErrNotAuthorized":
+		*v = new(createWebhookMutationCreateWebhookErrNotAuthorized)
+		return json.Unmarshal(b, *v)
+	case "ErrSplitNotFound":
+		*v = new(createWebhookMutationCreateWebhookErrSplitNotFound)
 		return json.Unmarshal(b, *v)
 	case "":
 		return fmt.Errorf(
-			"response was missing GetAuthNoncePayloadOrError.__typename")
+			"response was missing CreateWebhookPayloadOrError.__typename")
 	default:
 		return fmt.Errorf(
-			`unexpected concrete type for getAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError: "%v"`, tn.TypeName)
+			`unexpected concrete type for createWebhookMutationCreateWebhookCreateWebhookPayloadOrError: "%v"`, tn.TypeName)
 	}
 }
 
-func __marshalgetAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError(v *getAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError) ([]byte, error) {
+func __marshalcreateWebhookMutationCreateWebhookCreateWebhookPayloadOrError(v *createWebhookMutationCreateWebhookCreateWebhookPayloadOrError) ([]byte, error) {
 
 	var typename string
 	switch v := (*v).(type) {
-	case *getAuthNonceMutationGetAuthNonce:
-		typename = "AuthNonce"
+	case *createWebhookMutationCreateWebhookCreateWebhookPayload:
+		typename = "CreateWebhookPayload"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*getAuthNonceMutationGetAuthNonce
+			*createWebhookMutationCreateWebhookCreateWebhookPayload
+		}{typename, v}
+		return json.Marshal(result)
+	case *createWebhookMutationCreateWebhookErrInvalidInput:
+		typename = "ErrInvalidInput"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*createWebhookMutationCreateWebhookErrInvalidInput
+		}{typename, v}
+		return json.Marshal(result)
+	case *createWebhookMutationCreateWebhookErrNotAuthorized:
+		typename = "ErrNotAuthorized"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*createWebhookMutationCreateWebhookErrNotAuthorized
+		}{typename, v}
+		return json.Marshal(result)
+	case *createWebhookMutationCreateWebhookErrSplitNotFound:
+		typename = "ErrSplitNotFound"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*createWebhookMutationCreateWebhookErrSplitNotFound
 		}{typename, v}
 		return json.Marshal(result)
 	case nil:
 		return []byte("null"), nil
 	default:
 		return nil, fmt.Errorf(
-			`unexpected concrete type for getAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError: "%T"`, v)
+			`unexpected concrete type for createWebhookMutationCreateWebhookCreateWebhookPayloadOrError: "%T"`, v)
 	}
 }
 
-// getAuthNonceMutationResponse is returned by getAuthNonceMutation on success.
-type getAuthNonceMutationResponse struct {
-	GetAuthNonce *getAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError `json:"-"`
+// createWebhookMutationCreateWebhookCreateWebhookPayloadWebhook includes the requested fields of the GraphQL type Webhook.
+// The GraphQL type's documentation follows.
+//
+// An endpoint that's sent a POST request for each event it's subscribed to. Requests are signed with the webhook's
+// secret: the X-SplitFi-Signature header is "t=<unix timestamp>,v1=<signature>", where the signature is the
+// hex-encoded HMAC-SHA256 of "<unix timestamp>.<request body>". Requests that aren't responded to with a 2xx
+// status are retried with exponential backoff.
+type createWebhookMutationCreateWebhookCreateWebhookPayloadWebhook struct {
+	Dbid persist.DBID `json:"dbid"`
 }
 
-// GetGetAuthNonce returns getAuthNonceMutationResponse.GetAuthNonce, and is useful for accessing the field via an interface.
-func (v *getAuthNonceMutationResponse) GetGetAuthNonce() *getAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError {
-	return v.GetAuthNonce
+// GetDbid returns createWebhookMutationCreateWebhookCreateWebhookPayloadWebhook.Dbid, and is useful for accessing the field via an interface.
+func (v *createWebhookMutationCreateWebhookCreateWebhookPayloadWebhook) GetDbid() persist.DBID {
+	return v.Dbid
 }
 
-func (v *getAuthNonceMutationResponse) UnmarshalJSON(b []byte) error {
+// createWebhookMutationCreateWebhookErrInvalidInput includes the requested fields of the GraphQL type ErrInvalidInput.
+type createWebhookMutationCreateWebhookErrInvalidInput struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns createWebhookMutationCreateWebhookErrInvalidInput.Typename, and is useful for accessing the field via an interface.
+func (v *createWebhookMutationCreateWebhookErrInvalidInput) GetTypename() *string { return v.Typename }
+
+// GetMessage returns createWebhookMutationCreateWebhookErrInvalidInput.Message, and is useful for accessing the field via an interface.
+func (v *createWebhookMutationCreateWebhookErrInvalidInput) GetMessage() string { return v.Message }
+
+// createWebhookMutationCreateWebhookErrNotAuthorized includes the requested fields of the GraphQL type ErrNotAuthorized.
+type createWebhookMutationCreateWebhookErrNotAuthorized struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns createWebhookMutationCreateWebhookErrNotAuthorized.Typename, and is useful for accessing the field via an interface.
+func (v *createWebhookMutationCreateWebhookErrNotAuthorized) GetTypename() *string { return v.Typename }
+
+// GetMessage returns createWebhookMutationCreateWebhookErrNotAuthorized.Message, and is useful for accessing the field via an interface.
+func (v *createWebhookMutationCreateWebhookErrNotAuthorized) GetMessage() string { return v.Message }
+
+// createWebhookMutationCreateWebhookErrSplitNotFound includes the requested fields of the GraphQL type ErrSplitNotFound.
+type createWebhookMutationCreateWebhookErrSplitNotFound struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns createWebhookMutationCreateWebhookErrSplitNotFound.Typename, and is useful for accessing the field via an interface.
+func (v *createWebhookMutationCreateWebhookErrSplitNotFound) GetTypename() *string { return v.Typename }
+
+// GetMessage returns createWebhookMutationCreateWebhookErrSplitNotFound.Message, and is useful for accessing the field via an interface.
+func (v *createWebhookMutationCreateWebhookErrSplitNotFound) GetMessage() string { return v.Message }
+
+// createWebhookMutationResponse is returned by createWebhookMutation on success.
+type createWebhookMutationResponse struct {
+	// Registers a webhook that's sent the events it subscribes to. The returned secret is needed to verify the
+	// webhook's requests and can't be retrieved again.
+	CreateWebhook *createWebhookMutationCreateWebhookCreateWebhookPayloadOrError `json:"-"`
+}
+
+// GetCreateWebhook returns createWebhookMutationResponse.CreateWebhook, and is useful for accessing the field via an interface.
+func (v *createWebhookMutationResponse) GetCreateWebhook() *createWebhookMutationCreateWebhookCreateWebhookPayloadOrError {
+	return v.CreateWebhook
+}
+
+func (v *createWebhookMutationResponse) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*getAuthNonceMutationResponse
-		GetAuthNonce json.RawMessage `json:"getAuthNonce"`
+		*createWebhookMutationResponse
+		CreateWebhook json.RawMessage `json:"createWebhook"`
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.getAuthNonceMutationResponse = v
+	firstPass.createWebhookMutationResponse = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -1298,26 +2063,26 @@ func (v *getAuthNonceMutationResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	{
-		dst := &v.GetAuthNonce
-		src := firstPass.GetAuthNonce
+		dst := &v.CreateWebhook
+		src := firstPass.CreateWebhook
 		if len(src) != 0 && string(src) != "null" {
-			*dst = new(getAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError)
-			err = __unmarshalgetAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError(
+			*dst = new(createWebhookMutationCreateWebhookCreateWebhookPayloadOrError)
+			err = __unmarshalcreateWebhookMutationCreateWebhookCreateWebhookPayloadOrError(
 				src, *dst)
 			if err != nil {
 				return fmt.Errorf(
-					"unable to unmarshal getAuthNonceMutationResponse.GetAuthNonce: %w", err)
+					"unable to unmarshal createWebhookMutationResponse.CreateWebhook: %w", err)
 			}
 		}
 	}
 	return nil
 }
 
-type __premarshalgetAuthNonceMutationResponse struct {
-	GetAuthNonce json.RawMessage `json:"getAuthNonce"`
+type __premarshalcreateWebhookMutationResponse struct {
+	CreateWebhook json.RawMessage `json:"createWebhook"`
 }
 
-func (v *getAuthNonceMutationResponse) MarshalJSON() ([]byte, error) {
+func (v *createWebhookMutationResponse) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -1325,99 +2090,111 @@ func (v *getAuthNonceMutationResponse) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *getAuthNonceMutationResponse) __premarshalJSON() (*__premarshalgetAuthNonceMutationResponse, error) {
-	var retval __premarshalgetAuthNonceMutationResponse
+func (v *createWebhookMutationResponse) __premarshalJSON() (*__premarshalcreateWebhookMutationResponse, error) {
+	var retval __premarshalcreateWebhookMutationResponse
 
 	{
 
-		dst := &retval.GetAuthNonce
-		src := v.GetAuthNonce
+		dst := &retval.CreateWebhook
+		src := v.CreateWebhook
 		if src != nil {
 			var err error
-			*dst, err = __marshalgetAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError(
+			*dst, err = __marshalcreateWebhookMutationCreateWebhookCreateWebhookPayloadOrError(
 				src)
 			if err != nil {
 				return nil, fmt.Errorf(
-					"unable to marshal getAuthNonceMutationResponse.GetAuthNonce: %w", err)
+					"unable to marshal createWebhookMutationResponse.CreateWebhook: %w", err)
 			}
 		}
 	}
 	return &retval, nil
 }
 
-// loginMutationLoginErrAuthenticationFailed includes the requested fields of the GraphQL type ErrAuthenticationFailed.
-type loginMutationLoginErrAuthenticationFailed struct {
+// exportLedgerMutationExportLedgerErrInvalidInput includes the requested fields of the GraphQL type ErrInvalidInput.
+type exportLedgerMutationExportLedgerErrInvalidInput struct {
 	Typename *string `json:"__typename"`
 	Message  string  `json:"message"`
 }
 
-// GetTypename returns loginMutationLoginErrAuthenticationFailed.Typename, and is useful for accessing the field via an interface.
-func (v *loginMutationLoginErrAuthenticationFailed) GetTypename() *string { return v.Typename }
+// GetTypename returns exportLedgerMutationExportLedgerErrInvalidInput.Typename, and is useful for accessing the field via an interface.
+func (v *exportLedgerMutationExportLedgerErrInvalidInput) GetTypename() *string { return v.Typename }
 
-// GetMessage returns loginMutationLoginErrAuthenticationFailed.Message, and is useful for accessing the field via an interface.
-func (v *loginMutationLoginErrAuthenticationFailed) GetMessage() string { return v.Message }
+// GetMessage returns exportLedgerMutationExportLedgerErrInvalidInput.Message, and is useful for accessing the field via an interface.
+func (v *exportLedgerMutationExportLedgerErrInvalidInput) GetMessage() string { return v.Message }
 
-// loginMutationLoginErrDoesNotOwnRequiredToken includes the requested fields of the GraphQL type ErrDoesNotOwnRequiredToken.
-type loginMutationLoginErrDoesNotOwnRequiredToken struct {
+// exportLedgerMutationExportLedgerErrNotAuthorized includes the requested fields of the GraphQL type ErrNotAuthorized.
+type exportLedgerMutationExportLedgerErrNotAuthorized struct {
 	Typename *string `json:"__typename"`
 	Message  string  `json:"message"`
 }
 
-// GetTypename returns loginMutationLoginErrDoesNotOwnRequiredToken.Typename, and is useful for accessing the field via an interface.
-func (v *loginMutationLoginErrDoesNotOwnRequiredToken) GetTypename() *string { return v.Typename }
+// GetTypename returns exportLedgerMutationExportLedgerErrNotAuthorized.Typename, and is useful for accessing the field via an interface.
+func (v *exportLedgerMutationExportLedgerErrNotAuthorized) GetTypename() *string { return v.Typename }
 
-// GetMessage returns loginMutationLoginErrDoesNotOwnRequiredToken.Message, and is useful for accessing the field via an interface.
-func (v *loginMutationLoginErrDoesNotOwnRequiredToken) GetMessage() string { return v.Message }
+// GetMessage returns exportLedgerMutationExportLedgerErrNotAuthorized.Message, and is useful for accessing the field via an interface.
+func (v *exportLedgerMutationExportLedgerErrNotAuthorized) GetMessage() string { return v.Message }
 
-// loginMutationLoginErrUserNotFound includes the requested fields of the GraphQL type ErrUserNotFound.
-type loginMutationLoginErrUserNotFound struct {
+// exportLedgerMutationExportLedgerErrSplitNotFound includes the requested fields of the GraphQL type ErrSplitNotFound.
+type exportLedgerMutationExportLedgerErrSplitNotFound struct {
 	Typename *string `json:"__typename"`
 	Message  string  `json:"message"`
 }
 
-// GetTypename returns loginMutationLoginErrUserNotFound.Typename, and is useful for accessing the field via an interface.
-func (v *loginMutationLoginErrUserNotFound) GetTypename() *string { return v.Typename }
+// GetTypename returns exportLedgerMutationExportLedgerErrSplitNotFound.Typename, and is useful for accessing the field via an interface.
+func (v *exportLedgerMutationExportLedgerErrSplitNotFound) GetTypename() *string { return v.Typename }
 
-// GetMessage returns loginMutationLoginErrUserNotFound.Message, and is useful for accessing the field via an interface.
-func (v *loginMutationLoginErrUserNotFound) GetMessage() string { return v.Message }
+// GetMessage returns exportLedgerMutationExportLedgerErrSplitNotFound.Message, and is useful for accessing the field via an interface.
+func (v *exportLedgerMutationExportLedgerErrSplitNotFound) GetMessage() string { return v.Message }
 
-// loginMutationLoginLoginPayload includes the requested fields of the GraphQL type LoginPayload.
-type loginMutationLoginLoginPayload struct {
-	Typename *string                               `json:"__typename"`
-	Viewer   *loginMutationLoginLoginPayloadViewer `json:"viewer"`
+// exportLedgerMutationExportLedgerExportLedgerPayload includes the requested fields of the GraphQL type ExportLedgerPayload.
+type exportLedgerMutationExportLedgerExportLedgerPayload struct {
+	Typename *string                                                                `json:"__typename"`
+	Export   *exportLedgerMutationExportLedgerExportLedgerPayloadExportLedgerExport `json:"export"`
 }
 
-// GetTypename returns loginMutationLoginLoginPayload.Typename, and is useful for accessing the field via an interface.
-func (v *loginMutationLoginLoginPayload) GetTypename() *string { return v.Typename }
+// GetTypename returns exportLedgerMutationExportLedgerExportLedgerPayload.Typename, and is useful for accessing the field via an interface.
+func (v *exportLedgerMutationExportLedgerExportLedgerPayload) GetTypename() *string {
+	return v.Typename
+}
 
-// GetViewer returns loginMutationLoginLoginPayload.Viewer, and is useful for accessing the field via an interface.
-func (v *loginMutationLoginLoginPayload) GetViewer() *loginMutationLoginLoginPayloadViewer {
-	return v.Viewer
+// GetExport returns exportLedgerMutationExportLedgerExportLedgerPayload.Export, and is useful for accessing the field via an interface.
+func (v *exportLedgerMutationExportLedgerExportLedgerPayload) GetExport() *exportLedgerMutationExportLedgerExportLedgerPayloadExportLedgerExport {
+	return v.Export
 }
 
-// loginMutationLoginLoginPayloadOrError includes the requested fields of the GraphQL interface LoginPayloadOrError.
+// exportLedgerMutationExportLedgerExportLedgerPayloadExportLedgerExport includes the requested fields of the GraphQL type LedgerExport.
+type exportLedgerMutationExportLedgerExportLedgerPayloadExportLedgerExport struct {
+	Dbid persist.DBID `json:"dbid"`
+}
+
+// GetDbid returns exportLedgerMutationExportLedgerExportLedgerPayloadExportLedgerExport.Dbid, and is useful for accessing the field via an interface.
+func (v *exportLedgerMutationExportLedgerExportLedgerPayloadExportLedgerExport) GetDbid() persist.DBID {
+	return v.Dbid
+}
+
+// exportLedgerMutationExportLedgerExportLedgerPayloadOrError includes the requested fields of the GraphQL interface ExportLedgerPayloadOrError.
 //
-// loginMutationLoginLoginPayloadOrError is implemented by the following types:
-// loginMutationLoginErrAuthenticationFailed
-// loginMutationLoginErrDoesNotOwnRequiredToken
-// loginMutationLoginErrUserNotFound
-// loginMutationLoginLoginPayload
-type loginMutationLoginLoginPayloadOrError interface {
-	implementsGraphQLInterfaceloginMutationLoginLoginPayloadOrError()
+// exportLedgerMutationExportLedgerExportLedgerPayloadOrError is implemented by the following types:
+// exportLedgerMutationExportLedgerErrInvalidInput
+// exportLedgerMutationExportLedgerErrNotAuthorized
+// exportLedgerMutationExportLedgerErrSplitNotFound
+// exportLedgerMutationExportLedgerExportLedgerPayload
+type exportLedgerMutationExportLedgerExportLedgerPayloadOrError interface {
+	implementsGraphQLInterfaceexportLedgerMutationExportLedgerExportLedgerPayloadOrError()
 	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
 	GetTypename() *string
 }
 
-func (v *loginMutationLoginErrAuthenticationFailed) implementsGraphQLInterfaceloginMutationLoginLoginPayloadOrError() {
+func (v *exportLedgerMutationExportLedgerErrInvalidInput) implementsGraphQLInterfaceexportLedgerMutationExportLedgerExportLedgerPayloadOrError() {
 }
-func (v *loginMutationLoginErrDoesNotOwnRequiredToken) implementsGraphQLInterfaceloginMutationLoginLoginPayloadOrError() {
+func (v *exportLedgerMutationExportLedgerErrNotAuthorized) implementsGraphQLInterfaceexportLedgerMutationExportLedgerExportLedgerPayloadOrError() {
 }
-func (v *loginMutationLoginErrUserNotFound) implementsGraphQLInterfaceloginMutationLoginLoginPayloadOrError() {
+func (v *exportLedgerMutationExportLedgerErrSplitNotFound) implementsGraphQLInterfaceexportLedgerMutationExportLedgerExportLedgerPayloadOrError() {
 }
-func (v *loginMutationLoginLoginPayload) implementsGraphQLInterfaceloginMutationLoginLoginPayloadOrError() {
+func (v *exportLedgerMutationExportLedgerExportLedgerPayload) implementsGraphQLInterfaceexportLedgerMutationExportLedgerExportLedgerPayloadOrError() {
 }
 
-func __unmarshalloginMutationLoginLoginPayloadOrError(b []byte, v *loginMutationLoginLoginPayloadOrError) error {
+func __unmarshalexportLedgerMutationExportLedgerExportLedgerPayloadOrError(b []byte, v *exportLedgerMutationExportLedgerExportLedgerPayloadOrError) error {
 	if string(b) == "null" {
 		return nil
 	}
@@ -1431,115 +2208,97 @@ func __unmarshalloginMutationLoginLoginPayloadOrError(b []byte, v *loginMutation
 	}
 
 	switch tn.TypeName {
-	case "ErrAuthenticationFailed":
-		*v = new(loginMutationLoginErrAuthenticationFailed)
+	case "ErrInvalidInput":
+		*v = new(exportLedgerMutationExportLedgerErrInvalidInput)
 		return json.Unmarshal(b, *v)
-	case "ErrDoesNotOwnRequiredToken":
-		*v = new(loginMutationLoginErrDoesNotOwnRequiredToken)
+	case "ErrNotAuthorized":
+		*v = new(exportLedgerMutationExportLedgerErrNotAuthorized)
 		return json.Unmarshal(b, *v)
-	case "ErrUserNotFound":
-		*v = new(loginMutationLoginErrUserNotFound)
+	case "ErrSplitNotFound":
+		*v = new(exportLedgerMutationExportLedgerErrSplitNotFound)
 		return json.Unmarshal(b, *v)
-	case "LoginPayload":
-		*v = new(loginMutationLoginLoginPayload)
+	case "ExportLedgerPayload":
+		*v = new(exportLedgerMutationExportLedgerExportLedgerPayload)
 		return json.Unmarshal(b, *v)
 	case "":
 		return fmt.Errorf(
-			"response was missing LoginPayloadOrError.__typename")
+			"response was missing ExportLedgerPayloadOrError.__typename")
 	default:
 		return fmt.Errorf(
-			`unexpected concrete type for loginMutationLoginLoginPayloadOrError: "%v"`, tn.TypeName)
+			`unexpected concrete type for exportLedgerMutationExportLedgerExportLedgerPayloadOrError: "%v"`, tn.TypeName)
 	}
 }
 
-func __marshalloginMutationLoginLoginPayloadOrError(v *loginMutationLoginLoginPayloadOrError) ([]byte, error) {
+func __marshalexportLedgerMutationExportLedgerExportLedgerPayloadOrError(v *exportLedgerMutationExportLedgerExportLedgerPayloadOrError) ([]byte, error) {
 
 	var typename string
 	switch v := (*v).(type) {
-	case *loginMutationLoginErrAuthenticationFailed:
-		typename = "ErrAuthenticationFailed"
+	case *exportLedgerMutationExportLedgerErrInvalidInput:
+		typename = "ErrInvalidInput"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*loginMutationLoginErrAuthenticationFailed
+			*exportLedgerMutationExportLedgerErrInvalidInput
 		}{typename, v}
 		return json.Marshal(result)
-	case *loginMutationLoginErrDoesNotOwnRequiredToken:
-		typename = "ErrDoesNotOwnRequiredToken"
+	case *exportLedgerMutationExportLedgerErrNotAuthorized:
+		typename = "ErrNotAuthorized"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*loginMutationLoginErrDoesNotOwnRequiredToken
+			*exportLedgerMutationExportLedgerErrNotAuthorized
 		}{typename, v}
 		return json.Marshal(result)
-	case *loginMutationLoginErrUserNotFound:
-		typename = "ErrUserNotFound"
+	case *exportLedgerMutationExportLedgerErrSplitNotFound:
+		typename = "ErrSplitNotFound"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*loginMutationLoginErrUserNotFound
+			*exportLedgerMutationExportLedgerErrSplitNotFound
 		}{typename, v}
 		return json.Marshal(result)
-	case *loginMutationLoginLoginPayload:
-		typename = "LoginPayload"
+	case *exportLedgerMutationExportLedgerExportLedgerPayload:
+		typename = "ExportLedgerPayload"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*loginMutationLoginLoginPayload
+			*exportLedgerMutationExportLedgerExportLedgerPayload
 		}{typename, v}
 		return json.Marshal(result)
 	case nil:
 		return []byte("null"), nil
 	default:
 		return nil, fmt.Errorf(
-			`unexpected concrete type for loginMutationLoginLoginPayloadOrError: "%T"`, v)
+			`unexpected concrete type for exportLedgerMutationExportLedgerExportLedgerPayloadOrError: "%T"`, v)
 	}
 }
 
-// loginMutationLoginLoginPayloadViewer includes the requested fields of the GraphQL type Viewer.
-type loginMutationLoginLoginPayloadViewer struct {
-	User *loginMutationLoginLoginPayloadViewerUserSplitFiUser `json:"user"`
-}
-
-// GetUser returns loginMutationLoginLoginPayloadViewer.User, and is useful for accessing the field via an interface.
-func (v *loginMutationLoginLoginPayloadViewer) GetUser() *loginMutationLoginLoginPayloadViewerUserSplitFiUser {
-	return v.User
-}
-
-// loginMutationLoginLoginPayloadViewerUserSplitFiUser includes the requested fields of the GraphQL type SplitFiUser.
-type loginMutationLoginLoginPayloadViewerUserSplitFiUser struct {
-	Username *string      `json:"username"`
-	Dbid     persist.DBID `json:"dbid"`
-}
-
-// GetUsername returns loginMutationLoginLoginPayloadViewerUserSplitFiUser.Username, and is useful for accessing the field via an interface.
-func (v *loginMutationLoginLoginPayloadViewerUserSplitFiUser) GetUsername() *string {
-	return v.Username
+// exportLedgerMutationResponse is returned by exportLedgerMutation on success.
+type exportLedgerMutationResponse struct {
+	// Exports the inflows, distributions and recipient allocations of a split, or the allocations paid to one of
+	// the viewer's wallets, recorded between from and to. Exporting a split requires the VIEWER role on it. Rows carry the token, amount in base units, decimals, tx
+	// hash, block time and USD value when the token has a price. Large exports are generated in the background, so
+	// poll Viewer.ledgerExport until the export is complete to get its download link.
+	ExportLedger *exportLedgerMutationExportLedgerExportLedgerPayloadOrError `json:"-"`
 }
 
-// GetDbid returns loginMutationLoginLoginPayloadViewerUserSplitFiUser.Dbid, and is useful for accessing the field via an interface.
-func (v *loginMutationLoginLoginPayloadViewerUserSplitFiUser) GetDbid() persist.DBID { return v.Dbid }
-
-// loginMutationResponse is returned by loginMutation on success.
-type loginMutationResponse struct {
-	Login *loginMutationLoginLoginPayloadOrError `json:"-"`
+// GetExportLedger returns exportLedgerMutationResponse.ExportLedger, and is useful for accessing the field via an interface.
+func (v *exportLedgerMutationResponse) GetExportLedger() *exportLedgerMutationExportLedgerExportLedgerPayloadOrError {
+	return v.ExportLedger
 }
 
-// GetLogin returns loginMutationResponse.Login, and is useful for accessing the field via an interface.
-func (v *loginMutationResponse) GetLogin() *loginMutationLoginLoginPayloadOrError { return v.Login }
-
-func (v *loginMutationResponse) UnmarshalJSON(b []byte) error {
+func (v *exportLedgerMutationResponse) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*loginMutationResponse
-		Login json.RawMessage `json:"login"`
+		*exportLedgerMutationResponse
+		ExportLedger json.RawMessage `json:"exportLedger"`
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.loginMutationResponse = v
+	firstPass.exportLedgerMutationResponse = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -1547,26 +2306,26 @@ func (v *loginMutationResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	{
-		dst := &v.Login
-		src := firstPass.Login
+		dst := &v.ExportLedger
+		src := firstPass.ExportLedger
 		if len(src) != 0 && string(src) != "null" {
-			*dst = new(loginMutationLoginLoginPayloadOrError)
-			err = __unmarshalloginMutationLoginLoginPayloadOrError(
+			*dst = new(exportLedgerMutationExportLedgerExportLedgerPayloadOrError)
+			err = __unmarshalexportLedgerMutationExportLedgerExportLedgerPayloadOrError(
 				src, *dst)
 			if err != nil {
 				return fmt.Errorf(
-					"unable to unmarshal loginMutationResponse.Login: %w", err)
+					"unable to unmarshal exportLedgerMutationResponse.ExportLedger: %w", err)
 			}
 		}
 	}
 	return nil
 }
 
-type __premarshalloginMutationResponse struct {
-	Login json.RawMessage `json:"login"`
+type __premarshalexportLedgerMutationResponse struct {
+	ExportLedger json.RawMessage `json:"exportLedger"`
 }
 
-func (v *loginMutationResponse) MarshalJSON() ([]byte, error) {
+func (v *exportLedgerMutationResponse) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -1574,92 +2333,123 @@ func (v *loginMutationResponse) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *loginMutationResponse) __premarshalJSON() (*__premarshalloginMutationResponse, error) {
-	var retval __premarshalloginMutationResponse
+func (v *exportLedgerMutationResponse) __premarshalJSON() (*__premarshalexportLedgerMutationResponse, error) {
+	var retval __premarshalexportLedgerMutationResponse
 
 	{
 
-		dst := &retval.Login
-		src := v.Login
+		dst := &retval.ExportLedger
+		src := v.ExportLedger
 		if src != nil {
 			var err error
-			*dst, err = __marshalloginMutationLoginLoginPayloadOrError(
+			*dst, err = __marshalexportLedgerMutationExportLedgerExportLedgerPayloadOrError(
 				src)
 			if err != nil {
 				return nil, fmt.Errorf(
-					"unable to marshal loginMutationResponse.Login: %w", err)
+					"unable to marshal exportLedgerMutationResponse.ExportLedger: %w", err)
 			}
 		}
 	}
 	return &retval, nil
 }
 
-// logoutMutationLogoutLogoutPayload includes the requested fields of the GraphQL type LogoutPayload.
-type logoutMutationLogoutLogoutPayload struct {
-	Viewer *logoutMutationLogoutLogoutPayloadViewer `json:"viewer"`
+// getAuthNonceMutationGetAuthNonce includes the requested fields of the GraphQL type AuthNonce.
+type getAuthNonceMutationGetAuthNonce struct {
+	Typename *string `json:"__typename"`
+	Nonce    *string `json:"nonce"`
+	Message  *string `json:"message"`
 }
 
-// GetViewer returns logoutMutationLogoutLogoutPayload.Viewer, and is useful for accessing the field via an interface.
-func (v *logoutMutationLogoutLogoutPayload) GetViewer() *logoutMutationLogoutLogoutPayloadViewer {
-	return v.Viewer
-}
+// GetTypename returns getAuthNonceMutationGetAuthNonce.Typename, and is useful for accessing the field via an interface.
+func (v *getAuthNonceMutationGetAuthNonce) GetTypename() *string { return v.Typename }
 
-// logoutMutationLogoutLogoutPayloadViewer includes the requested fields of the GraphQL type Viewer.
-type logoutMutationLogoutLogoutPayloadViewer struct {
-	User *logoutMutationLogoutLogoutPayloadViewerUserSplitFiUser `json:"user"`
-}
+// GetNonce returns getAuthNonceMutationGetAuthNonce.Nonce, and is useful for accessing the field via an interface.
+func (v *getAuthNonceMutationGetAuthNonce) GetNonce() *string { return v.Nonce }
 
-// GetUser returns logoutMutationLogoutLogoutPayloadViewer.User, and is useful for accessing the field via an interface.
-func (v *logoutMutationLogoutLogoutPayloadViewer) GetUser() *logoutMutationLogoutLogoutPayloadViewerUserSplitFiUser {
-	return v.User
-}
+// GetMessage returns getAuthNonceMutationGetAuthNonce.Message, and is useful for accessing the field via an interface.
+func (v *getAuthNonceMutationGetAuthNonce) GetMessage() *string { return v.Message }
 
-// logoutMutationLogoutLogoutPayloadViewerUserSplitFiUser includes the requested fields of the GraphQL type SplitFiUser.
-type logoutMutationLogoutLogoutPayloadViewerUserSplitFiUser struct {
-	Username *string      `json:"username"`
-	Dbid     persist.DBID `json:"dbid"`
+// getAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError includes the requested fields of the GraphQL interface GetAuthNoncePayloadOrError.
+//
+// getAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError is implemented by the following types:
+// getAuthNonceMutationGetAuthNonce
+type getAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError interface {
+	implementsGraphQLInterfacegetAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() *string
 }
 
-// GetUsername returns logoutMutationLogoutLogoutPayloadViewerUserSplitFiUser.Username, and is useful for accessing the field via an interface.
-func (v *logoutMutationLogoutLogoutPayloadViewerUserSplitFiUser) GetUsername() *string {
-	return v.Username
+func (v *getAuthNonceMutationGetAuthNonce) implementsGraphQLInterfacegetAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError() {
 }
 
-// GetDbid returns logoutMutationLogoutLogoutPayloadViewerUserSplitFiUser.Dbid, and is useful for accessing the field via an interface.
-func (v *logoutMutationLogoutLogoutPayloadViewerUserSplitFiUser) GetDbid() persist.DBID {
-	return v.Dbid
-}
+func __unmarshalgetAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError(b []byte, v *getAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError) error {
+	if string(b) == "null" {
+		return nil
+	}
 
-// logoutMutationResponse is returned by logoutMutation on success.
-type logoutMutationResponse struct {
-	Logout *logoutMutationLogoutLogoutPayload `json:"logout"`
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "AuthNonce":
+		*v = new(getAuthNonceMutationGetAuthNonce)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing GetAuthNoncePayloadOrError.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for getAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError: "%v"`, tn.TypeName)
+	}
 }
 
-// GetLogout returns logoutMutationResponse.Logout, and is useful for accessing the field via an interface.
-func (v *logoutMutationResponse) GetLogout() *logoutMutationLogoutLogoutPayload { return v.Logout }
+func __marshalgetAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError(v *getAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError) ([]byte, error) {
 
-// notificationsForViewerQueryResponse is returned by notificationsForViewerQuery on success.
-type notificationsForViewerQueryResponse struct {
-	Viewer *notificationsForViewerQueryViewerViewerOrError `json:"-"`
+	var typename string
+	switch v := (*v).(type) {
+	case *getAuthNonceMutationGetAuthNonce:
+		typename = "AuthNonce"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getAuthNonceMutationGetAuthNonce
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for getAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError: "%T"`, v)
+	}
 }
 
-// GetViewer returns notificationsForViewerQueryResponse.Viewer, and is useful for accessing the field via an interface.
-func (v *notificationsForViewerQueryResponse) GetViewer() *notificationsForViewerQueryViewerViewerOrError {
-	return v.Viewer
+// getAuthNonceMutationResponse is returned by getAuthNonceMutation on success.
+type getAuthNonceMutationResponse struct {
+	GetAuthNonce *getAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError `json:"-"`
 }
 
-func (v *notificationsForViewerQueryResponse) UnmarshalJSON(b []byte) error {
+// GetGetAuthNonce returns getAuthNonceMutationResponse.GetAuthNonce, and is useful for accessing the field via an interface.
+func (v *getAuthNonceMutationResponse) GetGetAuthNonce() *getAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError {
+	return v.GetAuthNonce
+}
+
+func (v *getAuthNonceMutationResponse) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*notificationsForViewerQueryResponse
-		Viewer json.RawMessage `json:"viewer"`
+		*getAuthNonceMutationResponse
+		GetAuthNonce json.RawMessage `json:"getAuthNonce"`
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.notificationsForViewerQueryResponse = v
+	firstPass.getAuthNonceMutationResponse = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -1667,26 +2457,26 @@ func (v *notificationsForViewerQueryResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	{
-		dst := &v.Viewer
-		src := firstPass.Viewer
+		dst := &v.GetAuthNonce
+		src := firstPass.GetAuthNonce
 		if len(src) != 0 && string(src) != "null" {
-			*dst = new(notificationsForViewerQueryViewerViewerOrError)
-			err = __unmarshalnotificationsForViewerQueryViewerViewerOrError(
-				src, *dst)
+			*dst = new(getAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError)
+			err = __unmarshalgetAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError(
+				src, *dst)
 			if err != nil {
 				return fmt.Errorf(
-					"unable to unmarshal notificationsForViewerQueryResponse.Viewer: %w", err)
+					"unable to unmarshal getAuthNonceMutationResponse.GetAuthNonce: %w", err)
 			}
 		}
 	}
 	return nil
 }
 
-type __premarshalnotificationsForViewerQueryResponse struct {
-	Viewer json.RawMessage `json:"viewer"`
+type __premarshalgetAuthNonceMutationResponse struct {
+	GetAuthNonce json.RawMessage `json:"getAuthNonce"`
 }
 
-func (v *notificationsForViewerQueryResponse) MarshalJSON() ([]byte, error) {
+func (v *getAuthNonceMutationResponse) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -1694,197 +2484,134 @@ func (v *notificationsForViewerQueryResponse) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *notificationsForViewerQueryResponse) __premarshalJSON() (*__premarshalnotificationsForViewerQueryResponse, error) {
-	var retval __premarshalnotificationsForViewerQueryResponse
+func (v *getAuthNonceMutationResponse) __premarshalJSON() (*__premarshalgetAuthNonceMutationResponse, error) {
+	var retval __premarshalgetAuthNonceMutationResponse
 
 	{
 
-		dst := &retval.Viewer
-		src := v.Viewer
+		dst := &retval.GetAuthNonce
+		src := v.GetAuthNonce
 		if src != nil {
 			var err error
-			*dst, err = __marshalnotificationsForViewerQueryViewerViewerOrError(
+			*dst, err = __marshalgetAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError(
 				src)
 			if err != nil {
 				return nil, fmt.Errorf(
-					"unable to marshal notificationsForViewerQueryResponse.Viewer: %w", err)
+					"unable to marshal getAuthNonceMutationResponse.GetAuthNonce: %w", err)
 			}
 		}
 	}
 	return &retval, nil
 }
 
-// notificationsForViewerQueryViewer includes the requested fields of the GraphQL type Viewer.
-type notificationsForViewerQueryViewer struct {
+// grantSplitRoleMutationGrantSplitRoleErrInvalidInput includes the requested fields of the GraphQL type ErrInvalidInput.
+type grantSplitRoleMutationGrantSplitRoleErrInvalidInput struct {
 	Typename *string `json:"__typename"`
-	// Returns a list of notifications in reverse chronological order.
-	// Seen notifications come after unseen notifications
-	Notifications *notificationsForViewerQueryViewerNotificationsNotificationsConnection `json:"notifications"`
+	Message  string  `json:"message"`
 }
 
-// GetTypename returns notificationsForViewerQueryViewer.Typename, and is useful for accessing the field via an interface.
-func (v *notificationsForViewerQueryViewer) GetTypename() *string { return v.Typename }
-
-// GetNotifications returns notificationsForViewerQueryViewer.Notifications, and is useful for accessing the field via an interface.
-func (v *notificationsForViewerQueryViewer) GetNotifications() *notificationsForViewerQueryViewerNotificationsNotificationsConnection {
-	return v.Notifications
+// GetTypename returns grantSplitRoleMutationGrantSplitRoleErrInvalidInput.Typename, and is useful for accessing the field via an interface.
+func (v *grantSplitRoleMutationGrantSplitRoleErrInvalidInput) GetTypename() *string {
+	return v.Typename
 }
 
-// notificationsForViewerQueryViewerErrNotAuthorized includes the requested fields of the GraphQL type ErrNotAuthorized.
-type notificationsForViewerQueryViewerErrNotAuthorized struct {
+// GetMessage returns grantSplitRoleMutationGrantSplitRoleErrInvalidInput.Message, and is useful for accessing the field via an interface.
+func (v *grantSplitRoleMutationGrantSplitRoleErrInvalidInput) GetMessage() string { return v.Message }
+
+// grantSplitRoleMutationGrantSplitRoleErrNotAuthorized includes the requested fields of the GraphQL type ErrNotAuthorized.
+type grantSplitRoleMutationGrantSplitRoleErrNotAuthorized struct {
 	Typename *string `json:"__typename"`
 	Message  string  `json:"message"`
 }
 
-// GetTypename returns notificationsForViewerQueryViewerErrNotAuthorized.Typename, and is useful for accessing the field via an interface.
-func (v *notificationsForViewerQueryViewerErrNotAuthorized) GetTypename() *string { return v.Typename }
-
-// GetMessage returns notificationsForViewerQueryViewerErrNotAuthorized.Message, and is useful for accessing the field via an interface.
-func (v *notificationsForViewerQueryViewerErrNotAuthorized) GetMessage() string { return v.Message }
-
-// notificationsForViewerQueryViewerNotificationsNotificationsConnection includes the requested fields of the GraphQL type NotificationsConnection.
-type notificationsForViewerQueryViewerNotificationsNotificationsConnection struct {
-	UnseenCount *int `json:"unseenCount"`
-}
-
-// GetUnseenCount returns notificationsForViewerQueryViewerNotificationsNotificationsConnection.UnseenCount, and is useful for accessing the field via an interface.
-func (v *notificationsForViewerQueryViewerNotificationsNotificationsConnection) GetUnseenCount() *int {
-	return v.UnseenCount
+// GetTypename returns grantSplitRoleMutationGrantSplitRoleErrNotAuthorized.Typename, and is useful for accessing the field via an interface.
+func (v *grantSplitRoleMutationGrantSplitRoleErrNotAuthorized) GetTypename() *string {
+	return v.Typename
 }
 
-// notificationsForViewerQueryViewerViewerOrError includes the requested fields of the GraphQL interface ViewerOrError.
-//
-// notificationsForViewerQueryViewerViewerOrError is implemented by the following types:
-// notificationsForViewerQueryViewerErrNotAuthorized
-// notificationsForViewerQueryViewer
-type notificationsForViewerQueryViewerViewerOrError interface {
-	implementsGraphQLInterfacenotificationsForViewerQueryViewerViewerOrError()
-	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
-	GetTypename() *string
-}
+// GetMessage returns grantSplitRoleMutationGrantSplitRoleErrNotAuthorized.Message, and is useful for accessing the field via an interface.
+func (v *grantSplitRoleMutationGrantSplitRoleErrNotAuthorized) GetMessage() string { return v.Message }
 
-func (v *notificationsForViewerQueryViewerErrNotAuthorized) implementsGraphQLInterfacenotificationsForViewerQueryViewerViewerOrError() {
-}
-func (v *notificationsForViewerQueryViewer) implementsGraphQLInterfacenotificationsForViewerQueryViewerViewerOrError() {
+// grantSplitRoleMutationGrantSplitRoleErrSplitNotFound includes the requested fields of the GraphQL type ErrSplitNotFound.
+type grantSplitRoleMutationGrantSplitRoleErrSplitNotFound struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
 }
 
-func __unmarshalnotificationsForViewerQueryViewerViewerOrError(b []byte, v *notificationsForViewerQueryViewerViewerOrError) error {
-	if string(b) == "null" {
-		return nil
-	}
-
-	var tn struct {
-		TypeName string `json:"__typename"`
-	}
-	err := json.Unmarshal(b, &tn)
-	if err != nil {
-		return err
-	}
-
-	switch tn.TypeName {
-	case "ErrNotAuthorized":
-		*v = new(notificationsForViewerQueryViewerErrNotAuthorized)
-		return json.Unmarshal(b, *v)
-	case "Viewer":
-		*v = new(notificationsForViewerQueryViewer)
-		return json.Unmarshal(b, *v)
-	case "":
-		return fmt.Errorf(
-			"response was missing ViewerOrError.__typename")
-	default:
-		return fmt.Errorf(
-			`unexpected concrete type for notificationsForViewerQueryViewerViewerOrError: "%v"`, tn.TypeName)
-	}
+// GetTypename returns grantSplitRoleMutationGrantSplitRoleErrSplitNotFound.Typename, and is useful for accessing the field via an interface.
+func (v *grantSplitRoleMutationGrantSplitRoleErrSplitNotFound) GetTypename() *string {
+	return v.Typename
 }
 
-func __marshalnotificationsForViewerQueryViewerViewerOrError(v *notificationsForViewerQueryViewerViewerOrError) ([]byte, error) {
-
-	var typename string
-	switch v := (*v).(type) {
-	case *notificationsForViewerQueryViewerErrNotAuthorized:
-		typename = "ErrNotAuthorized"
-
-		result := struct {
-			TypeName string `json:"__typename"`
-			*notificationsForViewerQueryViewerErrNotAuthorized
-		}{typename, v}
-		return json.Marshal(result)
-	case *notificationsForViewerQueryViewer:
-		typename = "Viewer"
-
-		result := struct {
-			TypeName string `json:"__typename"`
-			*notificationsForViewerQueryViewer
-		}{typename, v}
-		return json.Marshal(result)
-	case nil:
-		return []byte("null"), nil
-	default:
-		return nil, fmt.Errorf(
-			`unexpected concrete type for notificationsForViewerQueryViewerViewerOrError: "%T"`, v)
-	}
-}
+// GetMessage returns grantSplitRoleMutationGrantSplitRoleErrSplitNotFound.Message, and is useful for accessing the field via an interface.
+func (v *grantSplitRoleMutationGrantSplitRoleErrSplitNotFound) GetMessage() string { return v.Message }
 
-// publishSplitMutationPublishSplitErrInvalidInput includes the requested fields of the GraphQL type ErrInvalidInput.
-type publishSplitMutationPublishSplitErrInvalidInput struct {
+// grantSplitRoleMutationGrantSplitRoleErrUserNotFound includes the requested fields of the GraphQL type ErrUserNotFound.
+type grantSplitRoleMutationGrantSplitRoleErrUserNotFound struct {
 	Typename *string `json:"__typename"`
 	Message  string  `json:"message"`
 }
 
-// GetTypename returns publishSplitMutationPublishSplitErrInvalidInput.Typename, and is useful for accessing the field via an interface.
-func (v *publishSplitMutationPublishSplitErrInvalidInput) GetTypename() *string { return v.Typename }
+// GetTypename returns grantSplitRoleMutationGrantSplitRoleErrUserNotFound.Typename, and is useful for accessing the field via an interface.
+func (v *grantSplitRoleMutationGrantSplitRoleErrUserNotFound) GetTypename() *string {
+	return v.Typename
+}
 
-// GetMessage returns publishSplitMutationPublishSplitErrInvalidInput.Message, and is useful for accessing the field via an interface.
-func (v *publishSplitMutationPublishSplitErrInvalidInput) GetMessage() string { return v.Message }
+// GetMessage returns grantSplitRoleMutationGrantSplitRoleErrUserNotFound.Message, and is useful for accessing the field via an interface.
+func (v *grantSplitRoleMutationGrantSplitRoleErrUserNotFound) GetMessage() string { return v.Message }
 
-// publishSplitMutationPublishSplitErrNotAuthorized includes the requested fields of the GraphQL type ErrNotAuthorized.
-type publishSplitMutationPublishSplitErrNotAuthorized struct {
-	Typename *string `json:"__typename"`
-	Message  string  `json:"message"`
+// grantSplitRoleMutationGrantSplitRoleGrantSplitRolePayload includes the requested fields of the GraphQL type GrantSplitRolePayload.
+type grantSplitRoleMutationGrantSplitRoleGrantSplitRolePayload struct {
+	Typename *string                                                                     `json:"__typename"`
+	Member   *grantSplitRoleMutationGrantSplitRoleGrantSplitRolePayloadMemberSplitMember `json:"member"`
 }
 
-// GetTypename returns publishSplitMutationPublishSplitErrNotAuthorized.Typename, and is useful for accessing the field via an interface.
-func (v *publishSplitMutationPublishSplitErrNotAuthorized) GetTypename() *string { return v.Typename }
-
-// GetMessage returns publishSplitMutationPublishSplitErrNotAuthorized.Message, and is useful for accessing the field via an interface.
-func (v *publishSplitMutationPublishSplitErrNotAuthorized) GetMessage() string { return v.Message }
+// GetTypename returns grantSplitRoleMutationGrantSplitRoleGrantSplitRolePayload.Typename, and is useful for accessing the field via an interface.
+func (v *grantSplitRoleMutationGrantSplitRoleGrantSplitRolePayload) GetTypename() *string {
+	return v.Typename
+}
 
-// publishSplitMutationPublishSplitPublishSplitPayload includes the requested fields of the GraphQL type PublishSplitPayload.
-type publishSplitMutationPublishSplitPublishSplitPayload struct {
-	Typename *string                                                   `json:"__typename"`
-	Split    *publishSplitMutationPublishSplitPublishSplitPayloadSplit `json:"split"`
+// GetMember returns grantSplitRoleMutationGrantSplitRoleGrantSplitRolePayload.Member, and is useful for accessing the field via an interface.
+func (v *grantSplitRoleMutationGrantSplitRoleGrantSplitRolePayload) GetMember() *grantSplitRoleMutationGrantSplitRoleGrantSplitRolePayloadMemberSplitMember {
+	return v.Member
 }
 
-// GetTypename returns publishSplitMutationPublishSplitPublishSplitPayload.Typename, and is useful for accessing the field via an interface.
-func (v *publishSplitMutationPublishSplitPublishSplitPayload) GetTypename() *string {
-	return v.Typename
+// grantSplitRoleMutationGrantSplitRoleGrantSplitRolePayloadMemberSplitMember includes the requested fields of the GraphQL type SplitMember.
+type grantSplitRoleMutationGrantSplitRoleGrantSplitRolePayloadMemberSplitMember struct {
+	Dbid persist.DBID `json:"dbid"`
 }
 
-// GetSplit returns publishSplitMutationPublishSplitPublishSplitPayload.Split, and is useful for accessing the field via an interface.
-func (v *publishSplitMutationPublishSplitPublishSplitPayload) GetSplit() *publishSplitMutationPublishSplitPublishSplitPayloadSplit {
-	return v.Split
+// GetDbid returns grantSplitRoleMutationGrantSplitRoleGrantSplitRolePayloadMemberSplitMember.Dbid, and is useful for accessing the field via an interface.
+func (v *grantSplitRoleMutationGrantSplitRoleGrantSplitRolePayloadMemberSplitMember) GetDbid() persist.DBID {
+	return v.Dbid
 }
 
-// publishSplitMutationPublishSplitPublishSplitPayloadOrError includes the requested fields of the GraphQL interface PublishSplitPayloadOrError.
+// grantSplitRoleMutationGrantSplitRoleGrantSplitRolePayloadOrError includes the requested fields of the GraphQL interface GrantSplitRolePayloadOrError.
 //
-// publishSplitMutationPublishSplitPublishSplitPayloadOrError is implemented by the following types:
-// publishSplitMutationPublishSplitErrInvalidInput
-// publishSplitMutationPublishSplitErrNotAuthorized
-// publishSplitMutationPublishSplitPublishSplitPayload
-type publishSplitMutationPublishSplitPublishSplitPayloadOrError interface {
-	implementsGraphQLInterfacepublishSplitMutationPublishSplitPublishSplitPayloadOrError()
+// grantSplitRoleMutationGrantSplitRoleGrantSplitRolePayloadOrError is implemented by the following types:
+// grantSplitRoleMutationGrantSplitRoleErrInvalidInput
+// grantSplitRoleMutationGrantSplitRoleErrNotAuthorized
+// grantSplitRoleMutationGrantSplitRoleErrSplitNotFound
+// grantSplitRoleMutationGrantSplitRoleErrUserNotFound
+// grantSplitRoleMutationGrantSplitRoleGrantSplitRolePayload
+type grantSplitRoleMutationGrantSplitRoleGrantSplitRolePayloadOrError interface {
+	implementsGraphQLInterfacegrantSplitRoleMutationGrantSplitRoleGrantSplitRolePayloadOrError()
 	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
 	GetTypename() *string
 }
 
-func (v *publishSplitMutationPublishSplitErrInvalidInput) implementsGraphQLInterfacepublishSplitMutationPublishSplitPublishSplitPayloadOrError() {
+func (v *grantSplitRoleMutationGrantSplitRoleErrInvalidInput) implementsGraphQLInterfacegrantSplitRoleMutationGrantSplitRoleGrantSplitRolePayloadOrError() {
 }
-func (v *publishSplitMutationPublishSplitErrNotAuthorized) implementsGraphQLInterfacepublishSplitMutationPublishSplitPublishSplitPayloadOrError() {
+func (v *grantSplitRoleMutationGrantSplitRoleErrNotAuthorized) implementsGraphQLInterfacegrantSplitRoleMutationGrantSplitRoleGrantSplitRolePayloadOrError() {
 }
-func (v *publishSplitMutationPublishSplitPublishSplitPayload) implementsGraphQLInterfacepublishSplitMutationPublishSplitPublishSplitPayloadOrError() {
+func (v *grantSplitRoleMutationGrantSplitRoleErrSplitNotFound) implementsGraphQLInterfacegrantSplitRoleMutationGrantSplitRoleGrantSplitRolePayloadOrError() {
+}
+func (v *grantSplitRoleMutationGrantSplitRoleErrUserNotFound) implementsGraphQLInterfacegrantSplitRoleMutationGrantSplitRoleGrantSplitRolePayloadOrError() {
+}
+func (v *grantSplitRoleMutationGrantSplitRoleGrantSplitRolePayload) implementsGraphQLInterfacegrantSplitRoleMutationGrantSplitRoleGrantSplitRolePayloadOrError() {
 }
 
-func __unmarshalpublishSplitMutationPublishSplitPublishSplitPayloadOrError(b []byte, v *publishSplitMutationPublishSplitPublishSplitPayloadOrError) error {
+func __unmarshalgrantSplitRoleMutationGrantSplitRoleGrantSplitRolePayloadOrError(b []byte, v *grantSplitRoleMutationGrantSplitRoleGrantSplitRolePayloadOrError) error {
 	if string(b) == "null" {
 		return nil
 	}
@@ -1899,91 +2626,104 @@ func __unmarshalpublishSplitMutationPublishSplitPublishSplitPayloadOrError(b []b
 
 	switch tn.TypeName {
 	case "ErrInvalidInput":
-		*v = new(publishSplitMutationPublishSplitErrInvalidInput)
+		*v = new(grantSplitRoleMutationGrantSplitRoleErrInvalidInput)
 		return json.Unmarshal(b, *v)
 	case "ErrNotAuthorized":
-		*v = new(publishSplitMutationPublishSplitErrNotAuthorized)
+		*v = new(grantSplitRoleMutationGrantSplitRoleErrNotAuthorized)
 		return json.Unmarshal(b, *v)
-	case "PublishSplitPayload":
-		*v = new(publishSplitMutationPublishSplitPublishSplitPayload)
+	case "ErrSplitNotFound":
+		*v = new(grantSplitRoleMutationGrantSplitRoleErrSplitNotFound)
+		return json.Unmarshal(b, *v)
+	case "ErrUserNotFound":
+		*v = new(grantSplitRoleMutationGrantSplitRoleErrUserNotFound)
+		return json.Unmarshal(b, *v)
+	case "GrantSplitRolePayload":
+		*v = new(grantSplitRoleMutationGrantSplitRoleGrantSplitRolePayload)
 		return json.Unmarshal(b, *v)
 	case "":
 		return fmt.Errorf(
-			"response was missing PublishSplitPayloadOrError.__typename")
+			"response was missing GrantSplitRolePayloadOrError.__typename")
 	default:
 		return fmt.Errorf(
-			`unexpected concrete type for publishSplitMutationPublishSplitPublishSplitPayloadOrError: "%v"`, tn.TypeName)
+			`unexpected concrete type for grantSplitRoleMutationGrantSplitRoleGrantSplitRolePayloadOrError: "%v"`, tn.TypeName)
 	}
 }
 
-func __marshalpublishSplitMutationPublishSplitPublishSplitPayloadOrError(v *publishSplitMutationPublishSplitPublishSplitPayloadOrError) ([]byte, error) {
+func __marshalgrantSplitRoleMutationGrantSplitRoleGrantSplitRolePayloadOrError(v *grantSplitRoleMutationGrantSplitRoleGrantSplitRolePayloadOrError) ([]byte, error) {
 
 	var typename string
 	switch v := (*v).(type) {
-	case *publishSplitMutationPublishSplitErrInvalidInput:
+	case *grantSplitRoleMutationGrantSplitRoleErrInvalidInput:
 		typename = "ErrInvalidInput"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*publishSplitMutationPublishSplitErrInvalidInput
+			*grantSplitRoleMutationGrantSplitRoleErrInvalidInput
 		}{typename, v}
 		return json.Marshal(result)
-	case *publishSplitMutationPublishSplitErrNotAuthorized:
+	case *grantSplitRoleMutationGrantSplitRoleErrNotAuthorized:
 		typename = "ErrNotAuthorized"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*publishSplitMutationPublishSplitErrNotAuthorized
+			*grantSplitRoleMutationGrantSplitRoleErrNotAuthorized
 		}{typename, v}
 		return json.Marshal(result)
-	case *publishSplitMutationPublishSplitPublishSplitPayload:
-		typename = "PublishSplitPayload"
+	case *grantSplitRoleMutationGrantSplitRoleErrSplitNotFound:
+		typename = "ErrSplitNotFound"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*publishSplitMutationPublishSplitPublishSplitPayload
+			*grantSplitRoleMutationGrantSplitRoleErrSplitNotFound
+		}{typename, v}
+		return json.Marshal(result)
+	case *grantSplitRoleMutationGrantSplitRoleErrUserNotFound:
+		typename = "ErrUserNotFound"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*grantSplitRoleMutationGrantSplitRoleErrUserNotFound
+		}{typename, v}
+		return json.Marshal(result)
+	case *grantSplitRoleMutationGrantSplitRoleGrantSplitRolePayload:
+		typename = "GrantSplitRolePayload"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*grantSplitRoleMutationGrantSplitRoleGrantSplitRolePayload
 		}{typename, v}
 		return json.Marshal(result)
 	case nil:
 		return []byte("null"), nil
 	default:
 		return nil, fmt.Errorf(
-			`unexpected concrete type for publishSplitMutationPublishSplitPublishSplitPayloadOrError: "%T"`, v)
+			`unexpected concrete type for grantSplitRoleMutationGrantSplitRoleGrantSplitRolePayloadOrError: "%T"`, v)
 	}
 }
 
-// publishSplitMutationPublishSplitPublishSplitPayloadSplit includes the requested fields of the GraphQL type Split.
-type publishSplitMutationPublishSplitPublishSplitPayloadSplit struct {
-	Dbid persist.DBID `json:"dbid"`
-}
-
-// GetDbid returns publishSplitMutationPublishSplitPublishSplitPayloadSplit.Dbid, and is useful for accessing the field via an interface.
-func (v *publishSplitMutationPublishSplitPublishSplitPayloadSplit) GetDbid() persist.DBID {
-	return v.Dbid
-}
-
-// publishSplitMutationResponse is returned by publishSplitMutation on success.
-type publishSplitMutationResponse struct {
-	PublishSplit *publishSplitMutationPublishSplitPublishSplitPayloadOrError `json:"-"`
+// grantSplitRoleMutationResponse is returned by grantSplitRoleMutation on success.
+type grantSplitRoleMutationResponse struct {
+	// Gives a user a role on a split, replacing any role they were granted before. Only controllers can grant roles.
+	GrantSplitRole *grantSplitRoleMutationGrantSplitRoleGrantSplitRolePayloadOrError `json:"-"`
 }
 
-// GetPublishSplit returns publishSplitMutationResponse.PublishSplit, and is useful for accessing the field via an interface.
-func (v *publishSplitMutationResponse) GetPublishSplit() *publishSplitMutationPublishSplitPublishSplitPayloadOrError {
-	return v.PublishSplit
+// GetGrantSplitRole returns grantSplitRoleMutationResponse.GrantSplitRole, and is useful for accessing the field via an interface.
+func (v *grantSplitRoleMutationResponse) GetGrantSplitRole() *grantSplitRoleMutationGrantSplitRoleGrantSplitRolePayloadOrError {
+	return v.GrantSplitRole
 }
 
-func (v *publishSplitMutationResponse) UnmarshalJSON(b []byte) error {
+func (v *grantSplitRoleMutationResponse) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*publishSplitMutationResponse
-		PublishSplit json.RawMessage `json:"publishSplit"`
+		*grantSplitRoleMutationResponse
+		GrantSplitRole json.RawMessage `json:"grantSplitRole"`
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.publishSplitMutationResponse = v
+	firstPass.grantSplitRoleMutationResponse = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -1991,26 +2731,26 @@ func (v *publishSplitMutationResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	{
-		dst := &v.PublishSplit
-		src := firstPass.PublishSplit
+		dst := &v.GrantSplitRole
+		src := firstPass.GrantSplitRole
 		if len(src) != 0 && string(src) != "null" {
-			*dst = new(publishSplitMutationPublishSplitPublishSplitPayloadOrError)
-			err = __unmarshalpublishSplitMutationPublishSplitPublishSplitPayloadOrError(
+			*dst = new(grantSplitRoleMutationGrantSplitRoleGrantSplitRolePayloadOrError)
+			err = __unmarshalgrantSplitRoleMutationGrantSplitRoleGrantSplitRolePayloadOrError(
 				src, *dst)
 			if err != nil {
 				return fmt.Errorf(
-					"unable to unmarshal publishSplitMutationResponse.PublishSplit: %w", err)
+					"unable to unmarshal grantSplitRoleMutationResponse.GrantSplitRole: %w", err)
 			}
 		}
 	}
 	return nil
 }
 
-type __premarshalpublishSplitMutationResponse struct {
-	PublishSplit json.RawMessage `json:"publishSplit"`
+type __premarshalgrantSplitRoleMutationResponse struct {
+	GrantSplitRole json.RawMessage `json:"grantSplitRole"`
 }
 
-func (v *publishSplitMutationResponse) MarshalJSON() ([]byte, error) {
+func (v *grantSplitRoleMutationResponse) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -2018,107 +2758,1022 @@ func (v *publishSplitMutationResponse) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *publishSplitMutationResponse) __premarshalJSON() (*__premarshalpublishSplitMutationResponse, error) {
-	var retval __premarshalpublishSplitMutationResponse
+func (v *grantSplitRoleMutationResponse) __premarshalJSON() (*__premarshalgrantSplitRoleMutationResponse, error) {
+	var retval __premarshalgrantSplitRoleMutationResponse
 
 	{
 
-		dst := &retval.PublishSplit
-		src := v.PublishSplit
+		dst := &retval.GrantSplitRole
+		src := v.GrantSplitRole
 		if src != nil {
 			var err error
-			*dst, err = __marshalpublishSplitMutationPublishSplitPublishSplitPayloadOrError(
+			*dst, err = __marshalgrantSplitRoleMutationGrantSplitRoleGrantSplitRolePayloadOrError(
 				src)
 			if err != nil {
 				return nil, fmt.Errorf(
-					"unable to marshal publishSplitMutationResponse.PublishSplit: %w", err)
+					"unable to marshal grantSplitRoleMutationResponse.GrantSplitRole: %w", err)
 			}
 		}
 	}
 	return &retval, nil
 }
 
-// registerPushTokenMutationRegisterUserPushTokenErrInvalidInput includes the requested fields of the GraphQL type ErrInvalidInput.
-type registerPushTokenMutationRegisterUserPushTokenErrInvalidInput struct {
+// loginMutationLoginErrAuthenticationFailed includes the requested fields of the GraphQL type ErrAuthenticationFailed.
+type loginMutationLoginErrAuthenticationFailed struct {
 	Typename *string `json:"__typename"`
 	Message  string  `json:"message"`
 }
 
-// GetTypename returns registerPushTokenMutationRegisterUserPushTokenErrInvalidInput.Typename, and is useful for accessing the field via an interface.
-func (v *registerPushTokenMutationRegisterUserPushTokenErrInvalidInput) GetTypename() *string {
-	return v.Typename
-}
+// GetTypename returns loginMutationLoginErrAuthenticationFailed.Typename, and is useful for accessing the field via an interface.
+func (v *loginMutationLoginErrAuthenticationFailed) GetTypename() *string { return v.Typename }
 
-// GetMessage returns registerPushTokenMutationRegisterUserPushTokenErrInvalidInput.Message, and is useful for accessing the field via an interface.
-func (v *registerPushTokenMutationRegisterUserPushTokenErrInvalidInput) GetMessage() string {
-	return v.Message
-}
+// GetMessage returns loginMutationLoginErrAuthenticationFailed.Message, and is useful for accessing the field via an interface.
+func (v *loginMutationLoginErrAuthenticationFailed) GetMessage() string { return v.Message }
 
-// registerPushTokenMutationRegisterUserPushTokenErrNotAuthorized includes the requested fields of the GraphQL type ErrNotAuthorized.
-type registerPushTokenMutationRegisterUserPushTokenErrNotAuthorized struct {
+// loginMutationLoginErrDoesNotOwnRequiredToken includes the requested fields of the GraphQL type ErrDoesNotOwnRequiredToken.
+type loginMutationLoginErrDoesNotOwnRequiredToken struct {
 	Typename *string `json:"__typename"`
 	Message  string  `json:"message"`
 }
 
-// GetTypename returns registerPushTokenMutationRegisterUserPushTokenErrNotAuthorized.Typename, and is useful for accessing the field via an interface.
-func (v *registerPushTokenMutationRegisterUserPushTokenErrNotAuthorized) GetTypename() *string {
-	return v.Typename
-}
-
+// GetTypename returns loginMutationLoginErrDoesNotOwnRequiredToken.Typename, and is useful for accessing the field via an interface.
+func (v *loginMutationLoginErrDoesNotOwnRequiredToken) GetTypename() *string { return v.Typename }
+
+// GetMessage returns loginMutationLoginErrDoesNotOwnRequiredToken.Message, and is useful for accessing the field via an interface.
+func (v *loginMutationLoginErrDoesNotOwnRequiredToken) GetMessage() string { return v.Message }
+
+// loginMutationLoginErrUserNotFound includes the requested fields of the GraphQL type ErrUserNotFound.
+type loginMutationLoginErrUserNotFound struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns loginMutationLoginErrUserNotFound.Typename, and is useful for accessing the field via an interface.
+func (v *loginMutationLoginErrUserNotFound) GetTypename() *string { return v.Typename }
+
+// GetMessage returns loginMutationLoginErrUserNotFound.Message, and is useful for accessing the field via an interface.
+func (v *loginMutationLoginErrUserNotFound) GetMessage() string { return v.Message }
+
+// loginMutationLoginLoginPayload includes the requested fields of the GraphQL type LoginPayload.
+type loginMutationLoginLoginPayload struct {
+	Typename *string                               `json:"__typename"`
+	Viewer   *loginMutationLoginLoginPayloadViewer `json:"viewer"`
+}
+
+// GetTypename returns loginMutationLoginLoginPayload.Typename, and is useful for accessing the field via an interface.
+func (v *loginMutationLoginLoginPayload) GetTypename() *string { return v.Typename }
+
+// GetViewer returns loginMutationLoginLoginPayload.Viewer, and is useful for accessing the field via an interface.
+func (v *loginMutationLoginLoginPayload) GetViewer() *loginMutationLoginLoginPayloadViewer {
+	return v.Viewer
+}
+
+// loginMutationLoginLoginPayloadOrError includes the requested fields of the GraphQL interface LoginPayloadOrError.
+//
+// loginMutationLoginLoginPayloadOrError is implemented by the following types:
+// loginMutationLoginErrAuthenticationFailed
+// loginMutationLoginErrDoesNotOwnRequiredToken
+// loginMutationLoginErrUserNotFound
+// loginMutationLoginLoginPayload
+type loginMutationLoginLoginPayloadOrError interface {
+	implementsGraphQLInterfaceloginMutationLoginLoginPayloadOrError()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() *string
+}
+
+func (v *loginMutationLoginErrAuthenticationFailed) implementsGraphQLInterfaceloginMutationLoginLoginPayloadOrError() {
+}
+func (v *loginMutationLoginErrDoesNotOwnRequiredToken) implementsGraphQLInterfaceloginMutationLoginLoginPayloadOrError() {
+}
+func (v *loginMutationLoginErrUserNotFound) implementsGraphQLInterfaceloginMutationLoginLoginPayloadOrError() {
+}
+func (v *loginMutationLoginLoginPayload) implementsGraphQLInterfaceloginMutationLoginLoginPayloadOrError() {
+}
+
+func __unmarshalloginMutationLoginLoginPayloadOrError(b []byte, v *loginMutationLoginLoginPayloadOrError) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "ErrAuthenticationFailed":
+		*v = new(loginMutationLoginErrAuthenticationFailed)
+		return json.Unmarshal(b, *v)
+	case "ErrDoesNotOwnRequiredToken":
+		*v = new(loginMutationLoginErrDoesNotOwnRequiredToken)
+		return json.Unmarshal(b, *v)
+	case "ErrUserNotFound":
+		*v = new(loginMutationLoginErrUserNotFound)
+		return json.Unmarshal(b, *v)
+	case "LoginPayload":
+		*v = new(loginMutationLoginLoginPayload)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing LoginPayloadOrError.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for loginMutationLoginLoginPayloadOrError: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalloginMutationLoginLoginPayloadOrError(v *loginMutationLoginLoginPayloadOrError) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *loginMutationLoginErrAuthenticationFailed:
+		typename = "ErrAuthenticationFailed"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*loginMutationLoginErrAuthenticationFailed
+		}{typename, v}
+		return json.Marshal(result)
+	case *loginMutationLoginErrDoesNotOwnRequiredToken:
+		typename = "ErrDoesNotOwnRequiredToken"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*loginMutationLoginErrDoesNotOwnRequiredToken
+		}{typename, v}
+		return json.Marshal(result)
+	case *loginMutationLoginErrUserNotFound:
+		typename = "ErrUserNotFound"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*loginMutationLoginErrUserNotFound
+		}{typename, v}
+		return json.Marshal(result)
+	case *loginMutationLoginLoginPayload:
+		typename = "LoginPayload"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*loginMutationLoginLoginPayload
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for loginMutationLoginLoginPayloadOrError: "%T"`, v)
+	}
+}
+
+// loginMutationLoginLoginPayloadViewer includes the requested fields of the GraphQL type Viewer.
+type loginMutationLoginLoginPayloadViewer struct {
+	User *loginMutationLoginLoginPayloadViewerUserSplitFiUser `json:"user"`
+}
+
+// GetUser returns loginMutationLoginLoginPayloadViewer.User, and is useful for accessing the field via an interface.
+func (v *loginMutationLoginLoginPayloadViewer) GetUser() *loginMutationLoginLoginPayloadViewerUserSplitFiUser {
+	return v.User
+}
+
+// loginMutationLoginLoginPayloadViewerUserSplitFiUser includes the requested fields of the GraphQL type SplitFiUser.
+type loginMutationLoginLoginPayloadViewerUserSplitFiUser struct {
+	Username *string      `json:"username"`
+	Dbid     persist.DBID `json:"dbid"`
+}
+
+// GetUsername returns loginMutationLoginLoginPayloadViewerUserSplitFiUser.Username, and is useful for accessing the field via an interface.
+func (v *loginMutationLoginLoginPayloadViewerUserSplitFiUser) GetUsername() *string {
+	return v.Username
+}
+
+// GetDbid returns loginMutationLoginLoginPayloadViewerUserSplitFiUser.Dbid, and is useful for accessing the field via an interface.
+func (v *loginMutationLoginLoginPayloadViewerUserSplitFiUser) GetDbid() persist.DBID { return v.Dbid }
+
+// loginMutationResponse is returned by loginMutation on success.
+type loginMutationResponse struct {
+	Login *loginMutationLoginLoginPayloadOrError `json:"-"`
+}
+
+// GetLogin returns loginMutationResponse.Login, and is useful for accessing the field via an interface.
+func (v *loginMutationResponse) GetLogin() *loginMutationLoginLoginPayloadOrError { return v.Login }
+
+func (v *loginMutationResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*loginMutationResponse
+		Login json.RawMessage `json:"login"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.loginMutationResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Login
+		src := firstPass.Login
+		if len(src) != 0 && string(src) != "null" {
+			*dst = new(loginMutationLoginLoginPayloadOrError)
+			err = __unmarshalloginMutationLoginLoginPayloadOrError(
+				src, *dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal loginMutationResponse.Login: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalloginMutationResponse struct {
+	Login json.RawMessage `json:"login"`
+}
+
+func (v *loginMutationResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *loginMutationResponse) __premarshalJSON() (*__premarshalloginMutationResponse, error) {
+	var retval __premarshalloginMutationResponse
+
+	{
+
+		dst := &retval.Login
+		src := v.Login
+		if src != nil {
+			var err error
+			*dst, err = __marshalloginMutationLoginLoginPayloadOrError(
+				src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"unable to marshal loginMutationResponse.Login: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// logoutMutationLogoutLogoutPayload includes the requested fields of the GraphQL type LogoutPayload.
+type logoutMutationLogoutLogoutPayload struct {
+	Viewer *logoutMutationLogoutLogoutPayloadViewer `json:"viewer"`
+}
+
+// GetViewer returns logoutMutationLogoutLogoutPayload.Viewer, and is useful for accessing the field via an interface.
+func (v *logoutMutationLogoutLogoutPayload) GetViewer() *logoutMutationLogoutLogoutPayloadViewer {
+	return v.Viewer
+}
+
+// logoutMutationLogoutLogoutPayloadViewer includes the requested fields of the GraphQL type Viewer.
+type logoutMutationLogoutLogoutPayloadViewer struct {
+	User *logoutMutationLogoutLogoutPayloadViewerUserSplitFiUser `json:"user"`
+}
+
+// GetUser returns logoutMutationLogoutLogoutPayloadViewer.User, and is useful for accessing the field via an interface.
+func (v *logoutMutationLogoutLogoutPayloadViewer) GetUser() *logoutMutationLogoutLogoutPayloadViewerUserSplitFiUser {
+	return v.User
+}
+
+// logoutMutationLogoutLogoutPayloadViewerUserSplitFiUser includes the requested fields of the GraphQL type SplitFiUser.
+type logoutMutationLogoutLogoutPayloadViewerUserSplitFiUser struct {
+	Username *string      `json:"username"`
+	Dbid     persist.DBID `json:"dbid"`
+}
+
+// GetUsername returns logoutMutationLogoutLogoutPayloadViewerUserSplitFiUser.Username, and is useful for accessing the field via an interface.
+func (v *logoutMutationLogoutLogoutPayloadViewerUserSplitFiUser) GetUsername() *string {
+	return v.Username
+}
+
+// GetDbid returns logoutMutationLogoutLogoutPayloadViewerUserSplitFiUser.Dbid, and is useful for accessing the field via an interface.
+func (v *logoutMutationLogoutLogoutPayloadViewerUserSplitFiUser) GetDbid() persist.DBID {
+	return v.Dbid
+}
+
+// logoutMutationResponse is returned by logoutMutation on success.
+type logoutMutationResponse struct {
+	Logout *logoutMutationLogoutLogoutPayload `json:"logout"`
+}
+
+// GetLogout returns logoutMutationResponse.Logout, and is useful for accessing the field via an interface.
+func (v *logoutMutationResponse) GetLogout() *logoutMutationLogoutLogoutPayload { return v.Logout }
+
+// notificationsForViewerQueryResponse is returned by notificationsForViewerQuery on success.
+type notificationsForViewerQueryResponse struct {
+	Viewer *notificationsForViewerQueryViewerViewerOrError `json:"-"`
+}
+
+// GetViewer returns notificationsForViewerQueryResponse.Viewer, and is useful for accessing the field via an interface.
+func (v *notificationsForViewerQueryResponse) GetViewer() *notificationsForViewerQueryViewerViewerOrError {
+	return v.Viewer
+}
+
+func (v *notificationsForViewerQueryResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*notificationsForViewerQueryResponse
+		Viewer json.RawMessage `json:"viewer"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.notificationsForViewerQueryResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Viewer
+		src := firstPass.Viewer
+		if len(src) != 0 && string(src) != "null" {
+			*dst = new(notificationsForViewerQueryViewerViewerOrError)
+			err = __unmarshalnotificationsForViewerQueryViewerViewerOrError(
+				src, *dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal notificationsForViewerQueryResponse.Viewer: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalnotificationsForViewerQueryResponse struct {
+	Viewer json.RawMessage `json:"viewer"`
+}
+
+func (v *notificationsForViewerQueryResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *notificationsForViewerQueryResponse) __premarshalJSON() (*__premarshalnotificationsForViewerQueryResponse, error) {
+	var retval __premarshalnotificationsForViewerQueryResponse
+
+	{
+
+		dst := &retval.Viewer
+		src := v.Viewer
+		if src != nil {
+			var err error
+			*dst, err = __marshalnotificationsForViewerQueryViewerViewerOrError(
+				src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"unable to marshal notificationsForViewerQueryResponse.Viewer: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// notificationsForViewerQueryViewer includes the requested fields of the GraphQL type Viewer.
+type notificationsForViewerQueryViewer struct {
+	Typename *string `json:"__typename"`
+	// Returns a list of notifications in reverse chronological order.
+	// Seen notifications come after unseen notifications
+	Notifications *notificationsForViewerQueryViewerNotificationsNotificationsConnection `json:"notifications"`
+}
+
+// GetTypename returns notificationsForViewerQueryViewer.Typename, and is useful for accessing the field via an interface.
+func (v *notificationsForViewerQueryViewer) GetTypename() *string { return v.Typename }
+
+// GetNotifications returns notificationsForViewerQueryViewer.Notifications, and is useful for accessing the field via an interface.
+func (v *notificationsForViewerQueryViewer) GetNotifications() *notificationsForViewerQueryViewerNotificationsNotificationsConnection {
+	return v.Notifications
+}
+
+// notificationsForViewerQueryViewerErrNotAuthorized includes the requested fields of the GraphQL type ErrNotAuthorized.
+type notificationsForViewerQueryViewerErrNotAuthorized struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns notificationsForViewerQueryViewerErrNotAuthorized.Typename, and is useful for accessing the field via an interface.
+func (v *notificationsForViewerQueryViewerErrNotAuthorized) GetTypename() *string { return v.Typename }
+
+// GetMessage returns notificationsForViewerQueryViewerErrNotAuthorized.Message, and is useful for accessing the field via an interface.
+func (v *notificationsForViewerQueryViewerErrNotAuthorized) GetMessage() string { return v.Message }
+
+// notificationsForViewerQueryViewerNotificationsNotificationsConnection includes the requested fields of the GraphQL type NotificationsConnection.
+type notificationsForViewerQueryViewerNotificationsNotificationsConnection struct {
+	UnseenCount *int `json:"unseenCount"`
+}
+
+// GetUnseenCount returns notificationsForViewerQueryViewerNotificationsNotificationsConnection.UnseenCount, and is useful for accessing the field via an interface.
+func (v *notificationsForViewerQueryViewerNotificationsNotificationsConnection) GetUnseenCount() *int {
+	return v.UnseenCount
+}
+
+// notificationsForViewerQueryViewerViewerOrError includes the requested fields of the GraphQL interface ViewerOrError.
+//
+// notificationsForViewerQueryViewerViewerOrError is implemented by the following types:
+// notificationsForViewerQueryViewerErrNotAuthorized
+// notificationsForViewerQueryViewer
+type notificationsForViewerQueryViewerViewerOrError interface {
+	implementsGraphQLInterfacenotificationsForViewerQueryViewerViewerOrError()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() *string
+}
+
+func (v *notificationsForViewerQueryViewerErrNotAuthorized) implementsGraphQLInterfacenotificationsForViewerQueryViewerViewerOrError() {
+}
+func (v *notificationsForViewerQueryViewer) implementsGraphQLInterfacenotificationsForViewerQueryViewerViewerOrError() {
+}
+
+func __unmarshalnotificationsForViewerQueryViewerViewerOrError(b []byte, v *notificationsForViewerQueryViewerViewerOrError) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "ErrNotAuthorized":
+		*v = new(notificationsForViewerQueryViewerErrNotAuthorized)
+		return json.Unmarshal(b, *v)
+	case "Viewer":
+		*v = new(notificationsForViewerQueryViewer)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing ViewerOrError.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for notificationsForViewerQueryViewerViewerOrError: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalnotificationsForViewerQueryViewerViewerOrError(v *notificationsForViewerQueryViewerViewerOrError) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *notificationsForViewerQueryViewerErrNotAuthorized:
+		typename = "ErrNotAuthorized"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*notificationsForViewerQueryViewerErrNotAuthorized
+		}{typename, v}
+		return json.Marshal(result)
+	case *notificationsForViewerQueryViewer:
+		typename = "Viewer"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*notificationsForViewerQueryViewer
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for notificationsForViewerQueryViewerViewerOrError: "%T"`, v)
+	}
+}
+
+// publishSplitMutationPublishSplitErrInvalidInput includes the requested fields of the GraphQL type ErrInvalidInput.
+type publishSplitMutationPublishSplitErrInvalidInput struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns publishSplitMutationPublishSplitErrInvalidInput.Typename, and is useful for accessing the field via an interface.
+func (v *publishSplitMutationPublishSplitErrInvalidInput) GetTypename() *string { return v.Typename }
+
+// GetMessage returns publishSplitMutationPublishSplitErrInvalidInput.Message, and is useful for accessing the field via an interface.
+func (v *publishSplitMutationPublishSplitErrInvalidInput) GetMessage() string { return v.Message }
+
+// publishSplitMutationPublishSplitErrNotAuthorized includes the requested fields of the GraphQL type ErrNotAuthorized.
+type publishSplitMutationPublishSplitErrNotAuthorized struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns publishSplitMutationPublishSplitErrNotAuthorized.Typename, and is useful for accessing the field via an interface.
+func (v *publishSplitMutationPublishSplitErrNotAuthorized) GetTypename() *string { return v.Typename }
+
+// GetMessage returns publishSplitMutationPublishSplitErrNotAuthorized.Message, and is useful for accessing the field via an interface.
+func (v *publishSplitMutationPublishSplitErrNotAuthorized) GetMessage() string { return v.Message }
+
+// publishSplitMutationPublishSplitPublishSplitPayload includes the requested fields of the GraphQL type PublishSplitPayload.
+type publishSplitMutationPublishSplitPublishSplitPayload struct {
+	Typename *string                                                   `json:"__typename"`
+	Split    *publishSplitMutationPublishSplitPublishSplitPayloadSplit `json:"split"`
+}
+
+// GetTypename returns publishSplitMutationPublishSplitPublishSplitPayload.Typename, and is useful for accessing the field via an interface.
+func (v *publishSplitMutationPublishSplitPublishSplitPayload) GetTypename() *string {
+	return v.Typename
+}
+
+// GetSplit returns publishSplitMutationPublishSplitPublishSplitPayload.Split, and is useful for accessing the field via an interface.
+func (v *publishSplitMutationPublishSplitPublishSplitPayload) GetSplit() *publishSplitMutationPublishSplitPublishSplitPayloadSplit {
+	return v.Split
+}
+
+// publishSplitMutationPublishSplitPublishSplitPayloadOrError includes the requested fields of the GraphQL interface PublishSplitPayloadOrError.
+//
+// publishSplitMutationPublishSplitPublishSplitPayloadOrError is implemented by the following types:
+// publishSplitMutationPublishSplitErrInvalidInput
+// publishSplitMutationPublishSplitErrNotAuthorized
+// publishSplitMutationPublishSplitPublishSplitPayload
+type publishSplitMutationPublishSplitPublishSplitPayloadOrError interface {
+	implementsGraphQLInterfacepublishSplitMutationPublishSplitPublishSplitPayloadOrError()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() *string
+}
+
+func (v *publishSplitMutationPublishSplitErrInvalidInput) implementsGraphQLInterfacepublishSplitMutationPublishSplitPublishSplitPayloadOrError() {
+}
+func (v *publishSplitMutationPublishSplitErrNotAuthorized) implementsGraphQLInterfacepublishSplitMutationPublishSplitPublishSplitPayloadOrError() {
+}
+func (v *publishSplitMutationPublishSplitPublishSplitPayload) implementsGraphQLInterfacepublishSplitMutationPublishSplitPublishSplitPayloadOrError() {
+}
+
+func __unmarshalpublishSplitMutationPublishSplitPublishSplitPayloadOrError(b []byte, v *publishSplitMutationPublishSplitPublishSplitPayloadOrError) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "ErrInvalidInput":
+		*v = new(publishSplitMutationPublishSplitErrInvalidInput)
+		return json.Unmarshal(b, *v)
+	case "ErrNotAuthorized":
+		*v = new(publishSplitMutationPublishSplitErrNotAuthorized)
+		return json.Unmarshal(b, *v)
+	case "PublishSplitPayload":
+		*v = new(publishSplitMutationPublishSplitPublishSplitPayload)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing PublishSplitPayloadOrError.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for publishSplitMutationPublishSplitPublishSplitPayloadOrError: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalpublishSplitMutationPublishSplitPublishSplitPayloadOrError(v *publishSplitMutationPublishSplitPublishSplitPayloadOrError) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *publishSplitMutationPublishSplitErrInvalidInput:
+		typename = "ErrInvalidInput"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*publishSplitMutationPublishSplitErrInvalidInput
+		}{typename, v}
+		return json.Marshal(result)
+	case *publishSplitMutationPublishSplitErrNotAuthorized:
+		typename = "ErrNotAuthorized"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*publishSplitMutationPublishSplitErrNotAuthorized
+		}{typename, v}
+		return json.Marshal(result)
+	case *publishSplitMutationPublishSplitPublishSplitPayload:
+		typename = "PublishSplitPayload"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*publishSplitMutationPublishSplitPublishSplitPayload
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for publishSplitMutationPublishSplitPublishSplitPayloadOrError: "%T"`, v)
+	}
+}
+
+// publishSplitMutationPublishSplitPublishSplitPayloadSplit includes the requested fields of the GraphQL type Split.
+type publishSplitMutationPublishSplitPublishSplitPayloadSplit struct {
+	Dbid persist.DBID `json:"dbid"`
+}
+
+// GetDbid returns publishSplitMutationPublishSplitPublishSplitPayloadSplit.Dbid, and is useful for accessing the field via an interface.
+func (v *publishSplitMutationPublishSplitPublishSplitPayloadSplit) GetDbid() persist.DBID {
+	return v.Dbid
+}
+
+// publishSplitMutationResponse is returned by publishSplitMutation on success.
+type publishSplitMutationResponse struct {
+	PublishSplit *publishSplitMutationPublishSplitPublishSplitPayloadOrError `json:"-"`
+}
+
+// GetPublishSplit returns publishSplitMutationResponse.PublishSplit, and is useful for accessing the field via an interface.
+func (v *publishSplitMutationResponse) GetPublishSplit() *publishSplitMutationPublishSplitPublishSplitPayloadOrError {
+	return v.PublishSplit
+}
+
+func (v *publishSplitMutationResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*publishSplitMutationResponse
+		PublishSplit json.RawMessage `json:"publishSplit"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.publishSplitMutationResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.PublishSplit
+		src := firstPass.PublishSplit
+		if len(src) != 0 && string(src) != "null" {
+			*dst = new(publishSplitMutationPublishSplitPublishSplitPayloadOrError)
+			err = __unmarshalpublishSplitMutationPublishSplitPublishSplitPayloadOrError(
+				src, *dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal publishSplitMutationResponse.PublishSplit: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalpublishSplitMutationResponse struct {
+	PublishSplit json.RawMessage `json:"publishSplit"`
+}
+
+func (v *publishSplitMutationResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *publishSplitMutationResponse) __premarshalJSON() (*__premarshalpublishSplitMutationResponse, error) {
+	var retval __premarshalpublishSplitMutationResponse
+
+	{
+
+		dst := &retval.PublishSplit
+		src := v.PublishSplit
+		if src != nil {
+			var err error
+			*dst, err = __marshalpublishSplitMutationPublishSplitPublishSplitPayloadOrError(
+				src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"unable to marshal publishSplitMutationResponse.PublishSplit: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// registerPushTokenMutationRegisterUserPushTokenErrInvalidInput includes the requested fields of the GraphQL type ErrInvalidInput.
+type registerPushTokenMutationRegisterUserPushTokenErrInvalidInput struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns registerPushTokenMutationRegisterUserPushTokenErrInvalidInput.Typename, and is useful for accessing the field via an interface.
+func (v *registerPushTokenMutationRegisterUserPushTokenErrInvalidInput) GetTypename() *string {
+	return v.Typename
+}
+
+// GetMessage returns registerPushTokenMutationRegisterUserPushTokenErrInvalidInput.Message, and is useful for accessing the field via an interface.
+func (v *registerPushTokenMutationRegisterUserPushTokenErrInvalidInput) GetMessage() string {
+	return v.Message
+}
+
+// registerPushTokenMutationRegisterUserPushTokenErrNotAuthorized includes the requested fields of the GraphQL type ErrNotAuthorized.
+type registerPushTokenMutationRegisterUserPushTokenErrNotAuthorized struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns registerPushTokenMutationRegisterUserPushTokenErrNotAuthorized.Typename, and is useful for accessing the field via an interface.
+func (v *registerPushTokenMutationRegisterUserPushTokenErrNotAuthorized) GetTypename() *string {
+	return v.Typename
+}
+
 // GetMessage returns registerPushTokenMutationRegisterUserPushTokenErrNotAuthorized.Message, and is useful for accessing the field via an interface.
 func (v *registerPushTokenMutationRegisterUserPushTokenErrNotAuthorized) GetMessage() string {
 	return v.Message
 }
 
-// registerPushTokenMutationRegisterUserPushTokenErrPushTokenBelongsToAnotherUser includes the requested fields of the GraphQL type ErrPushTokenBelongsToAnotherUser.
-type registerPushTokenMutationRegisterUserPushTokenErrPushTokenBelongsToAnotherUser struct {
+// registerPushTokenMutationRegisterUserPushTokenErrPushTokenBelongsToAnotherUser includes the requested fields of the GraphQL type ErrPushTokenBelongsToAnotherUser.
+type registerPushTokenMutationRegisterUserPushTokenErrPushTokenBelongsToAnotherUser struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns registerPushTokenMutationRegisterUserPushTokenErrPushTokenBelongsToAnotherUser.Typename, and is useful for accessing the field via an interface.
+func (v *registerPushTokenMutationRegisterUserPushTokenErrPushTokenBelongsToAnotherUser) GetTypename() *string {
+	return v.Typename
+}
+
+// GetMessage returns registerPushTokenMutationRegisterUserPushTokenErrPushTokenBelongsToAnotherUser.Message, and is useful for accessing the field via an interface.
+func (v *registerPushTokenMutationRegisterUserPushTokenErrPushTokenBelongsToAnotherUser) GetMessage() string {
+	return v.Message
+}
+
+// registerPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayload includes the requested fields of the GraphQL type RegisterUserPushTokenPayload.
+type registerPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayload struct {
+	Typename *string `json:"__typename"`
+}
+
+// GetTypename returns registerPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayload.Typename, and is useful for accessing the field via an interface.
+func (v *registerPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayload) GetTypename() *string {
+	return v.Typename
+}
+
+// registerPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayloadOrError includes the requested fields of the GraphQL interface RegisterUserPushTokenPayloadOrError.
+//
+// registerPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayloadOrError is implemented by the following types:
+// registerPushTokenMutationRegisterUserPushTokenErrInvalidInput
+// registerPushTokenMutationRegisterUserPushTokenErrNotAuthorized
+// registerPushTokenMutationRegisterUserPushTokenErrPushTokenBelongsToAnotherUser
+// registerPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayload
+type registerPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayloadOrError interface {
+	implementsGraphQLInterfaceregisterPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayloadOrError()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() *string
+}
+
+func (v *registerPushTokenMutationRegisterUserPushTokenErrInvalidInput) implementsGraphQLInterfaceregisterPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayloadOrError() {
+}
+func (v *registerPushTokenMutationRegisterUserPushTokenErrNotAuthorized) implementsGraphQLInterfaceregisterPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayloadOrError() {
+}
+func (v *registerPushTokenMutationRegisterUserPushTokenErrPushTokenBelongsToAnotherUser) implementsGraphQLInterfaceregisterPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayloadOrError() {
+}
+func (v *registerPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayload) implementsGraphQLInterfaceregisterPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayloadOrError() {
+}
+
+func __unmarshalregisterPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayloadOrError(b []byte, v *registerPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayloadOrError) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "ErrInvalidInput":
+		*v = new(registerPushTokenMutationRegisterUserPushTokenErrInvalidInput)
+		return json.Unmarshal(b, *v)
+	case "ErrNotAuthorized":
+		*v = new(registerPushTokenMutationRegisterUserPushTokenErrNotAuthorized)
+		return json.Unmarshal(b, *v)
+	case "ErrPushTokenBelongsToAnotherUser":
+		*v = new(registerPushTokenMutationRegisterUserPushTokenErrPushTokenBelongsToAnotherUser)
+		return json.Unmarshal(b, *v)
+	case "RegisterUserPushTokenPayload":
+		*v = new(registerPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayload)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing RegisterUserPushTokenPayloadOrError.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for registerPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayloadOrError: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalregisterPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayloadOrError(v *registerPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayloadOrError) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *registerPushTokenMutationRegisterUserPushTokenErrInvalidInput:
+		typename = "ErrInvalidInput"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*registerPushTokenMutationRegisterUserPushTokenErrInvalidInput
+		}{typename, v}
+		return json.Marshal(result)
+	case *registerPushTokenMutationRegisterUserPushTokenErrNotAuthorized:
+		typename = "ErrNotAuthorized"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*registerPushTokenMutationRegisterUserPushTokenErrNotAuthorized
+		}{typename, v}
+		return json.Marshal(result)
+	case *registerPushTokenMutationRegisterUserPushTokenErrPushTokenBelongsToAnotherUser:
+		typename = "ErrPushTokenBelongsToAnotherUser"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*registerPushTokenMutationRegisterUserPushTokenErrPushTokenBelongsToAnotherUser
+		}{typename, v}
+		return json.Marshal(result)
+	case *registerPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayload:
+		typename = "RegisterUserPushTokenPayload"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*registerPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayload
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for registerPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayloadOrError: "%T"`, v)
+	}
+}
+
+// registerPushTokenMutationResponse is returned by registerPushTokenMutation on success.
+type registerPushTokenMutationResponse struct {
+	RegisterUserPushToken *registerPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayloadOrError `json:"-"`
+}
+
+// GetRegisterUserPushToken returns registerPushTokenMutationResponse.RegisterUserPushToken, and is useful for accessing the field via an interface.
+func (v *registerPushTokenMutationResponse) GetRegisterUserPushToken() *registerPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayloadOrError {
+	return v.RegisterUserPushToken
+}
+
+func (v *registerPushTokenMutationResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*registerPushTokenMutationResponse
+		RegisterUserPushToken json.RawMessage `json:"registerUserPushToken"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.registerPushTokenMutationResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.RegisterUserPushToken
+		src := firstPass.RegisterUserPushToken
+		if len(src) != 0 && string(src) != "null" {
+			*dst = new(registerPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayloadOrError)
+			err = __unmarshalregisterPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayloadOrError(
+				src, *dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal registerPushTokenMutationResponse.RegisterUserPushToken: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalregisterPushTokenMutationResponse struct {
+	RegisterUserPushToken json.RawMessage `json:"registerUserPushToken"`
+}
+
+func (v *registerPushTokenMutationResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *registerPushTokenMutationResponse) __premarshalJSON() (*__premarshalregisterPushTokenMutationResponse, error) {
+	var retval __premarshalregisterPushTokenMutationResponse
+
+	{
+
+		dst := &retval.RegisterUserPushToken
+		src := v.RegisterUserPushToken
+		if src != nil {
+			var err error
+			*dst, err = __marshalregisterPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayloadOrError(
+				src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"unable to marshal registerPushTokenMutationResponse.RegisterUserPushToken: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// removeUserWalletsMutationRemoveUserWalletsErrInvalidInput includes the requested fields of the GraphQL type ErrInvalidInput.
+type removeUserWalletsMutationRemoveUserWalletsErrInvalidInput struct {
 	Typename *string `json:"__typename"`
 	Message  string  `json:"message"`
 }
 
-// GetTypename returns registerPushTokenMutationRegisterUserPushTokenErrPushTokenBelongsToAnotherUser.Typename, and is useful for accessing the field via an interface.
-func (v *registerPushTokenMutationRegisterUserPushTokenErrPushTokenBelongsToAnotherUser) GetTypename() *string {
+// GetTypename returns removeUserWalletsMutationRemoveUserWalletsErrInvalidInput.Typename, and is useful for accessing the field via an interface.
+func (v *removeUserWalletsMutationRemoveUserWalletsErrInvalidInput) GetTypename() *string {
 	return v.Typename
 }
 
-// GetMessage returns registerPushTokenMutationRegisterUserPushTokenErrPushTokenBelongsToAnotherUser.Message, and is useful for accessing the field via an interface.
-func (v *registerPushTokenMutationRegisterUserPushTokenErrPushTokenBelongsToAnotherUser) GetMessage() string {
+// GetMessage returns removeUserWalletsMutationRemoveUserWalletsErrInvalidInput.Message, and is useful for accessing the field via an interface.
+func (v *removeUserWalletsMutationRemoveUserWalletsErrInvalidInput) GetMessage() string {
 	return v.Message
 }
 
-// registerPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayload includes the requested fields of the GraphQL type RegisterUserPushTokenPayload.
-type registerPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayload struct {
+// removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized includes the requested fields of the GraphQL type ErrNotAuthorized.
+type removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized struct {
 	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
 }
 
-// GetTypename returns registerPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayload.Typename, and is useful for accessing the field via an interface.
-func (v *registerPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayload) GetTypename() *string {
+// GetTypename returns removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized.Typename, and is useful for accessing the field via an interface.
+func (v *removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized) GetTypename() *string {
 	return v.Typename
 }
 
-// registerPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayloadOrError includes the requested fields of the GraphQL interface RegisterUserPushTokenPayloadOrError.
+// GetMessage returns removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized.Message, and is useful for accessing the field via an interface.
+func (v *removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized) GetMessage() string {
+	return v.Message
+}
+
+// removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload includes the requested fields of the GraphQL type RemoveUserWalletsPayload.
+type removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload struct {
+	Typename *string                                                                   `json:"__typename"`
+	Viewer   *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewer `json:"viewer"`
+}
+
+// GetTypename returns removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload.Typename, and is useful for accessing the field via an interface.
+func (v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload) GetTypename() *string {
+	return v.Typename
+}
+
+// GetViewer returns removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload.Viewer, and is useful for accessing the field via an interface.
+func (v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload) GetViewer() *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewer {
+	return v.Viewer
+}
+
+// removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError includes the requested fields of the GraphQL interface RemoveUserWalletsPayloadOrError.
 //
-// registerPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayloadOrError is implemented by the following types:
-// registerPushTokenMutationRegisterUserPushTokenErrInvalidInput
-// registerPushTokenMutationRegisterUserPushTokenErrNotAuthorized
-// registerPushTokenMutationRegisterUserPushTokenErrPushTokenBelongsToAnotherUser
-// registerPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayload
-type registerPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayloadOrError interface {
-	implementsGraphQLInterfaceregisterPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayloadOrError()
+// removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError is implemented by the following types:
+// removeUserWalletsMutationRemoveUserWalletsErrInvalidInput
+// removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized
+// removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload
+type removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError interface {
+	implementsGraphQLInterfaceremoveUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError()
 	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
 	GetTypename() *string
 }
 
-func (v *registerPushTokenMutationRegisterUserPushTokenErrInvalidInput) implementsGraphQLInterfaceregisterPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayloadOrError() {
-}
-func (v *registerPushTokenMutationRegisterUserPushTokenErrNotAuthorized) implementsGraphQLInterfaceregisterPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayloadOrError() {
+func (v *removeUserWalletsMutationRemoveUserWalletsErrInvalidInput) implementsGraphQLInterfaceremoveUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError() {
 }
-func (v *registerPushTokenMutationRegisterUserPushTokenErrPushTokenBelongsToAnotherUser) implementsGraphQLInterfaceregisterPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayloadOrError() {
+func (v *removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized) implementsGraphQLInterfaceremoveUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError() {
 }
-func (v *registerPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayload) implementsGraphQLInterfaceregisterPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayloadOrError() {
+func (v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload) implementsGraphQLInterfaceremoveUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError() {
 }
 
-func __unmarshalregisterPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayloadOrError(b []byte, v *registerPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayloadOrError) error {
+func __unmarshalremoveUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError(b []byte, v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError) error {
 	if string(b) == "null" {
 		return nil
 	}
@@ -2133,92 +3788,209 @@ func __unmarshalregisterPushTokenMutationRegisterUserPushTokenRegisterUserPushTo
 
 	switch tn.TypeName {
 	case "ErrInvalidInput":
-		*v = new(registerPushTokenMutationRegisterUserPushTokenErrInvalidInput)
+		*v = new(removeUserWalletsMutationRemoveUserWalletsErrInvalidInput)
 		return json.Unmarshal(b, *v)
 	case "ErrNotAuthorized":
-		*v = new(registerPushTokenMutationRegisterUserPushTokenErrNotAuthorized)
-		return json.Unmarshal(b, *v)
-	case "ErrPushTokenBelongsToAnotherUser":
-		*v = new(registerPushTokenMutationRegisterUserPushTokenErrPushTokenBelongsToAnotherUser)
+		*v = new(removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized)
 		return json.Unmarshal(b, *v)
-	case "RegisterUserPushTokenPayload":
-		*v = new(registerPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayload)
+	case "RemoveUserWalletsPayload":
+		*v = new(removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload)
 		return json.Unmarshal(b, *v)
 	case "":
 		return fmt.Errorf(
-			"response was missing RegisterUserPushTokenPayloadOrError.__typename")
+			"response was missing RemoveUserWalletsPayloadOrError.__typename")
 	default:
 		return fmt.Errorf(
-			`unexpected concrete type for registerPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayloadOrError: "%v"`, tn.TypeName)
+			`unexpected concrete type for removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError: "%v"`, tn.TypeName)
 	}
 }
 
-func __marshalregisterPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayloadOrError(v *registerPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayloadOrError) ([]byte, error) {
+func __marshalremoveUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError(v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError) ([]byte, error) {
 
 	var typename string
 	switch v := (*v).(type) {
-	case *registerPushTokenMutationRegisterUserPushTokenErrInvalidInput:
+	case *removeUserWalletsMutationRemoveUserWalletsErrInvalidInput:
 		typename = "ErrInvalidInput"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*registerPushTokenMutationRegisterUserPushTokenErrInvalidInput
+			*removeUserWalletsMutationRemoveUserWalletsErrInvalidInput
 		}{typename, v}
 		return json.Marshal(result)
-	case *registerPushTokenMutationRegisterUserPushTokenErrNotAuthorized:
+	case *removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized:
 		typename = "ErrNotAuthorized"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*registerPushTokenMutationRegisterUserPushTokenErrNotAuthorized
-		}{typename, v}
-		return json.Marshal(result)
-	case *registerPushTokenMutationRegisterUserPushTokenErrPushTokenBelongsToAnotherUser:
-		typename = "ErrPushTokenBelongsToAnotherUser"
-
-		result := struct {
-			TypeName string `json:"__typename"`
-			*registerPushTokenMutationRegisterUserPushTokenErrPushTokenBelongsToAnotherUser
+			*removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized
 		}{typename, v}
 		return json.Marshal(result)
-	case *registerPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayload:
-		typename = "RegisterUserPushTokenPayload"
+	case *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload:
+		typename = "RemoveUserWalletsPayload"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*registerPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayload
+			*removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload
 		}{typename, v}
 		return json.Marshal(result)
 	case nil:
 		return []byte("null"), nil
 	default:
 		return nil, fmt.Errorf(
-			`unexpected concrete type for registerPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayloadOrError: "%T"`, v)
+			`unexpected concrete type for removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError: "%T"`, v)
 	}
 }
 
-// registerPushTokenMutationResponse is returned by registerPushTokenMutation on success.
-type registerPushTokenMutationResponse struct {
-	RegisterUserPushToken *registerPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayloadOrError `json:"-"`
+// removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewer includes the requested fields of the GraphQL type Viewer.
+type removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewer struct {
+	User *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUser `json:"user"`
+}
+
+// GetUser returns removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewer.User, and is useful for accessing the field via an interface.
+func (v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewer) GetUser() *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUser {
+	return v.User
+}
+
+// removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUser includes the requested fields of the GraphQL type SplitFiUser.
+type removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUser struct {
+	Wallets []*removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUserWalletsWallet `json:"wallets"`
+}
+
+// GetWallets returns removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUser.Wallets, and is useful for accessing the field via an interface.
+func (v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUser) GetWallets() []*removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUserWalletsWallet {
+	return v.Wallets
+}
+
+// removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUserWalletsWallet includes the requested fields of the GraphQL type Wallet.
+type removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUserWalletsWallet struct {
+	Dbid         persist.DBID                                                                                                      `json:"dbid"`
+	ChainAddress *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUserWalletsWalletChainAddress `json:"chainAddress"`
+}
+
+// GetDbid returns removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUserWalletsWallet.Dbid, and is useful for accessing the field via an interface.
+func (v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUserWalletsWallet) GetDbid() persist.DBID {
+	return v.Dbid
+}
+
+// GetChainAddress returns removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUserWalletsWallet.ChainAddress, and is useful for accessing the field via an interface.
+func (v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUserWalletsWallet) GetChainAddress() *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUserWalletsWalletChainAddress {
+	return v.ChainAddress
+}
+
+// removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUserWalletsWalletChainAddress includes the requested fields of the GraphQL type ChainAddress.
+type removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUserWalletsWalletChainAddress struct {
+	Address *string `json:"address"`
+	Chain   *Chain  `json:"chain"`
+}
+
+// GetAddress returns removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUserWalletsWalletChainAddress.Address, and is useful for accessing the field via an interface.
+func (v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUserWalletsWalletChainAddress) GetAddress() *string {
+	return v.Address
+}
+
+// GetChain returns removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUserWalletsWalletChainAddress.Chain, and is useful for accessing the field via an interface.
+func (v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUserWalletsWalletChainAddress) GetChain() *Chain {
+	return v.Chain
+}
+
+// removeUserWalletsMutationResponse is returned by removeUserWalletsMutation on success.
+type removeUserWalletsMutationResponse struct {
+	RemoveUserWallets *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError `json:"-"`
+}
+
+// GetRemoveUserWallets returns removeUserWalletsMutationResponse.RemoveUserWallets, and is useful for accessing the field via an interface.
+func (v *removeUserWalletsMutationResponse) GetRemoveUserWallets() *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError {
+	return v.RemoveUserWallets
+}
+
+func (v *removeUserWalletsMutationResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*removeUserWalletsMutationResponse
+		RemoveUserWallets json.RawMessage `json:"removeUserWallets"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.removeUserWalletsMutationResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.RemoveUserWallets
+		src := firstPass.RemoveUserWallets
+		if len(src) != 0 && string(src) != "null" {
+			*dst = new(removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError)
+			err = __unmarshalremoveUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError(
+				src, *dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal removeUserWalletsMutationResponse.RemoveUserWallets: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalremoveUserWalletsMutationResponse struct {
+	RemoveUserWallets json.RawMessage `json:"removeUserWallets"`
+}
+
+func (v *removeUserWalletsMutationResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *removeUserWalletsMutationResponse) __premarshalJSON() (*__premarshalremoveUserWalletsMutationResponse, error) {
+	var retval __premarshalremoveUserWalletsMutationResponse
+
+	{
+
+		dst := &retval.RemoveUserWallets
+		src := v.RemoveUserWallets
+		if src != nil {
+			var err error
+			*dst, err = __marshalremoveUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError(
+				src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"unable to marshal removeUserWalletsMutationResponse.RemoveUserWallets: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// revokeSplitRoleMutationResponse is returned by revokeSplitRoleMutation on success.
+type revokeSplitRoleMutationResponse struct {
+	RevokeSplitRole *revokeSplitRoleMutationRevokeSplitRoleRevokeSplitRolePayloadOrError `json:"-"`
 }
 
-// GetRegisterUserPushToken returns registerPushTokenMutationResponse.RegisterUserPushToken, and is useful for accessing the field via an interface.
-func (v *registerPushTokenMutationResponse) GetRegisterUserPushToken() *registerPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayloadOrError {
-	return v.RegisterUserPushToken
+// GetRevokeSplitRole returns revokeSplitRoleMutationResponse.RevokeSplitRole, and is useful for accessing the field via an interface.
+func (v *revokeSplitRoleMutationResponse) GetRevokeSplitRole() *revokeSplitRoleMutationRevokeSplitRoleRevokeSplitRolePayloadOrError {
+	return v.RevokeSplitRole
 }
 
-func (v *registerPushTokenMutationResponse) UnmarshalJSON(b []byte) error {
+func (v *revokeSplitRoleMutationResponse) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*registerPushTokenMutationResponse
-		RegisterUserPushToken json.RawMessage `json:"registerUserPushToken"`
+		*revokeSplitRoleMutationResponse
+		RevokeSplitRole json.RawMessage `json:"revokeSplitRole"`
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.registerPushTokenMutationResponse = v
+	firstPass.revokeSplitRoleMutationResponse = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -2226,26 +3998,26 @@ func (v *registerPushTokenMutationResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	{
-		dst := &v.RegisterUserPushToken
-		src := firstPass.RegisterUserPushToken
+		dst := &v.RevokeSplitRole
+		src := firstPass.RevokeSplitRole
 		if len(src) != 0 && string(src) != "null" {
-			*dst = new(registerPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayloadOrError)
-			err = __unmarshalregisterPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayloadOrError(
+			*dst = new(revokeSplitRoleMutationRevokeSplitRoleRevokeSplitRolePayloadOrError)
+			err = __unmarshalrevokeSplitRoleMutationRevokeSplitRoleRevokeSplitRolePayloadOrError(
 				src, *dst)
 			if err != nil {
 				return fmt.Errorf(
-					"unable to unmarshal registerPushTokenMutationResponse.RegisterUserPushToken: %w", err)
+					"unable to unmarshal revokeSplitRoleMutationResponse.RevokeSplitRole: %w", err)
 			}
 		}
 	}
 	return nil
 }
 
-type __premarshalregisterPushTokenMutationResponse struct {
-	RegisterUserPushToken json.RawMessage `json:"registerUserPushToken"`
+type __premarshalrevokeSplitRoleMutationResponse struct {
+	RevokeSplitRole json.RawMessage `json:"revokeSplitRole"`
 }
 
-func (v *registerPushTokenMutationResponse) MarshalJSON() ([]byte, error) {
+func (v *revokeSplitRoleMutationResponse) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -2253,94 +4025,111 @@ func (v *registerPushTokenMutationResponse) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *registerPushTokenMutationResponse) __premarshalJSON() (*__premarshalregisterPushTokenMutationResponse, error) {
-	var retval __premarshalregisterPushTokenMutationResponse
+func (v *revokeSplitRoleMutationResponse) __premarshalJSON() (*__premarshalrevokeSplitRoleMutationResponse, error) {
+	var retval __premarshalrevokeSplitRoleMutationResponse
 
 	{
 
-		dst := &retval.RegisterUserPushToken
-		src := v.RegisterUserPushToken
+		dst := &retval.RevokeSplitRole
+		src := v.RevokeSplitRole
 		if src != nil {
 			var err error
-			*dst, err = __marshalregisterPushTokenMutationRegisterUserPushTokenRegisterUserPushTokenPayloadOrError(
+			*dst, err = __marshalrevokeSplitRoleMutationRevokeSplitRoleRevokeSplitRolePayloadOrError(
 				src)
 			if err != nil {
 				return nil, fmt.Errorf(
-					"unable to marshal registerPushTokenMutationResponse.RegisterUserPushToken: %w", err)
+					"unable to marshal revokeSplitRoleMutationResponse.RevokeSplitRole: %w", err)
 			}
 		}
 	}
 	return &retval, nil
 }
 
-// removeUserWalletsMutationRemoveUserWalletsErrInvalidInput includes the requested fields of the GraphQL type ErrInvalidInput.
-type removeUserWalletsMutationRemoveUserWalletsErrInvalidInput struct {
+// revokeSplitRoleMutationRevokeSplitRoleErrInvalidInput includes the requested fields of the GraphQL type ErrInvalidInput.
+type revokeSplitRoleMutationRevokeSplitRoleErrInvalidInput struct {
 	Typename *string `json:"__typename"`
 	Message  string  `json:"message"`
 }
 
-// GetTypename returns removeUserWalletsMutationRemoveUserWalletsErrInvalidInput.Typename, and is useful for accessing the field via an interface.
-func (v *removeUserWalletsMutationRemoveUserWalletsErrInvalidInput) GetTypename() *string {
+// GetTypename returns revokeSplitRoleMutationRevokeSplitRoleErrInvalidInput.Typename, and is useful for accessing the field via an interface.
+func (v *revokeSplitRoleMutationRevokeSplitRoleErrInvalidInput) GetTypename() *string {
 	return v.Typename
 }
 
-// GetMessage returns removeUserWalletsMutationRemoveUserWalletsErrInvalidInput.Message, and is useful for accessing the field via an interface.
-func (v *removeUserWalletsMutationRemoveUserWalletsErrInvalidInput) GetMessage() string {
+// GetMessage returns revokeSplitRoleMutationRevokeSplitRoleErrInvalidInput.Message, and is useful for accessing the field via an interface.
+func (v *revokeSplitRoleMutationRevokeSplitRoleErrInvalidInput) GetMessage() string { return v.Message }
+
+// revokeSplitRoleMutationRevokeSplitRoleErrNotAuthorized includes the requested fields of the GraphQL type ErrNotAuthorized.
+type revokeSplitRoleMutationRevokeSplitRoleErrNotAuthorized struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns revokeSplitRoleMutationRevokeSplitRoleErrNotAuthorized.Typename, and is useful for accessing the field via an interface.
+func (v *revokeSplitRoleMutationRevokeSplitRoleErrNotAuthorized) GetTypename() *string {
+	return v.Typename
+}
+
+// GetMessage returns revokeSplitRoleMutationRevokeSplitRoleErrNotAuthorized.Message, and is useful for accessing the field via an interface.
+func (v *revokeSplitRoleMutationRevokeSplitRoleErrNotAuthorized) GetMessage() string {
 	return v.Message
 }
 
-// removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized includes the requested fields of the GraphQL type ErrNotAuthorized.
-type removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized struct {
+// revokeSplitRoleMutationRevokeSplitRoleErrSplitNotFound includes the requested fields of the GraphQL type ErrSplitNotFound.
+type revokeSplitRoleMutationRevokeSplitRoleErrSplitNotFound struct {
 	Typename *string `json:"__typename"`
 	Message  string  `json:"message"`
 }
 
-// GetTypename returns removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized.Typename, and is useful for accessing the field via an interface.
-func (v *removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized) GetTypename() *string {
+// GetTypename returns revokeSplitRoleMutationRevokeSplitRoleErrSplitNotFound.Typename, and is useful for accessing the field via an interface.
+func (v *revokeSplitRoleMutationRevokeSplitRoleErrSplitNotFound) GetTypename() *string {
 	return v.Typename
 }
 
-// GetMessage returns removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized.Message, and is useful for accessing the field via an interface.
-func (v *removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized) GetMessage() string {
+// GetMessage returns revokeSplitRoleMutationRevokeSplitRoleErrSplitNotFound.Message, and is useful for accessing the field via an interface.
+func (v *revokeSplitRoleMutationRevokeSplitRoleErrSplitNotFound) GetMessage() string {
 	return v.Message
 }
 
-// removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload includes the requested fields of the GraphQL type RemoveUserWalletsPayload.
-type removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload struct {
-	Typename *string                                                                   `json:"__typename"`
-	Viewer   *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewer `json:"viewer"`
+// revokeSplitRoleMutationRevokeSplitRoleRevokeSplitRolePayload includes the requested fields of the GraphQL type RevokeSplitRolePayload.
+type revokeSplitRoleMutationRevokeSplitRoleRevokeSplitRolePayload struct {
+	Typename *string                                                            `json:"__typename"`
+	Split    *revokeSplitRoleMutationRevokeSplitRoleRevokeSplitRolePayloadSplit `json:"split"`
 }
 
-// GetTypename returns removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload.Typename, and is useful for accessing the field via an interface.
-func (v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload) GetTypename() *string {
+// GetTypename returns revokeSplitRoleMutationRevokeSplitRoleRevokeSplitRolePayload.Typename, and is useful for accessing the field via an interface.
+func (v *revokeSplitRoleMutationRevokeSplitRoleRevokeSplitRolePayload) GetTypename() *string {
 	return v.Typename
 }
 
-// GetViewer returns removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload.Viewer, and is useful for accessing the field via an interface.
-func (v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload) GetViewer() *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewer {
-	return v.Viewer
+// GetSplit returns revokeSplitRoleMutationRevokeSplitRoleRevokeSplitRolePayload.Split, and is useful for accessing the field via an interface.
+func (v *revokeSplitRoleMutationRevokeSplitRoleRevokeSplitRolePayload) GetSplit() *revokeSplitRoleMutationRevokeSplitRoleRevokeSplitRolePayloadSplit {
+	return v.Split
 }
 
-// removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError includes the requested fields of the GraphQL interface RemoveUserWalletsPayloadOrError.
+// revokeSplitRoleMutationRevokeSplitRoleRevokeSplitRolePayloadOrError includes the requested fields of the GraphQL interface RevokeSplitRolePayloadOrError.
 //
-// removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError is implemented by the following types:
-// removeUserWalletsMutationRemoveUserWalletsErrInvalidInput
-// removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized
-// removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload
-type removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError interface {
-	implementsGraphQLInterfaceremoveUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError()
+// revokeSplitRoleMutationRevokeSplitRoleRevokeSplitRolePayloadOrError is implemented by the following types:
+// revokeSplitRoleMutationRevokeSplitRoleErrInvalidInput
+// revokeSplitRoleMutationRevokeSplitRoleErrNotAuthorized
+// revokeSplitRoleMutationRevokeSplitRoleErrSplitNotFound
+// revokeSplitRoleMutationRevokeSplitRoleRevokeSplitRolePayload
+type revokeSplitRoleMutationRevokeSplitRoleRevokeSplitRolePayloadOrError interface {
+	implementsGraphQLInterfacerevokeSplitRoleMutationRevokeSplitRoleRevokeSplitRolePayloadOrError()
 	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
 	GetTypename() *string
 }
 
-func (v *removeUserWalletsMutationRemoveUserWalletsErrInvalidInput) implementsGraphQLInterfaceremoveUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError() {
+func (v *revokeSplitRoleMutationRevokeSplitRoleErrInvalidInput) implementsGraphQLInterfacerevokeSplitRoleMutationRevokeSplitRoleRevokeSplitRolePayloadOrError() {
 }
-func (v *removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized) implementsGraphQLInterfaceremoveUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError() {
+func (v *revokeSplitRoleMutationRevokeSplitRoleErrNotAuthorized) implementsGraphQLInterfacerevokeSplitRoleMutationRevokeSplitRoleRevokeSplitRolePayloadOrError() {
 }
-func (v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload) implementsGraphQLInterfaceremoveUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError() {
+func (v *revokeSplitRoleMutationRevokeSplitRoleErrSplitNotFound) implementsGraphQLInterfacerevokeSplitRoleMutationRevokeSplitRoleRevokeSplitRolePayloadOrError() {
+}
+func (v *revokeSplitRoleMutationRevokeSplitRoleRevokeSplitRolePayload) implementsGraphQLInterfacerevokeSplitRoleMutationRevokeSplitRoleRevokeSplitRolePayloadOrError() {
 }
 
-func __unmarshalremoveUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError(b []byte, v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError) error {
+func __unmarshalrevokeSplitRoleMutationRevokeSplitRoleRevokeSplitRolePayloadOrError(b []byte, v *revokeSplitRoleMutationRevokeSplitRoleRevokeSplitRolePayloadOrError) error {
 	if string(b) == "null" {
 		return nil
 	}
@@ -2355,133 +4144,105 @@ func __unmarshalremoveUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPaylo
 
 	switch tn.TypeName {
 	case "ErrInvalidInput":
-		*v = new(removeUserWalletsMutationRemoveUserWalletsErrInvalidInput)
+		*v = new(revokeSplitRoleMutationRevokeSplitRoleErrInvalidInput)
 		return json.Unmarshal(b, *v)
 	case "ErrNotAuthorized":
-		*v = new(removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized)
+		*v = new(revokeSplitRoleMutationRevokeSplitRoleErrNotAuthorized)
 		return json.Unmarshal(b, *v)
-	case "RemoveUserWalletsPayload":
-		*v = new(removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload)
+	case "ErrSplitNotFound":
+		*v = new(revokeSplitRoleMutationRevokeSplitRoleErrSplitNotFound)
+		return json.Unmarshal(b, *v)
+	case "RevokeSplitRolePayload":
+		*v = new(revokeSplitRoleMutationRevokeSplitRoleRevokeSplitRolePayload)
 		return json.Unmarshal(b, *v)
 	case "":
 		return fmt.Errorf(
-			"response was missing RemoveUserWalletsPayloadOrError.__typename")
+			"response was missing RevokeSplitRolePayloadOrError.__typename")
 	default:
 		return fmt.Errorf(
-			`unexpected concrete type for removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError: "%v"`, tn.TypeName)
+			`unexpected concrete type for revokeSplitRoleMutationRevokeSplitRoleRevokeSplitRolePayloadOrError: "%v"`, tn.TypeName)
 	}
 }
 
-func __marshalremoveUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError(v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError) ([]byte, error) {
+func __marshalrevokeSplitRoleMutationRevokeSplitRoleRevokeSplitRolePayloadOrError(v *revokeSplitRoleMutationRevokeSplitRoleRevokeSplitRolePayloadOrError) ([]byte, error) {
 
 	var typename string
 	switch v := (*v).(type) {
-	case *removeUserWalletsMutationRemoveUserWalletsErrInvalidInput:
+	case *revokeSplitRoleMutationRevokeSplitRoleErrInvalidInput:
 		typename = "ErrInvalidInput"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*removeUserWalletsMutationRemoveUserWalletsErrInvalidInput
+			*revokeSplitRoleMutationRevokeSplitRoleErrInvalidInput
 		}{typename, v}
 		return json.Marshal(result)
-	case *removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized:
+	case *revokeSplitRoleMutationRevokeSplitRoleErrNotAuthorized:
 		typename = "ErrNotAuthorized"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized
+			*revokeSplitRoleMutationRevokeSplitRoleErrNotAuthorized
 		}{typename, v}
 		return json.Marshal(result)
-	case *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload:
-		typename = "RemoveUserWalletsPayload"
+	case *revokeSplitRoleMutationRevokeSplitRoleErrSplitNotFound:
+		typename = "ErrSplitNotFound"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload
+			*revokeSplitRoleMutationRevokeSplitRoleErrSplitNotFound
+		}{typename, v}
+		return json.Marshal(result)
+	case *revokeSplitRoleMutationRevokeSplitRoleRevokeSplitRolePayload:
+		typename = "RevokeSplitRolePayload"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*revokeSplitRoleMutationRevokeSplitRoleRevokeSplitRolePayload
 		}{typename, v}
 		return json.Marshal(result)
 	case nil:
 		return []byte("null"), nil
 	default:
 		return nil, fmt.Errorf(
-			`unexpected concrete type for removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError: "%T"`, v)
+			`unexpected concrete type for revokeSplitRoleMutationRevokeSplitRoleRevokeSplitRolePayloadOrError: "%T"`, v)
 	}
 }
 
-// removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewer includes the requested fields of the GraphQL type Viewer.
-type removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewer struct {
-	User *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUser `json:"user"`
-}
-
-// GetUser returns removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewer.User, and is useful for accessing the field via an interface.
-func (v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewer) GetUser() *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUser {
-	return v.User
-}
-
-// removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUser includes the requested fields of the GraphQL type SplitFiUser.
-type removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUser struct {
-	Wallets []*removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUserWalletsWallet `json:"wallets"`
-}
-
-// GetWallets returns removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUser.Wallets, and is useful for accessing the field via an interface.
-func (v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUser) GetWallets() []*removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUserWalletsWallet {
-	return v.Wallets
-}
-
-// removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUserWalletsWallet includes the requested fields of the GraphQL type Wallet.
-type removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUserWalletsWallet struct {
-	Dbid         persist.DBID                                                                                                      `json:"dbid"`
-	ChainAddress *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUserWalletsWalletChainAddress `json:"chainAddress"`
+// revokeSplitRoleMutationRevokeSplitRoleRevokeSplitRolePayloadSplit includes the requested fields of the GraphQL type Split.
+type revokeSplitRoleMutationRevokeSplitRoleRevokeSplitRolePayloadSplit struct {
+	Dbid persist.DBID `json:"dbid"`
 }
 
-// GetDbid returns removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUserWalletsWallet.Dbid, and is useful for accessing the field via an interface.
-func (v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUserWalletsWallet) GetDbid() persist.DBID {
+// GetDbid returns revokeSplitRoleMutationRevokeSplitRoleRevokeSplitRolePayloadSplit.Dbid, and is useful for accessing the field via an interface.
+func (v *revokeSplitRoleMutationRevokeSplitRoleRevokeSplitRolePayloadSplit) GetDbid() persist.DBID {
 	return v.Dbid
 }
 
-// GetChainAddress returns removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUserWalletsWallet.ChainAddress, and is useful for accessing the field via an interface.
-func (v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUserWalletsWallet) GetChainAddress() *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUserWalletsWalletChainAddress {
-	return v.ChainAddress
-}
-
-// removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUserWalletsWalletChainAddress includes the requested fields of the GraphQL type ChainAddress.
-type removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUserWalletsWalletChainAddress struct {
-	Address *string `json:"address"`
-	Chain   *Chain  `json:"chain"`
-}
-
-// GetAddress returns removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUserWalletsWalletChainAddress.Address, and is useful for accessing the field via an interface.
-func (v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUserWalletsWalletChainAddress) GetAddress() *string {
-	return v.Address
-}
-
-// GetChain returns removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUserWalletsWalletChainAddress.Chain, and is useful for accessing the field via an interface.
-func (v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserSplitFiUserWalletsWalletChainAddress) GetChain() *Chain {
-	return v.Chain
-}
-
-// removeUserWalletsMutationResponse is returned by removeUserWalletsMutation on success.
-type removeUserWalletsMutationResponse struct {
-	RemoveUserWallets *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError `json:"-"`
+// simulateSplitChangeQueryResponse is returned by simulateSplitChangeQuery on success.
+type simulateSplitChangeQueryResponse struct {
+	// Previews how changing a split's shares would change what each of its recipients receives, without saving
+	// anything. newShares replace the split's recipients, so recipients left out are removed, and are validated the
+	// same way as updating the split's shares. Only the split's controllers can simulate changes to it.
+	SimulateSplitChange *simulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayloadOrError `json:"-"`
 }
 
-// GetRemoveUserWallets returns removeUserWalletsMutationResponse.RemoveUserWallets, and is useful for accessing the field via an interface.
-func (v *removeUserWalletsMutationResponse) GetRemoveUserWallets() *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError {
-	return v.RemoveUserWallets
+// GetSimulateSplitChange returns simulateSplitChangeQueryResponse.SimulateSplitChange, and is useful for accessing the field via an interface.
+func (v *simulateSplitChangeQueryResponse) GetSimulateSplitChange() *simulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayloadOrError {
+	return v.SimulateSplitChange
 }
 
-func (v *removeUserWalletsMutationResponse) UnmarshalJSON(b []byte) error {
+func (v *simulateSplitChangeQueryResponse) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*removeUserWalletsMutationResponse
-		RemoveUserWallets json.RawMessage `json:"removeUserWallets"`
+		*simulateSplitChangeQueryResponse
+		SimulateSplitChange json.RawMessage `json:"simulateSplitChange"`
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.removeUserWalletsMutationResponse = v
+	firstPass.simulateSplitChangeQueryResponse = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -2489,26 +4250,26 @@ func (v *removeUserWalletsMutationResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	{
-		dst := &v.RemoveUserWallets
-		src := firstPass.RemoveUserWallets
+		dst := &v.SimulateSplitChange
+		src := firstPass.SimulateSplitChange
 		if len(src) != 0 && string(src) != "null" {
-			*dst = new(removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError)
-			err = __unmarshalremoveUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError(
+			*dst = new(simulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayloadOrError)
+			err = __unmarshalsimulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayloadOrError(
 				src, *dst)
 			if err != nil {
 				return fmt.Errorf(
-					"unable to unmarshal removeUserWalletsMutationResponse.RemoveUserWallets: %w", err)
+					"unable to unmarshal simulateSplitChangeQueryResponse.SimulateSplitChange: %w", err)
 			}
 		}
 	}
 	return nil
 }
 
-type __premarshalremoveUserWalletsMutationResponse struct {
-	RemoveUserWallets json.RawMessage `json:"removeUserWallets"`
+type __premarshalsimulateSplitChangeQueryResponse struct {
+	SimulateSplitChange json.RawMessage `json:"simulateSplitChange"`
 }
 
-func (v *removeUserWalletsMutationResponse) MarshalJSON() ([]byte, error) {
+func (v *simulateSplitChangeQueryResponse) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -2516,24 +4277,221 @@ func (v *removeUserWalletsMutationResponse) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *removeUserWalletsMutationResponse) __premarshalJSON() (*__premarshalremoveUserWalletsMutationResponse, error) {
-	var retval __premarshalremoveUserWalletsMutationResponse
+func (v *simulateSplitChangeQueryResponse) __premarshalJSON() (*__premarshalsimulateSplitChangeQueryResponse, error) {
+	var retval __premarshalsimulateSplitChangeQueryResponse
+
+	{
+
+		dst := &retval.SimulateSplitChange
+		src := v.SimulateSplitChange
+		if src != nil {
+			var err error
+			*dst, err = __marshalsimulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayloadOrError(
+				src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"unable to marshal simulateSplitChangeQueryResponse.SimulateSplitChange: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// simulateSplitChangeQuerySimulateSplitChangeErrInvalidInput includes the requested fields of the GraphQL type ErrInvalidInput.
+type simulateSplitChangeQuerySimulateSplitChangeErrInvalidInput struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns simulateSplitChangeQuerySimulateSplitChangeErrInvalidInput.Typename, and is useful for accessing the field via an interface.
+func (v *simulateSplitChangeQuerySimulateSplitChangeErrInvalidInput) GetTypename() *string {
+	return v.Typename
+}
+
+// GetMessage returns simulateSplitChangeQuerySimulateSplitChangeErrInvalidInput.Message, and is useful for accessing the field via an interface.
+func (v *simulateSplitChangeQuerySimulateSplitChangeErrInvalidInput) GetMessage() string {
+	return v.Message
+}
+
+// simulateSplitChangeQuerySimulateSplitChangeErrNotAuthorized includes the requested fields of the GraphQL type ErrNotAuthorized.
+type simulateSplitChangeQuerySimulateSplitChangeErrNotAuthorized struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns simulateSplitChangeQuerySimulateSplitChangeErrNotAuthorized.Typename, and is useful for accessing the field via an interface.
+func (v *simulateSplitChangeQuerySimulateSplitChangeErrNotAuthorized) GetTypename() *string {
+	return v.Typename
+}
+
+// GetMessage returns simulateSplitChangeQuerySimulateSplitChangeErrNotAuthorized.Message, and is useful for accessing the field via an interface.
+func (v *simulateSplitChangeQuerySimulateSplitChangeErrNotAuthorized) GetMessage() string {
+	return v.Message
+}
+
+// simulateSplitChangeQuerySimulateSplitChangeErrSplitNotFound includes the requested fields of the GraphQL type ErrSplitNotFound.
+type simulateSplitChangeQuerySimulateSplitChangeErrSplitNotFound struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns simulateSplitChangeQuerySimulateSplitChangeErrSplitNotFound.Typename, and is useful for accessing the field via an interface.
+func (v *simulateSplitChangeQuerySimulateSplitChangeErrSplitNotFound) GetTypename() *string {
+	return v.Typename
+}
+
+// GetMessage returns simulateSplitChangeQuerySimulateSplitChangeErrSplitNotFound.Message, and is useful for accessing the field via an interface.
+func (v *simulateSplitChangeQuerySimulateSplitChangeErrSplitNotFound) GetMessage() string {
+	return v.Message
+}
+
+// simulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayload includes the requested fields of the GraphQL type SimulateSplitChangePayload.
+type simulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayload struct {
+	Typename            *string                                                                                                              `json:"__typename"`
+	HypotheticalInflows []simulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayloadHypotheticalInflowsSimulatedTokenDistribution `json:"hypotheticalInflows"`
+}
+
+// GetTypename returns simulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayload.Typename, and is useful for accessing the field via an interface.
+func (v *simulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayload) GetTypename() *string {
+	return v.Typename
+}
+
+// GetHypotheticalInflows returns simulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayload.HypotheticalInflows, and is useful for accessing the field via an interface.
+func (v *simulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayload) GetHypotheticalInflows() []simulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayloadHypotheticalInflowsSimulatedTokenDistribution {
+	return v.HypotheticalInflows
+}
+
+// simulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayloadHypotheticalInflowsSimulatedTokenDistribution includes the requested fields of the GraphQL type SimulatedTokenDistribution.
+type simulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayloadHypotheticalInflowsSimulatedTokenDistribution struct {
+	Recipients []simulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayloadHypotheticalInflowsSimulatedTokenDistributionRecipientsSimulatedRecipientAllocation `json:"recipients"`
+}
+
+// GetRecipients returns simulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayloadHypotheticalInflowsSimulatedTokenDistribution.Recipients, and is useful for accessing the field via an interface.
+func (v *simulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayloadHypotheticalInflowsSimulatedTokenDistribution) GetRecipients() []simulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayloadHypotheticalInflowsSimulatedTokenDistributionRecipientsSimulatedRecipientAllocation {
+	return v.Recipients
+}
+
+// simulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayloadHypotheticalInflowsSimulatedTokenDistributionRecipientsSimulatedRecipientAllocation includes the requested fields of the GraphQL type SimulatedRecipientAllocation.
+type simulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayloadHypotheticalInflowsSimulatedTokenDistributionRecipientsSimulatedRecipientAllocation struct {
+	Address *string `json:"address"`
+	Before  *string `json:"before"`
+	After   *string `json:"after"`
+}
+
+// GetAddress returns simulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayloadHypotheticalInflowsSimulatedTokenDistributionRecipientsSimulatedRecipientAllocation.Address, and is useful for accessing the field via an interface.
+func (v *simulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayloadHypotheticalInflowsSimulatedTokenDistributionRecipientsSimulatedRecipientAllocation) GetAddress() *string {
+	return v.Address
+}
+
+// GetBefore returns simulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayloadHypotheticalInflowsSimulatedTokenDistributionRecipientsSimulatedRecipientAllocation.Before, and is useful for accessing the field via an interface.
+func (v *simulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayloadHypotheticalInflowsSimulatedTokenDistributionRecipientsSimulatedRecipientAllocation) GetBefore() *string {
+	return v.Before
+}
+
+// GetAfter returns simulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayloadHypotheticalInflowsSimulatedTokenDistributionRecipientsSimulatedRecipientAllocation.After, and is useful for accessing the field via an interface.
+func (v *simulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayloadHypotheticalInflowsSimulatedTokenDistributionRecipientsSimulatedRecipientAllocation) GetAfter() *string {
+	return v.After
+}
+
+// simulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayloadOrError includes the requested fields of the GraphQL interface SimulateSplitChangePayloadOrError.
+//
+// simulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayloadOrError is implemented by the following types:
+// simulateSplitChangeQuerySimulateSplitChangeErrInvalidInput
+// simulateSplitChangeQuerySimulateSplitChangeErrNotAuthorized
+// simulateSplitChangeQuerySimulateSplitChangeErrSplitNotFound
+// simulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayload
+type simulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayloadOrError interface {
+	implementsGraphQLInterfacesimulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayloadOrError()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() *string
+}
+
+func (v *simulateSplitChangeQuerySimulateSplitChangeErrInvalidInput) implementsGraphQLInterfacesimulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayloadOrError() {
+}
+func (v *simulateSplitChangeQuerySimulateSplitChangeErrNotAuthorized) implementsGraphQLInterfacesimulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayloadOrError() {
+}
+func (v *simulateSplitChangeQuerySimulateSplitChangeErrSplitNotFound) implementsGraphQLInterfacesimulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayloadOrError() {
+}
+func (v *simulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayload) implementsGraphQLInterfacesimulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayloadOrError() {
+}
+
+func __unmarshalsimulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayloadOrError(b []byte, v *simulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayloadOrError) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "ErrInvalidInput":
+		*v = new(simulateSplitChangeQuerySimulateSplitChangeErrInvalidInput)
+		return json.Unmarshal(b, *v)
+	case "ErrNotAuthorized":
+		*v = new(simulateSplitChangeQuerySimulateSplitChangeErrNotAuthorized)
+		return json.Unmarshal(b, *v)
+	case "ErrSplitNotFound":
+		*v = new(simulateSplitChangeQuerySimulateSplitChangeErrSplitNotFound)
+		return json.Unmarshal(b, *v)
+	case "SimulateSplitChangePayload":
+		*v = new(simulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayload)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing SimulateSplitChangePayloadOrError.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for simulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayloadOrError: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalsimulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayloadOrError(v *simulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayloadOrError) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *simulateSplitChangeQuerySimulateSplitChangeErrInvalidInput:
+		typename = "ErrInvalidInput"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*simulateSplitChangeQuerySimulateSplitChangeErrInvalidInput
+		}{typename, v}
+		return json.Marshal(result)
+	case *simulateSplitChangeQuerySimulateSplitChangeErrNotAuthorized:
+		typename = "ErrNotAuthorized"
 
-	{
+		result := struct {
+			TypeName string `json:"__typename"`
+			*simulateSplitChangeQuerySimulateSplitChangeErrNotAuthorized
+		}{typename, v}
+		return json.Marshal(result)
+	case *simulateSplitChangeQuerySimulateSplitChangeErrSplitNotFound:
+		typename = "ErrSplitNotFound"
 
-		dst := &retval.RemoveUserWallets
-		src := v.RemoveUserWallets
-		if src != nil {
-			var err error
-			*dst, err = __marshalremoveUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError(
-				src)
-			if err != nil {
-				return nil, fmt.Errorf(
-					"unable to marshal removeUserWalletsMutationResponse.RemoveUserWallets: %w", err)
-			}
-		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*simulateSplitChangeQuerySimulateSplitChangeErrSplitNotFound
+		}{typename, v}
+		return json.Marshal(result)
+	case *simulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayload:
+		typename = "SimulateSplitChangePayload"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*simulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayload
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for simulateSplitChangeQuerySimulateSplitChangeSimulateSplitChangePayloadOrError: "%T"`, v)
 	}
-	return &retval, nil
 }
 
 // updateSplitMutationResponse is returned by updateSplitMutation on success.
@@ -3829,6 +5787,52 @@ func addUserWalletMutation(
 	return &data_, err_
 }
 
+// The query or mutation executed by createSplitFromTemplateMutation.
+const createSplitFromTemplateMutation_Operation = `
+mutation createSplitFromTemplateMutation ($templateId: DBID!, $chain: Chain!) {
+	createSplitFromTemplate(templateId: $templateId, chain: $chain) {
+		__typename
+		... on Error {
+			__typename
+			message
+		}
+		... on CreateSplitPayload {
+			split {
+				dbid
+			}
+		}
+	}
+}
+`
+
+func createSplitFromTemplateMutation(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	templateId persist.DBID,
+	chain Chain,
+) (*createSplitFromTemplateMutationResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "createSplitFromTemplateMutation",
+		Query:  createSplitFromTemplateMutation_Operation,
+		Variables: &__createSplitFromTemplateMutationInput{
+			TemplateId: templateId,
+			Chain:      chain,
+		},
+	}
+	var err_ error
+
+	var data_ createSplitFromTemplateMutationResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by createSplitMutation.
 const createSplitMutation_Operation = `
 mutation createSplitMutation ($input: CreateSplitInput!) {
@@ -3875,6 +5879,50 @@ func createSplitMutation(
 	return &data_, err_
 }
 
+// The query or mutation executed by createSplitTemplateMutation.
+const createSplitTemplateMutation_Operation = `
+mutation createSplitTemplateMutation ($input: CreateSplitTemplateInput!) {
+	createSplitTemplate(input: $input) {
+		__typename
+		... on Error {
+			__typename
+			message
+		}
+		... on CreateSplitTemplatePayload {
+			template {
+				dbid
+			}
+		}
+	}
+}
+`
+
+func createSplitTemplateMutation(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input CreateSplitTemplateInput,
+) (*createSplitTemplateMutationResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "createSplitTemplateMutation",
+		Query:  createSplitTemplateMutation_Operation,
+		Variables: &__createSplitTemplateMutationInput{
+			Input: input,
+		},
+	}
+	var err_ error
+
+	var data_ createSplitTemplateMutationResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by createUserMutation.
 const createUserMutation_Operation = `
 mutation createUserMutation ($authMechanism: AuthMechanism!, $input: CreateUserInput!) {
@@ -3927,6 +5975,94 @@ func createUserMutation(
 	return &data_, err_
 }
 
+// The query or mutation executed by createWebhookMutation.
+const createWebhookMutation_Operation = `
+mutation createWebhookMutation ($input: CreateWebhookInput!) {
+	createWebhook(input: $input) {
+		__typename
+		... on Error {
+			__typename
+			message
+		}
+		... on CreateWebhookPayload {
+			webhook {
+				dbid
+			}
+		}
+	}
+}
+`
+
+func createWebhookMutation(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input CreateWebhookInput,
+) (*createWebhookMutationResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "createWebhookMutation",
+		Query:  createWebhookMutation_Operation,
+		Variables: &__createWebhookMutationInput{
+			Input: input,
+		},
+	}
+	var err_ error
+
+	var data_ createWebhookMutationResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by exportLedgerMutation.
+const exportLedgerMutation_Operation = `
+mutation exportLedgerMutation ($input: ExportLedgerInput!) {
+	exportLedger(input: $input) {
+		__typename
+		... on Error {
+			__typename
+			message
+		}
+		... on ExportLedgerPayload {
+			export {
+				dbid
+			}
+		}
+	}
+}
+`
+
+func exportLedgerMutation(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input ExportLedgerInput,
+) (*exportLedgerMutationResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "exportLedgerMutation",
+		Query:  exportLedgerMutation_Operation,
+		Variables: &__exportLedgerMutationInput{
+			Input: input,
+		},
+	}
+	var err_ error
+
+	var data_ exportLedgerMutationResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by getAuthNonceMutation.
 const getAuthNonceMutation_Operation = `
 mutation getAuthNonceMutation {
@@ -3962,6 +6098,50 @@ func getAuthNonceMutation(
 	return &data_, err_
 }
 
+// The query or mutation executed by grantSplitRoleMutation.
+const grantSplitRoleMutation_Operation = `
+mutation grantSplitRoleMutation ($input: GrantSplitRoleInput!) {
+	grantSplitRole(input: $input) {
+		__typename
+		... on Error {
+			__typename
+			message
+		}
+		... on GrantSplitRolePayload {
+			member {
+				dbid
+			}
+		}
+	}
+}
+`
+
+func grantSplitRoleMutation(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input GrantSplitRoleInput,
+) (*grantSplitRoleMutationResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "grantSplitRoleMutation",
+		Query:  grantSplitRoleMutation_Operation,
+		Variables: &__grantSplitRoleMutationInput{
+			Input: input,
+		},
+	}
+	var err_ error
+
+	var data_ grantSplitRoleMutationResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by loginMutation.
 const loginMutation_Operation = `
 mutation loginMutation ($authMechanism: AuthMechanism!) {
@@ -4223,6 +6403,102 @@ func removeUserWalletsMutation(
 	return &data_, err_
 }
 
+// The query or mutation executed by revokeSplitRoleMutation.
+const revokeSplitRoleMutation_Operation = `
+mutation revokeSplitRoleMutation ($input: RevokeSplitRoleInput!) {
+	revokeSplitRole(input: $input) {
+		__typename
+		... on Error {
+			__typename
+			message
+		}
+		... on RevokeSplitRolePayload {
+			split {
+				dbid
+			}
+		}
+	}
+}
+`
+
+func revokeSplitRoleMutation(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input RevokeSplitRoleInput,
+) (*revokeSplitRoleMutationResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "revokeSplitRoleMutation",
+		Query:  revokeSplitRoleMutation_Operation,
+		Variables: &__revokeSplitRoleMutationInput{
+			Input: input,
+		},
+	}
+	var err_ error
+
+	var data_ revokeSplitRoleMutationResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by simulateSplitChangeQuery.
+const simulateSplitChangeQuery_Operation = `
+query simulateSplitChangeQuery ($splitId: DBID!, $newShares: [SimulatedShareInput!]!, $hypotheticalInflows: [HypotheticalInflowInput!]) {
+	simulateSplitChange(splitId: $splitId, newShares: $newShares, hypotheticalInflows: $hypotheticalInflows) {
+		__typename
+		... on Error {
+			__typename
+			message
+		}
+		... on SimulateSplitChangePayload {
+			hypotheticalInflows {
+				recipients {
+					address
+					before
+					after
+				}
+			}
+		}
+	}
+}
+`
+
+func simulateSplitChangeQuery(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	splitId persist.DBID,
+	newShares []SimulatedShareInput,
+	hypotheticalInflows []HypotheticalInflowInput,
+) (*simulateSplitChangeQueryResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "simulateSplitChangeQuery",
+		Query:  simulateSplitChangeQuery_Operation,
+		Variables: &__simulateSplitChangeQueryInput{
+			SplitId:             splitId,
+			NewShares:           newShares,
+			HypotheticalInflows: hypotheticalInflows,
+		},
+	}
+	var err_ error
+
+	var data_ simulateSplitChangeQueryResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by updateSplitMutation.
 const updateSplitMutation_Operation = `
 mutation updateSplitMutation ($input: UpdateSplitInput!) {
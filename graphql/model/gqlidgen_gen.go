@@ -30,6 +30,14 @@ func (r *SplitFiUser) ID() GqlID {
 	return GqlID(fmt.Sprintf("SplitFiUser:%s", r.Dbid))
 }
 
+func (r *SplitGroup) ID() GqlID {
+	return GqlID(fmt.Sprintf("SplitGroup:%s", r.Dbid))
+}
+
+func (r *SplitReceivedFundsNotification) ID() GqlID {
+	return GqlID(fmt.Sprintf("SplitReceivedFundsNotification:%s", r.Dbid))
+}
+
 func (r *Token) ID() GqlID {
 	return GqlID(fmt.Sprintf("Token:%s", r.Dbid))
 }
@@ -51,15 +59,27 @@ func (r *Wallet) ID() GqlID {
 	return GqlID(fmt.Sprintf("Wallet:%s", r.Dbid))
 }
 
+func (r *Webhook) ID() GqlID {
+	return GqlID(fmt.Sprintf("Webhook:%s", r.Dbid))
+}
+
+func (r *WebhookDelivery) ID() GqlID {
+	return GqlID(fmt.Sprintf("WebhookDelivery:%s", r.Dbid))
+}
+
 type NodeFetcher struct {
-	OnAsset       func(ctx context.Context, dbid persist.DBID) (*Asset, error)
-	OnDeletedNode func(ctx context.Context, dbid persist.DBID) (*DeletedNode, error)
-	OnRecipient   func(ctx context.Context, dbid persist.DBID) (*Recipient, error)
-	OnSplit       func(ctx context.Context, dbid persist.DBID) (*Split, error)
-	OnSplitFiUser func(ctx context.Context, dbid persist.DBID) (*SplitFiUser, error)
-	OnToken       func(ctx context.Context, dbid persist.DBID) (*Token, error)
-	OnViewer      func(ctx context.Context, userId string) (*Viewer, error)
-	OnWallet      func(ctx context.Context, dbid persist.DBID) (*Wallet, error)
+	OnAsset                          func(ctx context.Context, dbid persist.DBID) (*Asset, error)
+	OnDeletedNode                    func(ctx context.Context, dbid persist.DBID) (*DeletedNode, error)
+	OnRecipient                      func(ctx context.Context, dbid persist.DBID) (*Recipient, error)
+	OnSplit                          func(ctx context.Context, dbid persist.DBID) (*Split, error)
+	OnSplitFiUser                    func(ctx context.Context, dbid persist.DBID) (*SplitFiUser, error)
+	OnSplitGroup                     func(ctx context.Context, dbid persist.DBID) (*SplitGroup, error)
+	OnSplitReceivedFundsNotification func(ctx context.Context, dbid persist.DBID) (*SplitReceivedFundsNotification, error)
+	OnToken                          func(ctx context.Context, dbid persist.DBID) (*Token, error)
+	OnViewer                         func(ctx context.Context, userId string) (*Viewer, error)
+	OnWallet                         func(ctx context.Context, dbid persist.DBID) (*Wallet, error)
+	OnWebhook                        func(ctx context.Context, dbid persist.DBID) (*Webhook, error)
+	OnWebhookDelivery                func(ctx context.Context, dbid persist.DBID) (*WebhookDelivery, error)
 }
 
 func (n *NodeFetcher) GetNodeByGqlID(ctx context.Context, id GqlID) (Node, error) {
@@ -97,6 +117,16 @@ func (n *NodeFetcher) GetNodeByGqlID(ctx context.Context, id GqlID) (Node, error
 			return nil, ErrInvalidIDFormat{message: fmt.Sprintf("'SplitFiUser' type requires 1 ID component(s) (%d component(s) supplied)", len(ids))}
 		}
 		return n.OnSplitFiUser(ctx, persist.DBID(ids[0]))
+	case "SplitGroup":
+		if len(ids) != 1 {
+			return nil, ErrInvalidIDFormat{message: fmt.Sprintf("'SplitGroup' type requires 1 ID component(s) (%d component(s) supplied)", len(ids))}
+		}
+		return n.OnSplitGroup(ctx, persist.DBID(ids[0]))
+	case "SplitReceivedFundsNotification":
+		if len(ids) != 1 {
+			return nil, ErrInvalidIDFormat{message: fmt.Sprintf("'SplitReceivedFundsNotification' type requires 1 ID component(s) (%d component(s) supplied)", len(ids))}
+		}
+		return n.OnSplitReceivedFundsNotification(ctx, persist.DBID(ids[0]))
 	case "Token":
 		if len(ids) != 1 {
 			return nil, ErrInvalidIDFormat{message: fmt.Sprintf("'Token' type requires 1 ID component(s) (%d component(s) supplied)", len(ids))}
@@ -112,6 +142,16 @@ func (n *NodeFetcher) GetNodeByGqlID(ctx context.Context, id GqlID) (Node, error
 			return nil, ErrInvalidIDFormat{message: fmt.Sprintf("'Wallet' type requires 1 ID component(s) (%d component(s) supplied)", len(ids))}
 		}
 		return n.OnWallet(ctx, persist.DBID(ids[0]))
+	case "Webhook":
+		if len(ids) != 1 {
+			return nil, ErrInvalidIDFormat{message: fmt.Sprintf("'Webhook' type requires 1 ID component(s) (%d component(s) supplied)", len(ids))}
+		}
+		return n.OnWebhook(ctx, persist.DBID(ids[0]))
+	case "WebhookDelivery":
+		if len(ids) != 1 {
+			return nil, ErrInvalidIDFormat{message: fmt.Sprintf("'WebhookDelivery' type requires 1 ID component(s) (%d component(s) supplied)", len(ids))}
+		}
+		return n.OnWebhookDelivery(ctx, persist.DBID(ids[0]))
 	}
 
 	return nil, ErrInvalidIDFormat{typeName}
@@ -129,11 +169,19 @@ func (n *NodeFetcher) ValidateHandlers() {
 		panic("NodeFetcher handler validation failed: no handler set for NodeFetcher.OnSplit")
 	case n.OnSplitFiUser == nil:
 		panic("NodeFetcher handler validation failed: no handler set for NodeFetcher.OnSplitFiUser")
+	case n.OnSplitGroup == nil:
+		panic("NodeFetcher handler validation failed: no handler set for NodeFetcher.OnSplitGroup")
+	case n.OnSplitReceivedFundsNotification == nil:
+		panic("NodeFetcher handler validation failed: no handler set for NodeFetcher.OnSplitReceivedFundsNotification")
 	case n.OnToken == nil:
 		panic("NodeFetcher handler validation failed: no handler set for NodeFetcher.OnToken")
 	case n.OnViewer == nil:
 		panic("NodeFetcher handler validation failed: no handler set for NodeFetcher.OnViewer")
 	case n.OnWallet == nil:
 		panic("NodeFetcher handler validation failed: no handler set for NodeFetcher.OnWallet")
+	case n.OnWebhook == nil:
+		panic("NodeFetcher handler validation failed: no handler set for NodeFetcher.OnWebhook")
+	case n.OnWebhookDelivery == nil:
+		panic("NodeFetcher handler validation failed: no handler set for NodeFetcher.OnWebhookDelivery")
 	}
 }
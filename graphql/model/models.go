@@ -38,6 +38,56 @@ type HelperUserEmailData struct {
 	UserId persist.DBID
 }
 
+type HelperRecipientData struct {
+	SplitID persist.DBID
+}
+
+type HelperMemberSplitData struct {
+	SplitID   persist.DBID
+	Addresses []persist.Address
+}
+
+type HelperSplitMemberData struct {
+	UserID persist.DBID
+}
+
+type HelperWebhookData struct {
+	SplitID persist.DBID
+}
+
+type HelperRecipientInviteData struct {
+	InviteeID persist.DBID
+}
+
+type HelperSplitLedgerEntryData struct {
+	SplitID persist.DBID
+}
+
+type HelperSplitActivityData struct {
+	SplitID persist.DBID
+}
+
+type HelperSplitBalancesUpdateData struct {
+	SplitID persist.DBID
+}
+
+type HelperSplitRevisionData struct {
+	ActorID persist.DBID
+}
+
+type HelperSplitDeletionRequestData struct {
+	SplitID     persist.DBID
+	RequesterID persist.DBID
+}
+
+type HelperSplitReceivedFundsNotificationData struct {
+	SplitID persist.DBID
+}
+
+type HelperSplitDeletionApprovalData struct {
+	ApproverID persist.DBID
+}
+
 type ErrInvalidIDFormat struct {
 	message string
 }
@@ -78,11 +128,21 @@ func (w *Window) UnmarshalGQL(v interface{}) error {
 	case allTimeWindow.Name:
 		*w = allTimeWindow
 	default:
-		panic(fmt.Sprintf("unknown window: %s", window))
+		return fmt.Errorf("unknown window: %s", window)
 	}
 	return nil
 }
 
+// IsAllTime returns true if the window has no start
+func (w Window) IsAllTime() bool {
+	return w == allTimeWindow
+}
+
+// IsValid returns true if the window is one of the ReportWindow values
+func (w Window) IsValid() bool {
+	return w == lastFiveDaysWindow || w == lastSevenDaysWindow || w == allTimeWindow
+}
+
 func (w Window) MarshalGQL(wt io.Writer) {
 	switch {
 	case w == lastFiveDaysWindow:
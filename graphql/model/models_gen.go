@@ -11,10 +11,18 @@ import (
 	"github.com/SplitFi/go-splitfi/service/persist"
 )
 
+type AcceptRecipientInvitePayloadOrError interface {
+	IsAcceptRecipientInvitePayloadOrError()
+}
+
 type AddRolesToUserPayloadOrError interface {
 	IsAddRolesToUserPayloadOrError()
 }
 
+type AddSplitToGroupPayloadOrError interface {
+	IsAddSplitToGroupPayloadOrError()
+}
+
 type AddUserWalletPayloadOrError interface {
 	IsAddUserWalletPayloadOrError()
 }
@@ -27,32 +35,84 @@ type AuthorizationError interface {
 	IsAuthorizationError()
 }
 
+type CompleteSplitMediaUploadPayloadOrError interface {
+	IsCompleteSplitMediaUploadPayloadOrError()
+}
+
+type CreateSplitGroupPayloadOrError interface {
+	IsCreateSplitGroupPayloadOrError()
+}
+
+type CreateSplitMediaUploadPayloadOrError interface {
+	IsCreateSplitMediaUploadPayloadOrError()
+}
+
 type CreateSplitPayloadOrError interface {
 	IsCreateSplitPayloadOrError()
 }
 
+type CreateSplitTemplatePayloadOrError interface {
+	IsCreateSplitTemplatePayloadOrError()
+}
+
 type CreateUserPayloadOrError interface {
 	IsCreateUserPayloadOrError()
 }
 
+type CreateWebhookPayloadOrError interface {
+	IsCreateWebhookPayloadOrError()
+}
+
+type DeleteContactPayloadOrError interface {
+	IsDeleteContactPayloadOrError()
+}
+
 type DeleteSplitPayloadOrError interface {
 	IsDeleteSplitPayloadOrError()
 }
 
+type DeleteSplitTemplatePayloadOrError interface {
+	IsDeleteSplitTemplatePayloadOrError()
+}
+
+type DeleteWebhookPayloadOrError interface {
+	IsDeleteWebhookPayloadOrError()
+}
+
 type Error interface {
 	IsError()
 }
 
+type ExportLedgerPayloadOrError interface {
+	IsExportLedgerPayloadOrError()
+}
+
+type FollowSplitPayloadOrError interface {
+	IsFollowSplitPayloadOrError()
+}
+
 type GetAuthNoncePayloadOrError interface {
 	IsGetAuthNoncePayloadOrError()
 }
 
+type GrantSplitRolePayloadOrError interface {
+	IsGrantSplitRolePayloadOrError()
+}
+
 type GroupedNotification interface {
 	Notification
 	Node
 	IsGroupedNotification()
 }
 
+type ImportContactsPayloadOrError interface {
+	IsImportContactsPayloadOrError()
+}
+
+type InviteRecipientPayloadOrError interface {
+	IsInviteRecipientPayloadOrError()
+}
+
 type LoginPayloadOrError interface {
 	IsLoginPayloadOrError()
 }
@@ -74,6 +134,10 @@ type OptOutForRolesPayloadOrError interface {
 	IsOptOutForRolesPayloadOrError()
 }
 
+type PingWebhookPayloadOrError interface {
+	IsPingWebhookPayloadOrError()
+}
+
 type PreverifyEmailPayloadOrError interface {
 	IsPreverifyEmailPayloadOrError()
 }
@@ -86,6 +150,10 @@ type RegisterUserPushTokenPayloadOrError interface {
 	IsRegisterUserPushTokenPayloadOrError()
 }
 
+type RemoveSplitFromGroupPayloadOrError interface {
+	IsRemoveSplitFromGroupPayloadOrError()
+}
+
 type RemoveUserWalletsPayloadOrError interface {
 	IsRemoveUserWalletsPayloadOrError()
 }
@@ -94,10 +162,26 @@ type ResendVerificationEmailPayloadOrError interface {
 	IsResendVerificationEmailPayloadOrError()
 }
 
+type ResyncSplitFromChainPayloadOrError interface {
+	IsResyncSplitFromChainPayloadOrError()
+}
+
+type RevokeRecipientInvitePayloadOrError interface {
+	IsRevokeRecipientInvitePayloadOrError()
+}
+
 type RevokeRolesFromUserPayloadOrError interface {
 	IsRevokeRolesFromUserPayloadOrError()
 }
 
+type RevokeSplitRolePayloadOrError interface {
+	IsRevokeSplitRolePayloadOrError()
+}
+
+type SaveContactPayloadOrError interface {
+	IsSaveContactPayloadOrError()
+}
+
 type SearchSplitsPayloadOrError interface {
 	IsSearchSplitsPayloadOrError()
 }
@@ -106,6 +190,14 @@ type SearchUsersPayloadOrError interface {
 	IsSearchUsersPayloadOrError()
 }
 
+type SetTokenPricePayloadOrError interface {
+	IsSetTokenPricePayloadOrError()
+}
+
+type SimulateSplitChangePayloadOrError interface {
+	IsSimulateSplitChangePayloadOrError()
+}
+
 type SplitByIDPayloadOrError interface {
 	IsSplitByIDPayloadOrError()
 }
@@ -118,6 +210,14 @@ type SplitFiUserOrWallet interface {
 	IsSplitFiUserOrWallet()
 }
 
+type SplitGroupByIDPayloadOrError interface {
+	IsSplitGroupByIDPayloadOrError()
+}
+
+type UnfollowSplitPayloadOrError interface {
+	IsUnfollowSplitPayloadOrError()
+}
+
 type UnregisterUserPushTokenPayloadOrError interface {
 	IsUnregisterUserPushTokenPayloadOrError()
 }
@@ -138,6 +238,10 @@ type UpdatePrimaryWalletPayloadOrError interface {
 	IsUpdatePrimaryWalletPayloadOrError()
 }
 
+type UpdateSplitGroupInfoPayloadOrError interface {
+	IsUpdateSplitGroupInfoPayloadOrError()
+}
+
 type UpdateSplitHiddenPayloadOrError interface {
 	IsUpdateSplitHiddenPayloadOrError()
 }
@@ -162,6 +266,10 @@ type UpdateUserInfoPayloadOrError interface {
 	IsUpdateUserInfoPayloadOrError()
 }
 
+type UpdateWebhookPayloadOrError interface {
+	IsUpdateWebhookPayloadOrError()
+}
+
 type UploadPersistedQueriesPayloadOrError interface {
 	IsUploadPersistedQueriesPayloadOrError()
 }
@@ -194,6 +302,18 @@ type ViewerSplitByIDPayloadOrError interface {
 	IsViewerSplitByIDPayloadOrError()
 }
 
+type AcceptRecipientInvitePayload struct {
+	Recipient *Recipient `json:"recipient"`
+}
+
+func (AcceptRecipientInvitePayload) IsAcceptRecipientInvitePayloadOrError() {}
+
+type AddSplitToGroupPayload struct {
+	Group *SplitGroup `json:"group"`
+}
+
+func (AddSplitToGroupPayload) IsAddSplitToGroupPayloadOrError() {}
+
 type AddUserWalletPayload struct {
 	Viewer *Viewer `json:"viewer"`
 }
@@ -217,7 +337,11 @@ type Asset struct {
 	Version      *int                  `json:"version"`
 	OwnerAddress *persist.ChainAddress `json:"ownerAddress"`
 	Balance      *int                  `json:"balance"`
-	Token        *Token                `json:"token"`
+	// The balance in the token's base units
+	Amount *string `json:"amount"`
+	// The balance valued at the token's current price, if the token has one
+	UsdValue *float64 `json:"usdValue"`
+	Token    *Token   `json:"token"`
 }
 
 func (Asset) IsNode() {}
@@ -243,22 +367,90 @@ type ChainSplits struct {
 	Splits []*Split       `json:"splits"`
 }
 
+type ClaimableAmount struct {
+	Chain        *persist.Chain   `json:"chain"`
+	TokenAddress *persist.Address `json:"tokenAddress"`
+	Amount       *string          `json:"amount"`
+}
+
 type ClearAllNotificationsPayload struct {
 	Notifications []Notification `json:"notifications"`
 }
 
+type CompleteSplitMediaUploadPayload struct {
+	Split *Split `json:"split"`
+}
+
+func (CompleteSplitMediaUploadPayload) IsCompleteSplitMediaUploadPayloadOrError() {}
+
+type Contact struct {
+	Dbid         persist.DBID     `json:"dbid"`
+	CreationTime *time.Time       `json:"creationTime"`
+	LastUpdated  *time.Time       `json:"lastUpdated"`
+	Chain        *persist.Chain   `json:"chain"`
+	Address      *persist.Address `json:"address"`
+	Label        *string          `json:"label"`
+	Notes        *string          `json:"notes"`
+}
+
+type CreateSplitGroupInput struct {
+	Name        *string        `json:"name"`
+	Description *string        `json:"description"`
+	SplitIds    []persist.DBID `json:"splitIds"`
+}
+
+type CreateSplitGroupPayload struct {
+	Group *SplitGroup `json:"group"`
+}
+
+func (CreateSplitGroupPayload) IsCreateSplitGroupPayloadOrError() {}
+
 type CreateSplitInput struct {
 	Name        *string `json:"name"`
 	Description *string `json:"description"`
 	Logo        *string `json:"logo"`
 }
 
+type CreateSplitMediaUploadInput struct {
+	SplitID       persist.DBID   `json:"splitId"`
+	Kind          SplitMediaKind `json:"kind"`
+	ContentType   string         `json:"contentType"`
+	ContentLength int            `json:"contentLength"`
+}
+
+type CreateSplitMediaUploadPayload struct {
+	UploadID persist.DBID `json:"uploadId"`
+	// The image must be uploaded to uploadURL with a PUT request that sends every header in headers
+	UploadURL string          `json:"uploadURL"`
+	Headers   []*UploadHeader `json:"headers"`
+	ExpiresAt time.Time       `json:"expiresAt"`
+}
+
+func (CreateSplitMediaUploadPayload) IsCreateSplitMediaUploadPayloadOrError() {}
+
 type CreateSplitPayload struct {
 	Split *Split `json:"split"`
 }
 
 func (CreateSplitPayload) IsCreateSplitPayloadOrError() {}
 
+type CreateSplitTemplateInput struct {
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+	LogoURL     *string `json:"logoURL"`
+	BannerURL   *string `json:"bannerURL"`
+	BadgeURL    *string `json:"badgeURL"`
+	// In parts per million, at most 1000000. Recipients' ownership must add up to exactly this amount.
+	TotalOwnership int                            `json:"totalOwnership"`
+	Recipients     []*SplitTemplateRecipientInput `json:"recipients"`
+}
+
+type CreateSplitTemplatePayload struct {
+	Template *SplitTemplate `json:"template"`
+}
+
+func (CreateSplitTemplatePayload) IsCreateSplitTemplatePayloadOrError() {}
+
 type CreateUserInput struct {
 	Username *string        `json:"username"`
 	Email    *persist.Email `json:"email"`
@@ -270,6 +462,20 @@ type CreateUserPayload struct {
 
 func (CreateUserPayload) IsCreateUserPayloadOrError() {}
 
+type CreateWebhookInput struct {
+	URL        string             `json:"url"`
+	SplitID    *persist.DBID      `json:"splitId"`
+	EventTypes []WebhookEventType `json:"eventTypes"`
+}
+
+type CreateWebhookPayload struct {
+	Webhook *Webhook `json:"webhook"`
+	// The secret the webhook's requests are signed with. It's only returned when the webhook is created.
+	Secret *string `json:"secret"`
+}
+
+func (CreateWebhookPayload) IsCreateWebhookPayloadOrError() {}
+
 type DebugAuth struct {
 	AsUsername         *string                 `json:"asUsername"`
 	UserID             *persist.DBID           `json:"userId"`
@@ -277,18 +483,42 @@ type DebugAuth struct {
 	DebugToolsPassword *string                 `json:"debugToolsPassword"`
 }
 
+type DeleteContactPayload struct {
+	Viewer *Viewer `json:"viewer"`
+}
+
+func (DeleteContactPayload) IsDeleteContactPayloadOrError() {}
+
 type DeleteSplitPayload struct {
-	DeletedID *DeletedNode `json:"deletedId"`
+	DeletedID       *DeletedNode          `json:"deletedId"`
+	PendingDeletion *SplitDeletionRequest `json:"pendingDeletion"`
 }
 
 func (DeleteSplitPayload) IsDeleteSplitPayloadOrError() {}
 
+type DeleteSplitTemplatePayload struct {
+	Viewer *Viewer `json:"viewer"`
+}
+
+func (DeleteSplitTemplatePayload) IsDeleteSplitTemplatePayloadOrError() {}
+
+type DeleteWebhookPayload struct {
+	Viewer *Viewer `json:"viewer"`
+}
+
+func (DeleteWebhookPayload) IsDeleteWebhookPayloadOrError() {}
+
 type DeletedNode struct {
 	Dbid persist.DBID `json:"dbid"`
 }
 
 func (DeletedNode) IsNode() {}
 
+type EffectiveOwnership struct {
+	Address    *persist.Address `json:"address"`
+	Percentage *float64         `json:"percentage"`
+}
+
 type EmailNotificationSettings struct {
 	UnsubscribedFromAll           bool `json:"unsubscribedFromAll"`
 	UnsubscribedFromNotifications bool `json:"unsubscribedFromNotifications"`
@@ -339,6 +569,7 @@ type ErrInvalidInput struct {
 	Reasons    []string `json:"reasons"`
 }
 
+func (ErrInvalidInput) IsSimulateSplitChangePayloadOrError()             {}
 func (ErrInvalidInput) IsUserByUsernameOrError()                         {}
 func (ErrInvalidInput) IsUserByIDOrError()                               {}
 func (ErrInvalidInput) IsUserByAddressOrError()                          {}
@@ -361,13 +592,38 @@ func (ErrInvalidInput) IsUnsubscribeFromEmailTypePayloadOrError()        {}
 func (ErrInvalidInput) IsOptInForRolesPayloadOrError()                   {}
 func (ErrInvalidInput) IsOptOutForRolesPayloadOrError()                  {}
 func (ErrInvalidInput) IsCreateSplitPayloadOrError()                     {}
+func (ErrInvalidInput) IsCreateSplitTemplatePayloadOrError()             {}
+func (ErrInvalidInput) IsDeleteSplitTemplatePayloadOrError()             {}
+func (ErrInvalidInput) IsSaveContactPayloadOrError()                     {}
+func (ErrInvalidInput) IsDeleteContactPayloadOrError()                   {}
+func (ErrInvalidInput) IsImportContactsPayloadOrError()                  {}
+func (ErrInvalidInput) IsExportLedgerPayloadOrError()                    {}
+func (ErrInvalidInput) IsInviteRecipientPayloadOrError()                 {}
+func (ErrInvalidInput) IsAcceptRecipientInvitePayloadOrError()           {}
+func (ErrInvalidInput) IsRevokeRecipientInvitePayloadOrError()           {}
 func (ErrInvalidInput) IsUpdateSplitInfoPayloadOrError()                 {}
+func (ErrInvalidInput) IsGrantSplitRolePayloadOrError()                  {}
+func (ErrInvalidInput) IsRevokeSplitRolePayloadOrError()                 {}
+func (ErrInvalidInput) IsCreateSplitGroupPayloadOrError()                {}
+func (ErrInvalidInput) IsAddSplitToGroupPayloadOrError()                 {}
+func (ErrInvalidInput) IsRemoveSplitFromGroupPayloadOrError()            {}
+func (ErrInvalidInput) IsUpdateSplitGroupInfoPayloadOrError()            {}
+func (ErrInvalidInput) IsFollowSplitPayloadOrError()                     {}
+func (ErrInvalidInput) IsUnfollowSplitPayloadOrError()                   {}
+func (ErrInvalidInput) IsCreateWebhookPayloadOrError()                   {}
+func (ErrInvalidInput) IsUpdateWebhookPayloadOrError()                   {}
+func (ErrInvalidInput) IsDeleteWebhookPayloadOrError()                   {}
+func (ErrInvalidInput) IsPingWebhookPayloadOrError()                     {}
+func (ErrInvalidInput) IsCreateSplitMediaUploadPayloadOrError()          {}
+func (ErrInvalidInput) IsCompleteSplitMediaUploadPayloadOrError()        {}
 func (ErrInvalidInput) IsUpdateSplitHiddenPayloadOrError()               {}
 func (ErrInvalidInput) IsDeleteSplitPayloadOrError()                     {}
 func (ErrInvalidInput) IsUpdateSplitOrderPayloadOrError()                {}
 func (ErrInvalidInput) IsUpdateSplitPayloadOrError()                     {}
 func (ErrInvalidInput) IsPublishSplitPayloadOrError()                    {}
 func (ErrInvalidInput) IsUpdatePrimaryWalletPayloadOrError()             {}
+func (ErrInvalidInput) IsResyncSplitFromChainPayloadOrError()            {}
+func (ErrInvalidInput) IsSetTokenPricePayloadOrError()                   {}
 func (ErrInvalidInput) IsUpdateUserExperiencePayloadOrError()            {}
 
 type ErrInvalidToken struct {
@@ -389,28 +645,54 @@ type ErrNotAuthorized struct {
 	Cause   AuthorizationError `json:"cause"`
 }
 
-func (ErrNotAuthorized) IsViewerOrError()                         {}
-func (ErrNotAuthorized) IsAddUserWalletPayloadOrError()           {}
-func (ErrNotAuthorized) IsRemoveUserWalletsPayloadOrError()       {}
-func (ErrNotAuthorized) IsUpdateUserInfoPayloadOrError()          {}
-func (ErrNotAuthorized) IsRegisterUserPushTokenPayloadOrError()   {}
-func (ErrNotAuthorized) IsUnregisterUserPushTokenPayloadOrError() {}
-func (ErrNotAuthorized) IsError()                                 {}
-func (ErrNotAuthorized) IsAddRolesToUserPayloadOrError()          {}
-func (ErrNotAuthorized) IsRevokeRolesFromUserPayloadOrError()     {}
-func (ErrNotAuthorized) IsOptInForRolesPayloadOrError()           {}
-func (ErrNotAuthorized) IsOptOutForRolesPayloadOrError()          {}
-func (ErrNotAuthorized) IsUploadPersistedQueriesPayloadOrError()  {}
-func (ErrNotAuthorized) IsCreateSplitPayloadOrError()             {}
-func (ErrNotAuthorized) IsUpdateSplitInfoPayloadOrError()         {}
-func (ErrNotAuthorized) IsUpdateSplitHiddenPayloadOrError()       {}
-func (ErrNotAuthorized) IsDeleteSplitPayloadOrError()             {}
-func (ErrNotAuthorized) IsUpdateSplitOrderPayloadOrError()        {}
-func (ErrNotAuthorized) IsUpdateSplitPayloadOrError()             {}
-func (ErrNotAuthorized) IsPublishSplitPayloadOrError()            {}
-func (ErrNotAuthorized) IsUpdatePrimaryWalletPayloadOrError()     {}
-func (ErrNotAuthorized) IsAdminAddWalletPayloadOrError()          {}
-func (ErrNotAuthorized) IsUpdateUserExperiencePayloadOrError()    {}
+func (ErrNotAuthorized) IsSimulateSplitChangePayloadOrError()      {}
+func (ErrNotAuthorized) IsViewerOrError()                          {}
+func (ErrNotAuthorized) IsAddUserWalletPayloadOrError()            {}
+func (ErrNotAuthorized) IsRemoveUserWalletsPayloadOrError()        {}
+func (ErrNotAuthorized) IsUpdateUserInfoPayloadOrError()           {}
+func (ErrNotAuthorized) IsRegisterUserPushTokenPayloadOrError()    {}
+func (ErrNotAuthorized) IsUnregisterUserPushTokenPayloadOrError()  {}
+func (ErrNotAuthorized) IsError()                                  {}
+func (ErrNotAuthorized) IsAddRolesToUserPayloadOrError()           {}
+func (ErrNotAuthorized) IsRevokeRolesFromUserPayloadOrError()      {}
+func (ErrNotAuthorized) IsOptInForRolesPayloadOrError()            {}
+func (ErrNotAuthorized) IsOptOutForRolesPayloadOrError()           {}
+func (ErrNotAuthorized) IsUploadPersistedQueriesPayloadOrError()   {}
+func (ErrNotAuthorized) IsCreateSplitPayloadOrError()              {}
+func (ErrNotAuthorized) IsCreateSplitTemplatePayloadOrError()      {}
+func (ErrNotAuthorized) IsDeleteSplitTemplatePayloadOrError()      {}
+func (ErrNotAuthorized) IsSaveContactPayloadOrError()              {}
+func (ErrNotAuthorized) IsDeleteContactPayloadOrError()            {}
+func (ErrNotAuthorized) IsImportContactsPayloadOrError()           {}
+func (ErrNotAuthorized) IsExportLedgerPayloadOrError()             {}
+func (ErrNotAuthorized) IsInviteRecipientPayloadOrError()          {}
+func (ErrNotAuthorized) IsAcceptRecipientInvitePayloadOrError()    {}
+func (ErrNotAuthorized) IsRevokeRecipientInvitePayloadOrError()    {}
+func (ErrNotAuthorized) IsUpdateSplitInfoPayloadOrError()          {}
+func (ErrNotAuthorized) IsGrantSplitRolePayloadOrError()           {}
+func (ErrNotAuthorized) IsRevokeSplitRolePayloadOrError()          {}
+func (ErrNotAuthorized) IsCreateSplitGroupPayloadOrError()         {}
+func (ErrNotAuthorized) IsAddSplitToGroupPayloadOrError()          {}
+func (ErrNotAuthorized) IsRemoveSplitFromGroupPayloadOrError()     {}
+func (ErrNotAuthorized) IsUpdateSplitGroupInfoPayloadOrError()     {}
+func (ErrNotAuthorized) IsFollowSplitPayloadOrError()              {}
+func (ErrNotAuthorized) IsUnfollowSplitPayloadOrError()            {}
+func (ErrNotAuthorized) IsCreateWebhookPayloadOrError()            {}
+func (ErrNotAuthorized) IsUpdateWebhookPayloadOrError()            {}
+func (ErrNotAuthorized) IsDeleteWebhookPayloadOrError()            {}
+func (ErrNotAuthorized) IsPingWebhookPayloadOrError()              {}
+func (ErrNotAuthorized) IsCreateSplitMediaUploadPayloadOrError()   {}
+func (ErrNotAuthorized) IsCompleteSplitMediaUploadPayloadOrError() {}
+func (ErrNotAuthorized) IsUpdateSplitHiddenPayloadOrError()        {}
+func (ErrNotAuthorized) IsDeleteSplitPayloadOrError()              {}
+func (ErrNotAuthorized) IsUpdateSplitOrderPayloadOrError()         {}
+func (ErrNotAuthorized) IsUpdateSplitPayloadOrError()              {}
+func (ErrNotAuthorized) IsPublishSplitPayloadOrError()             {}
+func (ErrNotAuthorized) IsUpdatePrimaryWalletPayloadOrError()      {}
+func (ErrNotAuthorized) IsAdminAddWalletPayloadOrError()           {}
+func (ErrNotAuthorized) IsResyncSplitFromChainPayloadOrError()     {}
+func (ErrNotAuthorized) IsSetTokenPricePayloadOrError()            {}
+func (ErrNotAuthorized) IsUpdateUserExperiencePayloadOrError()     {}
 
 type ErrPushTokenBelongsToAnotherUser struct {
 	Message string `json:"message"`
@@ -420,6 +702,14 @@ func (ErrPushTokenBelongsToAnotherUser) IsRegisterUserPushTokenPayloadOrError()
 func (ErrPushTokenBelongsToAnotherUser) IsUnregisterUserPushTokenPayloadOrError() {}
 func (ErrPushTokenBelongsToAnotherUser) IsError()                                 {}
 
+type ErrRecipientInviteNotFound struct {
+	Message string `json:"message"`
+}
+
+func (ErrRecipientInviteNotFound) IsError()                               {}
+func (ErrRecipientInviteNotFound) IsAcceptRecipientInvitePayloadOrError() {}
+func (ErrRecipientInviteNotFound) IsRevokeRecipientInvitePayloadOrError() {}
+
 type ErrSessionInvalidated struct {
 	Message string `json:"message"`
 }
@@ -427,13 +717,50 @@ type ErrSessionInvalidated struct {
 func (ErrSessionInvalidated) IsAuthorizationError() {}
 func (ErrSessionInvalidated) IsError()              {}
 
+type ErrSplitGroupNotFound struct {
+	Message string `json:"message"`
+}
+
+func (ErrSplitGroupNotFound) IsError()                              {}
+func (ErrSplitGroupNotFound) IsSplitGroupByIDPayloadOrError()       {}
+func (ErrSplitGroupNotFound) IsAddSplitToGroupPayloadOrError()      {}
+func (ErrSplitGroupNotFound) IsRemoveSplitFromGroupPayloadOrError() {}
+func (ErrSplitGroupNotFound) IsUpdateSplitGroupInfoPayloadOrError() {}
+
+type ErrSplitMediaNotFound struct {
+	Message string `json:"message"`
+}
+
+func (ErrSplitMediaNotFound) IsError()                                  {}
+func (ErrSplitMediaNotFound) IsCompleteSplitMediaUploadPayloadOrError() {}
+
 type ErrSplitNotFound struct {
 	Message string `json:"message"`
 }
 
-func (ErrSplitNotFound) IsError()                         {}
-func (ErrSplitNotFound) IsSplitByIDPayloadOrError()       {}
-func (ErrSplitNotFound) IsViewerSplitByIDPayloadOrError() {}
+func (ErrSplitNotFound) IsSimulateSplitChangePayloadOrError()    {}
+func (ErrSplitNotFound) IsError()                                {}
+func (ErrSplitNotFound) IsSplitByIDPayloadOrError()              {}
+func (ErrSplitNotFound) IsViewerSplitByIDPayloadOrError()        {}
+func (ErrSplitNotFound) IsExportLedgerPayloadOrError()           {}
+func (ErrSplitNotFound) IsInviteRecipientPayloadOrError()        {}
+func (ErrSplitNotFound) IsGrantSplitRolePayloadOrError()         {}
+func (ErrSplitNotFound) IsRevokeSplitRolePayloadOrError()        {}
+func (ErrSplitNotFound) IsCreateSplitGroupPayloadOrError()       {}
+func (ErrSplitNotFound) IsAddSplitToGroupPayloadOrError()        {}
+func (ErrSplitNotFound) IsFollowSplitPayloadOrError()            {}
+func (ErrSplitNotFound) IsUnfollowSplitPayloadOrError()          {}
+func (ErrSplitNotFound) IsCreateWebhookPayloadOrError()          {}
+func (ErrSplitNotFound) IsCreateSplitMediaUploadPayloadOrError() {}
+func (ErrSplitNotFound) IsResyncSplitFromChainPayloadOrError()   {}
+
+type ErrSplitRoleRequired struct {
+	Message string    `json:"message"`
+	Role    SplitRole `json:"role"`
+}
+
+func (ErrSplitRoleRequired) IsAuthorizationError() {}
+func (ErrSplitRoleRequired) IsError()              {}
 
 type ErrSyncFailed struct {
 	Message string `json:"message"`
@@ -463,6 +790,7 @@ func (ErrUserNotFound) IsUserByIDOrError()              {}
 func (ErrUserNotFound) IsUserByAddressOrError()         {}
 func (ErrUserNotFound) IsError()                        {}
 func (ErrUserNotFound) IsLoginPayloadOrError()          {}
+func (ErrUserNotFound) IsGrantSplitRolePayloadOrError() {}
 func (ErrUserNotFound) IsAdminAddWalletPayloadOrError() {}
 
 type ErrUsernameNotAvailable struct {
@@ -473,12 +801,54 @@ func (ErrUsernameNotAvailable) IsUpdateUserInfoPayloadOrError() {}
 func (ErrUsernameNotAvailable) IsError()                        {}
 func (ErrUsernameNotAvailable) IsCreateUserPayloadOrError()     {}
 
+type ErrWebhookNotFound struct {
+	Message string `json:"message"`
+}
+
+func (ErrWebhookNotFound) IsError()                       {}
+func (ErrWebhookNotFound) IsUpdateWebhookPayloadOrError() {}
+func (ErrWebhookNotFound) IsDeleteWebhookPayloadOrError() {}
+func (ErrWebhookNotFound) IsPingWebhookPayloadOrError()   {}
+
+type ExportLedgerInput struct {
+	SplitID          *persist.DBID      `json:"splitId"`
+	RecipientAddress *persist.Address   `json:"recipientAddress"`
+	From             time.Time          `json:"from"`
+	To               time.Time          `json:"to"`
+	Format           LedgerExportFormat `json:"format"`
+}
+
+type ExportLedgerPayload struct {
+	Export *LedgerExport `json:"export"`
+}
+
+func (ExportLedgerPayload) IsExportLedgerPayloadOrError() {}
+
+type FollowSplitPayload struct {
+	Viewer *Viewer `json:"viewer"`
+	Split  *Split  `json:"split"`
+}
+
+func (FollowSplitPayload) IsFollowSplitPayloadOrError() {}
+
 type GnosisSafeAuth struct {
 	Address persist.Address `json:"address"`
 	Nonce   string          `json:"nonce"`
 	Message string          `json:"message"`
 }
 
+type GrantSplitRoleInput struct {
+	SplitID persist.DBID `json:"splitId"`
+	UserID  persist.DBID `json:"userId"`
+	Role    SplitRole    `json:"role"`
+}
+
+type GrantSplitRolePayload struct {
+	Member *SplitMember `json:"member"`
+}
+
+func (GrantSplitRolePayload) IsGrantSplitRolePayloadOrError() {}
+
 type GroupNotificationUserEdge struct {
 	Node   *SplitFiUser `json:"node"`
 	Cursor *string      `json:"cursor"`
@@ -490,6 +860,36 @@ type GroupNotificationUsersConnection struct {
 	PageInfo *PageInfo                    `json:"pageInfo"`
 }
 
+// An amount of a token, in base units, that a simulated split receives on top of its current balances
+type HypotheticalInflowInput struct {
+	TokenAddress persist.Address `json:"tokenAddress"`
+	Amount       string          `json:"amount"`
+}
+
+type ImportContactsPayload struct {
+	Viewer   *Viewer `json:"viewer"`
+	Imported *int    `json:"imported"`
+}
+
+func (ImportContactsPayload) IsImportContactsPayloadOrError() {}
+
+type InviteRecipientPayload struct {
+	Recipient *Recipient `json:"recipient"`
+}
+
+func (InviteRecipientPayload) IsInviteRecipientPayloadOrError() {}
+
+type LedgerExport struct {
+	Dbid         persist.DBID        `json:"dbid"`
+	CreationTime *time.Time          `json:"creationTime"`
+	Format       *LedgerExportFormat `json:"format"`
+	Status       *LedgerExportStatus `json:"status"`
+	RowCount     *int                `json:"rowCount"`
+	// A signed link to download the export, valid for an hour from when it's requested. Only set once the
+	// export is complete.
+	DownloadURL *string `json:"downloadURL"`
+}
+
 type LoginPayload struct {
 	Viewer *Viewer `json:"viewer"`
 }
@@ -504,6 +904,28 @@ type MagicLinkAuth struct {
 	Token string `json:"token"`
 }
 
+type MemberSplit struct {
+	HelperMemberSplitData
+	Split *Split `json:"split"`
+	// The share of the split held by the member's recipient addresses, in parts per million out of the split's
+	// totalOwnership
+	Ownership *int `json:"ownership"`
+	// The amount of each token held by the split that the member would receive if the split were distributed now
+	Claimable []*ClaimableAmount `json:"claimable"`
+	// The total of each token the split has paid out to the member's recipient addresses
+	LifetimeEarnings []*TokenAmount `json:"lifetimeEarnings"`
+}
+
+type MemberSplitEdge struct {
+	Node   *MemberSplit `json:"node"`
+	Cursor *string      `json:"cursor"`
+}
+
+type MemberSplitsConnection struct {
+	Edges    []*MemberSplitEdge `json:"edges"`
+	PageInfo *PageInfo          `json:"pageInfo"`
+}
+
 type NotificationEdge struct {
 	Node   Notification `json:"node"`
 	Cursor *string      `json:"cursor"`
@@ -549,6 +971,12 @@ type PageInfo struct {
 	EndCursor       string `json:"endCursor"`
 }
 
+type PingWebhookPayload struct {
+	Delivery *WebhookDelivery `json:"delivery"`
+}
+
+func (PingWebhookPayload) IsPingWebhookPayloadOrError() {}
+
 type PreverifyEmailInput struct {
 	Email persist.Email `json:"email"`
 }
@@ -577,23 +1005,58 @@ type PublishSplitPayload struct {
 func (PublishSplitPayload) IsPublishSplitPayloadOrError() {}
 
 type Recipient struct {
+	HelperRecipientData
 	Dbid         persist.DBID     `json:"dbid"`
 	Version      *int             `json:"version"`
 	CreationTime *time.Time       `json:"creationTime"`
 	LastUpdated  *time.Time       `json:"lastUpdated"`
 	Address      *persist.Address `json:"address"`
 	Split        *Split           `json:"split"`
-	Ownership    *int             `json:"ownership"`
+	// The split at this recipient's address, if the recipient is itself a split on the same chain
+	RecipientSplit *Split `json:"recipientSplit"`
+	// The recipient's share of the split in parts per million, out of the split's totalOwnership
+	Ownership *int `json:"ownership"`
+	// The amount of each token held by the split that this recipient would receive if the split were distributed now.
+	// Amounts are in the token's base units.
+	Claimable []*ClaimableAmount `json:"claimable"`
+	// The viewer's label for this recipient's address, if the address is in the viewer's address book
+	Label *string `json:"label"`
+	// The latest invite to claim this recipient's share that hasn't been revoked, if any
+	Invite *RecipientInvite `json:"invite"`
+	// The user who claimed this recipient by accepting an invite
+	User *SplitFiUser `json:"user"`
 }
 
 func (Recipient) IsNode() {}
 
+type RecipientAllocation struct {
+	Address *persist.Address `json:"address"`
+	Amount  *string          `json:"amount"`
+}
+
+type RecipientInvite struct {
+	HelperRecipientInviteData
+	Dbid         persist.DBID           `json:"dbid"`
+	CreationTime *time.Time             `json:"creationTime"`
+	Status       *RecipientInviteStatus `json:"status"`
+	LastSentTime *time.Time             `json:"lastSentTime"`
+	AcceptedTime *time.Time             `json:"acceptedTime"`
+	Invitee      *SplitFiUser           `json:"invitee"`
+}
+
 type RegisterUserPushTokenPayload struct {
 	Viewer *Viewer `json:"viewer"`
 }
 
 func (RegisterUserPushTokenPayload) IsRegisterUserPushTokenPayloadOrError() {}
 
+type RemoveSplitFromGroupPayload struct {
+	Group *SplitGroup `json:"group"`
+	Split *Split      `json:"split"`
+}
+
+func (RemoveSplitFromGroupPayload) IsRemoveSplitFromGroupPayloadOrError() {}
+
 type RemoveUserWalletsPayload struct {
 	Viewer *Viewer `json:"viewer"`
 }
@@ -606,6 +1069,42 @@ type ResendVerificationEmailPayload struct {
 
 func (ResendVerificationEmailPayload) IsResendVerificationEmailPayloadOrError() {}
 
+type ResyncSplitFromChainPayload struct {
+	Split *Split `json:"split"`
+}
+
+func (ResyncSplitFromChainPayload) IsResyncSplitFromChainPayloadOrError() {}
+
+type RevokeRecipientInvitePayload struct {
+	Recipient *Recipient `json:"recipient"`
+}
+
+func (RevokeRecipientInvitePayload) IsRevokeRecipientInvitePayloadOrError() {}
+
+type RevokeSplitRoleInput struct {
+	SplitID persist.DBID `json:"splitId"`
+	UserID  persist.DBID `json:"userId"`
+}
+
+type RevokeSplitRolePayload struct {
+	Split *Split `json:"split"`
+}
+
+func (RevokeSplitRolePayload) IsRevokeSplitRolePayloadOrError() {}
+
+type SaveContactInput struct {
+	Chain   persist.Chain   `json:"chain"`
+	Address persist.Address `json:"address"`
+	Label   string          `json:"label"`
+	Notes   *string         `json:"notes"`
+}
+
+type SaveContactPayload struct {
+	Contact *Contact `json:"contact"`
+}
+
+func (SaveContactPayload) IsSaveContactPayloadOrError() {}
+
 type SearchSplitsPayload struct {
 	Results []*SplitSearchResult `json:"results"`
 }
@@ -618,6 +1117,52 @@ type SearchUsersPayload struct {
 
 func (SearchUsersPayload) IsSearchUsersPayloadOrError() {}
 
+type SetTokenPriceInput struct {
+	Chain        persist.Chain   `json:"chain"`
+	TokenAddress persist.Address `json:"tokenAddress"`
+	Decimals     int             `json:"decimals"`
+	UsdPrice     float64         `json:"usdPrice"`
+}
+
+type SetTokenPricePayload struct {
+	Chain        *persist.Chain   `json:"chain"`
+	TokenAddress *persist.Address `json:"tokenAddress"`
+	Decimals     *int             `json:"decimals"`
+	UsdPrice     *float64         `json:"usdPrice"`
+}
+
+func (SetTokenPricePayload) IsSetTokenPricePayloadOrError() {}
+
+type SimulateSplitChangePayload struct {
+	Split               *Split                        `json:"split"`
+	Balances            []*SimulatedTokenDistribution `json:"balances"`
+	HypotheticalInflows []*SimulatedTokenDistribution `json:"hypotheticalInflows"`
+}
+
+func (SimulateSplitChangePayload) IsSimulateSplitChangePayloadOrError() {}
+
+type SimulatedRecipientAllocation struct {
+	Address *persist.Address `json:"address"`
+	Before  *string          `json:"before"`
+	After   *string          `json:"after"`
+	Change  *string          `json:"change"`
+}
+
+// Sets a recipient's ownership in a simulated change to a split, in parts per million
+type SimulatedShareInput struct {
+	RecipientAddress persist.Address `json:"recipientAddress"`
+	Ownership        int             `json:"ownership"`
+}
+
+type SimulatedTokenDistribution struct {
+	Chain               *persist.Chain                  `json:"chain"`
+	TokenAddress        *persist.Address                `json:"tokenAddress"`
+	Amount              *string                         `json:"amount"`
+	UndistributedBefore *string                         `json:"undistributedBefore"`
+	UndistributedAfter  *string                         `json:"undistributedAfter"`
+	Recipients          []*SimulatedRecipientAllocation `json:"recipients"`
+}
+
 type Split struct {
 	Dbid        persist.DBID   `json:"dbid"`
 	Version     *int           `json:"version"`
@@ -627,24 +1172,140 @@ type Split struct {
 	LogoURL     *string        `json:"logoURL"`
 	BannerURL   *string        `json:"bannerURL"`
 	BadgeURL    *string        `json:"badgeURL"`
-	Assets      []*Asset       `json:"assets"`
-	Shares      []*Recipient   `json:"shares"`
+	// The split's images at the sizes clients display them at. Images set by URL instead of uploaded have no blurhash
+	// or aspect ratio.
+	Logo   *SplitMedia `json:"logo"`
+	Banner *SplitMedia `json:"banner"`
+	Badge  *SplitMedia `json:"badge"`
+	// The ownership that the split's recipients add up to, in parts per million. 1000000 is the whole split.
+	TotalOwnership *int `json:"totalOwnership"`
+	// The tokens held by the split. Spam tokens are excluded unless the filter includes them.
+	Assets *SplitAssetsConnection `json:"assets"`
+	// The split's recipients, largest ownership first
+	Shares *SplitSharesConnection `json:"shares"`
+	// Compares the split's recipients against the configuration enforced by its deployed contract.
+	// Reads from the chain on every request. Null if the split hasn't been deployed.
+	OnchainStatus *SplitOnchainStatus `json:"onchainStatus"`
+	// Previews how the split's current token balances would be distributed across its recipients.
+	// Amounts are in each token's base units.
+	DistributionPreview []*TokenDistribution          `json:"distributionPreview"`
+	Distributions       *SplitLedgerEntriesConnection `json:"distributions"`
+	// Returns every recorded change to the split's info or recipients. The split's version is the
+	// number of its latest revision.
+	Revisions    *SplitRevisionsConnection `json:"revisions"`
+	RevisionDiff *SplitRevisionDiff        `json:"revisionDiff"`
+	// Returns the viewer's unpublished edits made under editId. Only visible to the viewer who made them.
+	Draft *SplitDraft `json:"draft"`
+	// Flattens nested splits into the share of this split's funds that each end recipient ultimately receives
+	EffectiveOwnership []*EffectiveOwnership `json:"effectiveOwnership"`
+	// The request to delete this split that is still waiting for recipients to approve it, if any
+	PendingDeletion *SplitDeletionRequest `json:"pendingDeletion"`
+	// Summarizes the funds sent to the split during window, computed from the transfers it has received
+	Analytics *SplitAnalytics `json:"analytics"`
+	// The viewer's role on this split, or null if the viewer has no access to it
+	ViewerRole *SplitRole `json:"viewerRole"`
+	// The users that have been granted a role on this split. Controllers and recipients have their roles without
+	// being granted them, so they're only listed if they've also been granted a role.
+	Members []*SplitMember `json:"members"`
+	// The group linking this split to its deployments on other chains, if it's in one
+	Group *SplitGroup `json:"group"`
+	// A link to an image of the split's name, logo, recipient count and total received, for embedding on other sites
+	// and in link previews. The link changes whenever the split does. Defaults to a light, medium SVG.
+	CardURL *string `json:"cardURL"`
 }
 
 func (Split) IsNode()                    {}
 func (Split) IsSplitByIDPayloadOrError() {}
 
+type SplitActivity struct {
+	HelperSplitActivityData
+	Split               *Split             `json:"split"`
+	ActivityType        *SplitActivityType `json:"activityType"`
+	Chain               *persist.Chain     `json:"chain"`
+	TokenAddress        *persist.Address   `json:"tokenAddress"`
+	CounterpartyAddress *persist.Address   `json:"counterpartyAddress"`
+	Amount              *string            `json:"amount"`
+	TxHash              *string            `json:"txHash"`
+	BlockNumber         *string            `json:"blockNumber"`
+}
+
+type SplitAnalytics struct {
+	Window      *Window                  `json:"window"`
+	TotalUsd    *float64                 `json:"totalUSD"`
+	InflowCount *int                     `json:"inflowCount"`
+	Tokens      []*SplitInflowTokenTotal `json:"tokens"`
+	TopPayers   []*SplitInflowPayer      `json:"topPayers"`
+	TimeSeries  []*SplitInflowBucket     `json:"timeSeries"`
+}
+
+type SplitAssetEdge struct {
+	Node   *Asset  `json:"node"`
+	Cursor *string `json:"cursor"`
+}
+
+type SplitAssetsConnection struct {
+	Edges    []*SplitAssetEdge `json:"edges"`
+	PageInfo *PageInfo         `json:"pageInfo"`
+}
+
+type SplitAssetsFilter struct {
+	// Include tokens flagged as spam. Defaults to false.
+	IncludeSpam *bool           `json:"includeSpam"`
+	TokenTypes  []TokenType     `json:"tokenTypes"`
+	Chains      []persist.Chain `json:"chains"`
+}
+
+type SplitBalancesUpdate struct {
+	HelperSplitBalancesUpdateData
+	Split       *Split         `json:"split"`
+	Balances    []*TokenAmount `json:"balances"`
+	UpdatedTime *time.Time     `json:"updatedTime"`
+}
+
+type SplitDeletionApproval struct {
+	HelperSplitDeletionApprovalData
+	Dbid         persist.DBID     `json:"dbid"`
+	CreationTime *time.Time       `json:"creationTime"`
+	Approver     *SplitFiUser     `json:"approver"`
+	Address      *persist.Address `json:"address"`
+	Ownership    *int             `json:"ownership"`
+}
+
+type SplitDeletionRequest struct {
+	HelperSplitDeletionRequestData
+	Dbid              persist.DBID             `json:"dbid"`
+	CreationTime      *time.Time               `json:"creationTime"`
+	Requester         *SplitFiUser             `json:"requester"`
+	Quorum            *int                     `json:"quorum"`
+	ApprovedOwnership *int                     `json:"approvedOwnership"`
+	Approvals         []*SplitDeletionApproval `json:"approvals"`
+}
+
+type SplitDraft struct {
+	EditID      string     `json:"editId"`
+	Preview     *Split     `json:"preview"`
+	LastUpdated *time.Time `json:"lastUpdated"`
+	ExpiresAt   *time.Time `json:"expiresAt"`
+}
+
+type SplitEdge struct {
+	Node   *Split  `json:"node"`
+	Cursor *string `json:"cursor"`
+}
+
 type SplitFiUser struct {
 	HelperSplitFiUserData
-	Dbid                persist.DBID    `json:"dbid"`
-	Username            *string         `json:"username"`
-	Universal           *bool           `json:"universal"`
-	Roles               []*persist.Role `json:"roles"`
-	Wallets             []*Wallet       `json:"wallets"`
-	PrimaryWallet       *Wallet         `json:"primaryWallet"`
-	Splits              []*Split        `json:"splits"`
-	SplitsByChain       *ChainSplits    `json:"splitsByChain"`
-	IsAuthenticatedUser *bool           `json:"isAuthenticatedUser"`
+	Dbid          persist.DBID    `json:"dbid"`
+	Username      *string         `json:"username"`
+	Universal     *bool           `json:"universal"`
+	Roles         []*persist.Role `json:"roles"`
+	Wallets       []*Wallet       `json:"wallets"`
+	PrimaryWallet *Wallet         `json:"primaryWallet"`
+	Splits        []*Split        `json:"splits"`
+	// Pages through the same splits as splits, in the order the user chose. Splits the user has hidden are left out.
+	SplitsConnection    *SplitsConnection `json:"splitsConnection"`
+	SplitsByChain       *ChainSplits      `json:"splitsByChain"`
+	IsAuthenticatedUser *bool             `json:"isAuthenticatedUser"`
 }
 
 func (SplitFiUser) IsNode()                              {}
@@ -656,21 +1317,226 @@ func (SplitFiUser) IsUserByAddressOrError()              {}
 func (SplitFiUser) IsAddRolesToUserPayloadOrError()      {}
 func (SplitFiUser) IsRevokeRolesFromUserPayloadOrError() {}
 
+// Deployments of the same split on different chains within one L1 chain group, shown together as a single split
+type SplitGroup struct {
+	Dbid         persist.DBID `json:"dbid"`
+	Name         *string      `json:"name"`
+	Description  *string      `json:"description"`
+	CreationTime *time.Time   `json:"creationTime"`
+	Splits       []*Split     `json:"splits"`
+	// The balance of every token held by the group's splits. Amounts are in each token's base units.
+	Balances []*TokenAmount `json:"balances"`
+	// Previews how each split's current token balances would be distributed across its recipients
+	DistributionPreview []*TokenDistribution          `json:"distributionPreview"`
+	Distributions       *SplitLedgerEntriesConnection `json:"distributions"`
+	// Summarizes the funds sent to every split in the group during window. The viewer needs to be a viewer of
+	// every split in the group.
+	Analytics *SplitAnalytics `json:"analytics"`
+}
+
+func (SplitGroup) IsNode()                         {}
+func (SplitGroup) IsSplitGroupByIDPayloadOrError() {}
+
+type SplitGroupMemberInput struct {
+	GroupID persist.DBID `json:"groupId"`
+	SplitID persist.DBID `json:"splitId"`
+}
+
+type SplitInflowBucket struct {
+	Start       *time.Time `json:"start"`
+	UsdValue    *float64   `json:"usdValue"`
+	InflowCount *int       `json:"inflowCount"`
+}
+
+type SplitInflowPayer struct {
+	Address     *persist.Address `json:"address"`
+	UsdValue    *float64         `json:"usdValue"`
+	InflowCount *int             `json:"inflowCount"`
+}
+
+type SplitInflowTokenTotal struct {
+	Chain        *persist.Chain   `json:"chain"`
+	TokenAddress *persist.Address `json:"tokenAddress"`
+	Amount       *string          `json:"amount"`
+	UsdValue     *float64         `json:"usdValue"`
+	InflowCount  *int             `json:"inflowCount"`
+}
+
+type SplitLedgerEntriesConnection struct {
+	Edges    []*SplitLedgerEntryEdge `json:"edges"`
+	PageInfo *PageInfo               `json:"pageInfo"`
+}
+
+type SplitLedgerEntry struct {
+	HelperSplitLedgerEntryData
+	Dbid             persist.DBID          `json:"dbid"`
+	CreationTime     *time.Time            `json:"creationTime"`
+	EntryType        *SplitLedgerEntryType `json:"entryType"`
+	Split            *Split                `json:"split"`
+	Chain            *persist.Chain        `json:"chain"`
+	TokenAddress     *persist.Address      `json:"tokenAddress"`
+	RecipientAddress *persist.Address      `json:"recipientAddress"`
+	Amount           *string               `json:"amount"`
+	SplitBalance     *string               `json:"splitBalance"`
+	TxHash           *string               `json:"txHash"`
+	BlockNumber      *string               `json:"blockNumber"`
+}
+
+type SplitLedgerEntryEdge struct {
+	Node   *SplitLedgerEntry `json:"node"`
+	Cursor *string           `json:"cursor"`
+}
+
+type SplitMedia struct {
+	Small       *string  `json:"small"`
+	Medium      *string  `json:"medium"`
+	Large       *string  `json:"large"`
+	Blurhash    *string  `json:"blurhash"`
+	AspectRatio *float64 `json:"aspectRatio"`
+}
+
+type SplitMember struct {
+	HelperSplitMemberData
+	Dbid         persist.DBID `json:"dbid"`
+	CreationTime *time.Time   `json:"creationTime"`
+	User         *SplitFiUser `json:"user"`
+	Role         *SplitRole   `json:"role"`
+}
+
+type SplitOnchainStatus struct {
+	Status                *SplitOnchainSyncStatus `json:"status"`
+	CheckedAt             *time.Time              `json:"checkedAt"`
+	TotalOwnership        *int                    `json:"totalOwnership"`
+	OnchainTotalOwnership *int                    `json:"onchainTotalOwnership"`
+	Drift                 []*SplitRecipientDrift  `json:"drift"`
+}
+
 type SplitPositionInput struct {
 	SplitID  persist.DBID `json:"splitId"`
 	Position string       `json:"position"`
 }
 
-type SplitSearchResult struct {
-	Split *Split `json:"split"`
+// Sent to a split's followers and members when it receives funds. Transfers to the same split on the same day are
+// grouped into one notification, and count is the number of transfers it received.
+type SplitReceivedFundsNotification struct {
+	HelperSplitReceivedFundsNotificationData
+	Dbid         persist.DBID `json:"dbid"`
+	Seen         *bool        `json:"seen"`
+	CreationTime *time.Time   `json:"creationTime"`
+	UpdatedTime  *time.Time   `json:"updatedTime"`
+	Count        *int         `json:"count"`
+	Split        *Split       `json:"split"`
 }
 
+func (SplitReceivedFundsNotification) IsNotification()        {}
+func (SplitReceivedFundsNotification) IsNode()                {}
+func (SplitReceivedFundsNotification) IsGroupedNotification() {}
+
+type SplitRecipientDrift struct {
+	Address          *persist.Address `json:"address"`
+	Ownership        *int             `json:"ownership"`
+	OnchainOwnership *int             `json:"onchainOwnership"`
+}
+
+type SplitRevision struct {
+	HelperSplitRevisionData
+	Dbid           persist.DBID              `json:"dbid"`
+	Revision       int                       `json:"revision"`
+	CreationTime   *time.Time                `json:"creationTime"`
+	Source         *SplitRevisionSource      `json:"source"`
+	Actor          *SplitFiUser              `json:"actor"`
+	Name           *string                   `json:"name"`
+	Description    *string                   `json:"description"`
+	LogoURL        *string                   `json:"logoURL"`
+	BannerURL      *string                   `json:"bannerURL"`
+	BadgeURL       *string                   `json:"badgeURL"`
+	TotalOwnership *int                      `json:"totalOwnership"`
+	Recipients     []*SplitRevisionRecipient `json:"recipients"`
+}
+
+type SplitRevisionDiff struct {
+	FromRevision int                             `json:"fromRevision"`
+	ToRevision   int                             `json:"toRevision"`
+	Fields       []*SplitRevisionFieldChange     `json:"fields"`
+	Recipients   []*SplitRevisionRecipientChange `json:"recipients"`
+}
+
+type SplitRevisionEdge struct {
+	Node   *SplitRevision `json:"node"`
+	Cursor *string        `json:"cursor"`
+}
+
+type SplitRevisionFieldChange struct {
+	Field string  `json:"field"`
+	From  *string `json:"from"`
+	To    *string `json:"to"`
+}
+
+type SplitRevisionRecipient struct {
+	Address   *persist.Address `json:"address"`
+	Ownership *int             `json:"ownership"`
+}
+
+type SplitRevisionRecipientChange struct {
+	Address       persist.Address `json:"address"`
+	FromOwnership *int            `json:"fromOwnership"`
+	ToOwnership   *int            `json:"toOwnership"`
+}
+
+type SplitRevisionsConnection struct {
+	Edges    []*SplitRevisionEdge `json:"edges"`
+	PageInfo *PageInfo            `json:"pageInfo"`
+}
+
+type SplitSearchResult struct {
+	Split *Split `json:"split"`
+}
+
+type SplitShareEdge struct {
+	Node   *Recipient `json:"node"`
+	Cursor *string    `json:"cursor"`
+}
+
+// Sets a recipient's ownership of a split, in parts per million. Once every share is applied, each split's
+// recipients must add up to exactly its totalOwnership, and no recipient may have zero ownership.
 type SplitShareInput struct {
 	SplitID          persist.DBID    `json:"splitId"`
 	RecipientAddress persist.Address `json:"recipientAddress"`
 	Ownership        int             `json:"ownership"`
 }
 
+type SplitSharesConnection struct {
+	Edges    []*SplitShareEdge `json:"edges"`
+	PageInfo *PageInfo         `json:"pageInfo"`
+}
+
+type SplitTemplate struct {
+	Dbid           persist.DBID              `json:"dbid"`
+	Name           *string                   `json:"name"`
+	Description    *string                   `json:"description"`
+	LogoURL        *string                   `json:"logoURL"`
+	BannerURL      *string                   `json:"bannerURL"`
+	BadgeURL       *string                   `json:"badgeURL"`
+	TotalOwnership *int                      `json:"totalOwnership"`
+	Recipients     []*SplitTemplateRecipient `json:"recipients"`
+}
+
+type SplitTemplateRecipient struct {
+	Address   *persist.Address `json:"address"`
+	Ownership *int             `json:"ownership"`
+}
+
+type SplitTemplateRecipientInput struct {
+	Address persist.Address `json:"address"`
+	// In parts per million. Must be greater than zero.
+	Ownership int `json:"ownership"`
+}
+
+type SplitsConnection struct {
+	Edges    []*SplitEdge `json:"edges"`
+	PageInfo *PageInfo    `json:"pageInfo"`
+}
+
 type Token struct {
 	Dbid            persist.DBID   `json:"dbid"`
 	Version         *int           `json:"version"`
@@ -690,6 +1556,28 @@ type Token struct {
 
 func (Token) IsNode() {}
 
+type TokenAmount struct {
+	Chain        *persist.Chain   `json:"chain"`
+	TokenAddress *persist.Address `json:"tokenAddress"`
+	Amount       *string          `json:"amount"`
+}
+
+type TokenDistribution struct {
+	Chain         *persist.Chain         `json:"chain"`
+	TokenAddress  *persist.Address       `json:"tokenAddress"`
+	Balance       *string                `json:"balance"`
+	Distributed   *string                `json:"distributed"`
+	Undistributed *string                `json:"undistributed"`
+	Allocations   []*RecipientAllocation `json:"allocations"`
+}
+
+type UnfollowSplitPayload struct {
+	Viewer *Viewer `json:"viewer"`
+	Split  *Split  `json:"split"`
+}
+
+func (UnfollowSplitPayload) IsUnfollowSplitPayloadOrError() {}
+
 type UnregisterUserPushTokenPayload struct {
 	Viewer *Viewer `json:"viewer"`
 }
@@ -737,6 +1625,18 @@ type UpdatePrimaryWalletPayload struct {
 
 func (UpdatePrimaryWalletPayload) IsUpdatePrimaryWalletPayloadOrError() {}
 
+type UpdateSplitGroupInfoInput struct {
+	GroupID     persist.DBID `json:"groupId"`
+	Name        *string      `json:"name"`
+	Description *string      `json:"description"`
+}
+
+type UpdateSplitGroupInfoPayload struct {
+	Group *SplitGroup `json:"group"`
+}
+
+func (UpdateSplitGroupInfoPayload) IsUpdateSplitGroupInfoPayloadOrError() {}
+
 type UpdateSplitHiddenInput struct {
 	ID     persist.DBID `json:"id"`
 	Hidden bool         `json:"hidden"`
@@ -805,6 +1705,24 @@ type UpdateUserInfoPayload struct {
 
 func (UpdateUserInfoPayload) IsUpdateUserInfoPayloadOrError() {}
 
+type UpdateWebhookInput struct {
+	WebhookID  persist.DBID       `json:"webhookId"`
+	URL        *string            `json:"url"`
+	EventTypes []WebhookEventType `json:"eventTypes"`
+	Enabled    *bool              `json:"enabled"`
+}
+
+type UpdateWebhookPayload struct {
+	Webhook *Webhook `json:"webhook"`
+}
+
+func (UpdateWebhookPayload) IsUpdateWebhookPayloadOrError() {}
+
+type UploadHeader struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
 type UploadPersistedQueriesInput struct {
 	PersistedQueries *string `json:"persistedQueries"`
 }
@@ -870,13 +1788,31 @@ type Viewer struct {
 	Notifications        *NotificationsConnection `json:"notifications"`
 	NotificationSettings *NotificationSettings    `json:"notificationSettings"`
 	UserExperiences      []*UserExperience        `json:"userExperiences"`
+	// Returns the split ledger entries paid out to any of the viewer's wallets
+	Earnings       *SplitLedgerEntriesConnection `json:"earnings"`
+	SplitTemplates []*SplitTemplate              `json:"splitTemplates"`
+	// Returns the viewer's address book ordered by label. When query is set, only contacts whose label contains
+	// it or whose address starts with it are returned, with labels starting with it first.
+	Contacts []*Contact `json:"contacts"`
+	// Returns the viewer's address book as CSV, in the format accepted by importContacts
+	ExportContacts *string `json:"exportContacts"`
+	// Returns one of the viewer's ledger exports, or null if it doesn't exist
+	LedgerExport *LedgerExport `json:"ledgerExport"`
+	// Returns the splits that any of the viewer's wallets are recipients of, on any chain, oldest first
+	MemberSplits *MemberSplitsConnection `json:"memberSplits"`
+	// Returns the splits the viewer follows, in the order they were followed
+	FollowedSplits *SplitsConnection `json:"followedSplits"`
+	// Returns the viewer's webhooks in the order they were created
+	Webhooks []*Webhook `json:"webhooks"`
 }
 
 func (Viewer) IsNode()          {}
 func (Viewer) IsViewerOrError() {}
 
 type ViewerSplit struct {
-	Split *Split `json:"split"`
+	Split    *Split  `json:"split"`
+	Hidden   *bool   `json:"hidden"`
+	Position *string `json:"position"`
 }
 
 func (ViewerSplit) IsViewerSplitByIDPayloadOrError() {}
@@ -887,11 +1823,58 @@ type Wallet struct {
 	Chain        *persist.Chain        `json:"chain"`
 	WalletType   *persist.WalletType   `json:"walletType"`
 	Splits       []*Split              `json:"splits"`
+	// The splits this wallet's address is a recipient of
+	RecipientOf []*MemberSplit `json:"recipientOf"`
 }
 
 func (Wallet) IsNode()                {}
 func (Wallet) IsSplitFiUserOrWallet() {}
 
+// An endpoint that's sent a POST request for each event it's subscribed to. Requests are signed with the webhook's
+// secret: the X-SplitFi-Signature header is "t=<unix timestamp>,v1=<signature>", where the signature is the
+// hex-encoded HMAC-SHA256 of "<unix timestamp>.<request body>". Requests that aren't responded to with a 2xx
+// status are retried with exponential backoff.
+type Webhook struct {
+	HelperWebhookData
+	Dbid persist.DBID `json:"dbid"`
+	URL  *string      `json:"url"`
+	// The split the webhook is sent events about. Webhooks without a split are sent events about every split the
+	// viewer receives from or has a role on.
+	Split        *Split                       `json:"split"`
+	EventTypes   []WebhookEventType           `json:"eventTypes"`
+	Enabled      *bool                        `json:"enabled"`
+	CreationTime *time.Time                   `json:"creationTime"`
+	LastUpdated  *time.Time                   `json:"lastUpdated"`
+	Deliveries   *WebhookDeliveriesConnection `json:"deliveries"`
+}
+
+func (Webhook) IsNode() {}
+
+type WebhookDeliveriesConnection struct {
+	Edges    []*WebhookDeliveryEdge `json:"edges"`
+	PageInfo *PageInfo              `json:"pageInfo"`
+}
+
+type WebhookDelivery struct {
+	Dbid            persist.DBID           `json:"dbid"`
+	EventType       *WebhookEventType      `json:"eventType"`
+	Status          *WebhookDeliveryStatus `json:"status"`
+	Payload         *string                `json:"payload"`
+	Attempts        *int                   `json:"attempts"`
+	ResponseStatus  *int                   `json:"responseStatus"`
+	Error           *string                `json:"error"`
+	NextAttemptTime *time.Time             `json:"nextAttemptTime"`
+	CompletionTime  *time.Time             `json:"completionTime"`
+	CreationTime    *time.Time             `json:"creationTime"`
+}
+
+func (WebhookDelivery) IsNode() {}
+
+type WebhookDeliveryEdge struct {
+	Node   *WebhookDelivery `json:"node"`
+	Cursor *string          `json:"cursor"`
+}
+
 type EmailUnsubscriptionType string
 
 const (
@@ -933,6 +1916,90 @@ func (e EmailUnsubscriptionType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type LedgerExportFormat string
+
+const (
+	LedgerExportFormatCSV  LedgerExportFormat = "CSV"
+	LedgerExportFormatJSON LedgerExportFormat = "JSON"
+)
+
+var AllLedgerExportFormat = []LedgerExportFormat{
+	LedgerExportFormatCSV,
+	LedgerExportFormatJSON,
+}
+
+func (e LedgerExportFormat) IsValid() bool {
+	switch e {
+	case LedgerExportFormatCSV, LedgerExportFormatJSON:
+		return true
+	}
+	return false
+}
+
+func (e LedgerExportFormat) String() string {
+	return string(e)
+}
+
+func (e *LedgerExportFormat) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LedgerExportFormat(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LedgerExportFormat", str)
+	}
+	return nil
+}
+
+func (e LedgerExportFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type LedgerExportStatus string
+
+const (
+	LedgerExportStatusPending  LedgerExportStatus = "PENDING"
+	LedgerExportStatusComplete LedgerExportStatus = "COMPLETE"
+	LedgerExportStatusFailed   LedgerExportStatus = "FAILED"
+)
+
+var AllLedgerExportStatus = []LedgerExportStatus{
+	LedgerExportStatusPending,
+	LedgerExportStatusComplete,
+	LedgerExportStatusFailed,
+}
+
+func (e LedgerExportStatus) IsValid() bool {
+	switch e {
+	case LedgerExportStatusPending, LedgerExportStatusComplete, LedgerExportStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (e LedgerExportStatus) String() string {
+	return string(e)
+}
+
+func (e *LedgerExportStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LedgerExportStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LedgerExportStatus", str)
+	}
+	return nil
+}
+
+func (e LedgerExportStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type PreverifyEmailResult string
 
 const (
@@ -976,19 +2043,486 @@ func (e PreverifyEmailResult) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type RecipientInviteStatus string
+
+const (
+	RecipientInviteStatusPending  RecipientInviteStatus = "PENDING"
+	RecipientInviteStatusAccepted RecipientInviteStatus = "ACCEPTED"
+	RecipientInviteStatusRevoked  RecipientInviteStatus = "REVOKED"
+)
+
+var AllRecipientInviteStatus = []RecipientInviteStatus{
+	RecipientInviteStatusPending,
+	RecipientInviteStatusAccepted,
+	RecipientInviteStatusRevoked,
+}
+
+func (e RecipientInviteStatus) IsValid() bool {
+	switch e {
+	case RecipientInviteStatusPending, RecipientInviteStatusAccepted, RecipientInviteStatusRevoked:
+		return true
+	}
+	return false
+}
+
+func (e RecipientInviteStatus) String() string {
+	return string(e)
+}
+
+func (e *RecipientInviteStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RecipientInviteStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RecipientInviteStatus", str)
+	}
+	return nil
+}
+
+func (e RecipientInviteStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type SplitActivityType string
+
+const (
+	SplitActivityTypeInflow       SplitActivityType = "Inflow"
+	SplitActivityTypeDistribution SplitActivityType = "Distribution"
+	SplitActivityTypeWithdrawal   SplitActivityType = "Withdrawal"
+)
+
+var AllSplitActivityType = []SplitActivityType{
+	SplitActivityTypeInflow,
+	SplitActivityTypeDistribution,
+	SplitActivityTypeWithdrawal,
+}
+
+func (e SplitActivityType) IsValid() bool {
+	switch e {
+	case SplitActivityTypeInflow, SplitActivityTypeDistribution, SplitActivityTypeWithdrawal:
+		return true
+	}
+	return false
+}
+
+func (e SplitActivityType) String() string {
+	return string(e)
+}
+
+func (e *SplitActivityType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SplitActivityType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SplitActivityType", str)
+	}
+	return nil
+}
+
+func (e SplitActivityType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type SplitAssetSort string
+
+const (
+	// Highest USD value first. Tokens without a price are valued at zero and sorted by balance.
+	SplitAssetSortValue SplitAssetSort = "VALUE"
+	// Largest balance in the token's base units first
+	SplitAssetSortBalance SplitAssetSort = "BALANCE"
+)
+
+var AllSplitAssetSort = []SplitAssetSort{
+	SplitAssetSortValue,
+	SplitAssetSortBalance,
+}
+
+func (e SplitAssetSort) IsValid() bool {
+	switch e {
+	case SplitAssetSortValue, SplitAssetSortBalance:
+		return true
+	}
+	return false
+}
+
+func (e SplitAssetSort) String() string {
+	return string(e)
+}
+
+func (e *SplitAssetSort) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SplitAssetSort(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SplitAssetSort", str)
+	}
+	return nil
+}
+
+func (e SplitAssetSort) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type SplitCardFormat string
+
+const (
+	SplitCardFormatSVG SplitCardFormat = "SVG"
+	SplitCardFormatPng SplitCardFormat = "PNG"
+)
+
+var AllSplitCardFormat = []SplitCardFormat{
+	SplitCardFormatSVG,
+	SplitCardFormatPng,
+}
+
+func (e SplitCardFormat) IsValid() bool {
+	switch e {
+	case SplitCardFormatSVG, SplitCardFormatPng:
+		return true
+	}
+	return false
+}
+
+func (e SplitCardFormat) String() string {
+	return string(e)
+}
+
+func (e *SplitCardFormat) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SplitCardFormat(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SplitCardFormat", str)
+	}
+	return nil
+}
+
+func (e SplitCardFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type SplitCardSize string
+
+const (
+	SplitCardSizeSmall  SplitCardSize = "SMALL"
+	SplitCardSizeMedium SplitCardSize = "MEDIUM"
+	SplitCardSizeLarge  SplitCardSize = "LARGE"
+)
+
+var AllSplitCardSize = []SplitCardSize{
+	SplitCardSizeSmall,
+	SplitCardSizeMedium,
+	SplitCardSizeLarge,
+}
+
+func (e SplitCardSize) IsValid() bool {
+	switch e {
+	case SplitCardSizeSmall, SplitCardSizeMedium, SplitCardSizeLarge:
+		return true
+	}
+	return false
+}
+
+func (e SplitCardSize) String() string {
+	return string(e)
+}
+
+func (e *SplitCardSize) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SplitCardSize(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SplitCardSize", str)
+	}
+	return nil
+}
+
+func (e SplitCardSize) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type SplitCardTheme string
+
+const (
+	SplitCardThemeLight SplitCardTheme = "LIGHT"
+	SplitCardThemeDark  SplitCardTheme = "DARK"
+)
+
+var AllSplitCardTheme = []SplitCardTheme{
+	SplitCardThemeLight,
+	SplitCardThemeDark,
+}
+
+func (e SplitCardTheme) IsValid() bool {
+	switch e {
+	case SplitCardThemeLight, SplitCardThemeDark:
+		return true
+	}
+	return false
+}
+
+func (e SplitCardTheme) String() string {
+	return string(e)
+}
+
+func (e *SplitCardTheme) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SplitCardTheme(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SplitCardTheme", str)
+	}
+	return nil
+}
+
+func (e SplitCardTheme) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type SplitLedgerEntryType string
+
+const (
+	SplitLedgerEntryTypeDistribution SplitLedgerEntryType = "Distribution"
+	SplitLedgerEntryTypeWithdrawal   SplitLedgerEntryType = "Withdrawal"
+)
+
+var AllSplitLedgerEntryType = []SplitLedgerEntryType{
+	SplitLedgerEntryTypeDistribution,
+	SplitLedgerEntryTypeWithdrawal,
+}
+
+func (e SplitLedgerEntryType) IsValid() bool {
+	switch e {
+	case SplitLedgerEntryTypeDistribution, SplitLedgerEntryTypeWithdrawal:
+		return true
+	}
+	return false
+}
+
+func (e SplitLedgerEntryType) String() string {
+	return string(e)
+}
+
+func (e *SplitLedgerEntryType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SplitLedgerEntryType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SplitLedgerEntryType", str)
+	}
+	return nil
+}
+
+func (e SplitLedgerEntryType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type SplitMediaKind string
+
+const (
+	SplitMediaKindLogo   SplitMediaKind = "LOGO"
+	SplitMediaKindBanner SplitMediaKind = "BANNER"
+	SplitMediaKindBadge  SplitMediaKind = "BADGE"
+)
+
+var AllSplitMediaKind = []SplitMediaKind{
+	SplitMediaKindLogo,
+	SplitMediaKindBanner,
+	SplitMediaKindBadge,
+}
+
+func (e SplitMediaKind) IsValid() bool {
+	switch e {
+	case SplitMediaKindLogo, SplitMediaKindBanner, SplitMediaKindBadge:
+		return true
+	}
+	return false
+}
+
+func (e SplitMediaKind) String() string {
+	return string(e)
+}
+
+func (e *SplitMediaKind) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SplitMediaKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SplitMediaKind", str)
+	}
+	return nil
+}
+
+func (e SplitMediaKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type SplitOnchainSyncStatus string
+
+const (
+	SplitOnchainSyncStatusInSync  SplitOnchainSyncStatus = "InSync"
+	SplitOnchainSyncStatusDrifted SplitOnchainSyncStatus = "Drifted"
+)
+
+var AllSplitOnchainSyncStatus = []SplitOnchainSyncStatus{
+	SplitOnchainSyncStatusInSync,
+	SplitOnchainSyncStatusDrifted,
+}
+
+func (e SplitOnchainSyncStatus) IsValid() bool {
+	switch e {
+	case SplitOnchainSyncStatusInSync, SplitOnchainSyncStatusDrifted:
+		return true
+	}
+	return false
+}
+
+func (e SplitOnchainSyncStatus) String() string {
+	return string(e)
+}
+
+func (e *SplitOnchainSyncStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SplitOnchainSyncStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SplitOnchainSyncStatus", str)
+	}
+	return nil
+}
+
+func (e SplitOnchainSyncStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type SplitRevisionSource string
+
+const (
+	SplitRevisionSourceUI      SplitRevisionSource = "UI"
+	SplitRevisionSourceWebhook SplitRevisionSource = "Webhook"
+	SplitRevisionSourceAdmin   SplitRevisionSource = "Admin"
+)
+
+var AllSplitRevisionSource = []SplitRevisionSource{
+	SplitRevisionSourceUI,
+	SplitRevisionSourceWebhook,
+	SplitRevisionSourceAdmin,
+}
+
+func (e SplitRevisionSource) IsValid() bool {
+	switch e {
+	case SplitRevisionSourceUI, SplitRevisionSourceWebhook, SplitRevisionSourceAdmin:
+		return true
+	}
+	return false
+}
+
+func (e SplitRevisionSource) String() string {
+	return string(e)
+}
+
+func (e *SplitRevisionSource) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SplitRevisionSource(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SplitRevisionSource", str)
+	}
+	return nil
+}
+
+func (e SplitRevisionSource) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type SplitRole string
+
+const (
+	SplitRoleViewer     SplitRole = "VIEWER"
+	SplitRoleEditor     SplitRole = "EDITOR"
+	SplitRoleController SplitRole = "CONTROLLER"
+)
+
+var AllSplitRole = []SplitRole{
+	SplitRoleViewer,
+	SplitRoleEditor,
+	SplitRoleController,
+}
+
+func (e SplitRole) IsValid() bool {
+	switch e {
+	case SplitRoleViewer, SplitRoleEditor, SplitRoleController:
+		return true
+	}
+	return false
+}
+
+func (e SplitRole) String() string {
+	return string(e)
+}
+
+func (e *SplitRole) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SplitRole(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SplitRole", str)
+	}
+	return nil
+}
+
+func (e SplitRole) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type TokenType string
 
 const (
-	TokenTypeErc20 TokenType = "ERC20"
+	TokenTypeErc20  TokenType = "ERC20"
+	TokenTypeNative TokenType = "NATIVE"
 )
 
 var AllTokenType = []TokenType{
 	TokenTypeErc20,
+	TokenTypeNative,
 }
 
 func (e TokenType) IsValid() bool {
 	switch e {
-	case TokenTypeErc20:
+	case TokenTypeErc20, TokenTypeNative:
 		return true
 	}
 	return false
@@ -1059,3 +2593,93 @@ func (e *UserExperienceType) UnmarshalGQL(v interface{}) error {
 func (e UserExperienceType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "SUCCEEDED"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "FAILED"
+)
+
+var AllWebhookDeliveryStatus = []WebhookDeliveryStatus{
+	WebhookDeliveryStatusPending,
+	WebhookDeliveryStatusSucceeded,
+	WebhookDeliveryStatusFailed,
+}
+
+func (e WebhookDeliveryStatus) IsValid() bool {
+	switch e {
+	case WebhookDeliveryStatusPending, WebhookDeliveryStatusSucceeded, WebhookDeliveryStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (e WebhookDeliveryStatus) String() string {
+	return string(e)
+}
+
+func (e *WebhookDeliveryStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WebhookDeliveryStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WebhookDeliveryStatus", str)
+	}
+	return nil
+}
+
+func (e WebhookDeliveryStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type WebhookEventType string
+
+const (
+	WebhookEventTypeInflow             WebhookEventType = "INFLOW"
+	WebhookEventTypeDistribution       WebhookEventType = "DISTRIBUTION"
+	WebhookEventTypeRecipientsChanged  WebhookEventType = "RECIPIENTS_CHANGED"
+	WebhookEventTypeControllersChanged WebhookEventType = "CONTROLLERS_CHANGED"
+	WebhookEventTypePing               WebhookEventType = "PING"
+)
+
+var AllWebhookEventType = []WebhookEventType{
+	WebhookEventTypeInflow,
+	WebhookEventTypeDistribution,
+	WebhookEventTypeRecipientsChanged,
+	WebhookEventTypeControllersChanged,
+	WebhookEventTypePing,
+}
+
+func (e WebhookEventType) IsValid() bool {
+	switch e {
+	case WebhookEventTypeInflow, WebhookEventTypeDistribution, WebhookEventTypeRecipientsChanged, WebhookEventTypeControllersChanged, WebhookEventTypePing:
+		return true
+	}
+	return false
+}
+
+func (e WebhookEventType) String() string {
+	return string(e)
+}
+
+func (e *WebhookEventType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WebhookEventType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WebhookEventType", str)
+	}
+	return nil
+}
+
+func (e WebhookEventType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
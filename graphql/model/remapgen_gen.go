@@ -3,11 +3,21 @@
 package model
 
 var typeConversionMap = map[string]func(object interface{}) (objectAsType interface{}, ok bool){
+	"AcceptRecipientInvitePayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(AcceptRecipientInvitePayloadOrError)
+		return obj, ok
+	},
+
 	"AddRolesToUserPayloadOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(AddRolesToUserPayloadOrError)
 		return obj, ok
 	},
 
+	"AddSplitToGroupPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(AddSplitToGroupPayloadOrError)
+		return obj, ok
+	},
+
 	"AddUserWalletPayloadOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(AddUserWalletPayloadOrError)
 		return obj, ok
@@ -23,31 +33,101 @@ var typeConversionMap = map[string]func(object interface{}) (objectAsType interf
 		return obj, ok
 	},
 
+	"CompleteSplitMediaUploadPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(CompleteSplitMediaUploadPayloadOrError)
+		return obj, ok
+	},
+
+	"CreateSplitGroupPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(CreateSplitGroupPayloadOrError)
+		return obj, ok
+	},
+
+	"CreateSplitMediaUploadPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(CreateSplitMediaUploadPayloadOrError)
+		return obj, ok
+	},
+
 	"CreateSplitPayloadOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(CreateSplitPayloadOrError)
 		return obj, ok
 	},
 
+	"CreateSplitTemplatePayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(CreateSplitTemplatePayloadOrError)
+		return obj, ok
+	},
+
 	"CreateUserPayloadOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(CreateUserPayloadOrError)
 		return obj, ok
 	},
 
+	"CreateWebhookPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(CreateWebhookPayloadOrError)
+		return obj, ok
+	},
+
+	"DeleteContactPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(DeleteContactPayloadOrError)
+		return obj, ok
+	},
+
 	"DeleteSplitPayloadOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(DeleteSplitPayloadOrError)
 		return obj, ok
 	},
 
+	"DeleteSplitTemplatePayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(DeleteSplitTemplatePayloadOrError)
+		return obj, ok
+	},
+
+	"DeleteWebhookPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(DeleteWebhookPayloadOrError)
+		return obj, ok
+	},
+
 	"Error": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(Error)
 		return obj, ok
 	},
 
+	"ExportLedgerPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(ExportLedgerPayloadOrError)
+		return obj, ok
+	},
+
+	"FollowSplitPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(FollowSplitPayloadOrError)
+		return obj, ok
+	},
+
 	"GetAuthNoncePayloadOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(GetAuthNoncePayloadOrError)
 		return obj, ok
 	},
 
+	"GrantSplitRolePayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(GrantSplitRolePayloadOrError)
+		return obj, ok
+	},
+
+	"GroupedNotification": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(GroupedNotification)
+		return obj, ok
+	},
+
+	"ImportContactsPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(ImportContactsPayloadOrError)
+		return obj, ok
+	},
+
+	"InviteRecipientPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(InviteRecipientPayloadOrError)
+		return obj, ok
+	},
+
 	"LoginPayloadOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(LoginPayloadOrError)
 		return obj, ok
@@ -73,6 +153,11 @@ var typeConversionMap = map[string]func(object interface{}) (objectAsType interf
 		return obj, ok
 	},
 
+	"PingWebhookPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(PingWebhookPayloadOrError)
+		return obj, ok
+	},
+
 	"PreverifyEmailPayloadOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(PreverifyEmailPayloadOrError)
 		return obj, ok
@@ -88,6 +173,11 @@ var typeConversionMap = map[string]func(object interface{}) (objectAsType interf
 		return obj, ok
 	},
 
+	"RemoveSplitFromGroupPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(RemoveSplitFromGroupPayloadOrError)
+		return obj, ok
+	},
+
 	"RemoveUserWalletsPayloadOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(RemoveUserWalletsPayloadOrError)
 		return obj, ok
@@ -98,11 +188,31 @@ var typeConversionMap = map[string]func(object interface{}) (objectAsType interf
 		return obj, ok
 	},
 
+	"ResyncSplitFromChainPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(ResyncSplitFromChainPayloadOrError)
+		return obj, ok
+	},
+
+	"RevokeRecipientInvitePayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(RevokeRecipientInvitePayloadOrError)
+		return obj, ok
+	},
+
 	"RevokeRolesFromUserPayloadOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(RevokeRolesFromUserPayloadOrError)
 		return obj, ok
 	},
 
+	"RevokeSplitRolePayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(RevokeSplitRolePayloadOrError)
+		return obj, ok
+	},
+
+	"SaveContactPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(SaveContactPayloadOrError)
+		return obj, ok
+	},
+
 	"SearchSplitsPayloadOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(SearchSplitsPayloadOrError)
 		return obj, ok
@@ -113,6 +223,16 @@ var typeConversionMap = map[string]func(object interface{}) (objectAsType interf
 		return obj, ok
 	},
 
+	"SetTokenPricePayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(SetTokenPricePayloadOrError)
+		return obj, ok
+	},
+
+	"SimulateSplitChangePayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(SimulateSplitChangePayloadOrError)
+		return obj, ok
+	},
+
 	"SplitByIdPayloadOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(SplitByIDPayloadOrError)
 		return obj, ok
@@ -128,6 +248,16 @@ var typeConversionMap = map[string]func(object interface{}) (objectAsType interf
 		return obj, ok
 	},
 
+	"SplitGroupByIdPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(SplitGroupByIDPayloadOrError)
+		return obj, ok
+	},
+
+	"UnfollowSplitPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(UnfollowSplitPayloadOrError)
+		return obj, ok
+	},
+
 	"UnregisterUserPushTokenPayloadOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(UnregisterUserPushTokenPayloadOrError)
 		return obj, ok
@@ -153,6 +283,11 @@ var typeConversionMap = map[string]func(object interface{}) (objectAsType interf
 		return obj, ok
 	},
 
+	"UpdateSplitGroupInfoPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(UpdateSplitGroupInfoPayloadOrError)
+		return obj, ok
+	},
+
 	"UpdateSplitHiddenPayloadOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(UpdateSplitHiddenPayloadOrError)
 		return obj, ok
@@ -183,6 +318,11 @@ var typeConversionMap = map[string]func(object interface{}) (objectAsType interf
 		return obj, ok
 	},
 
+	"UpdateWebhookPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(UpdateWebhookPayloadOrError)
+		return obj, ok
+	},
+
 	"UploadPersistedQueriesPayloadOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(UploadPersistedQueriesPayloadOrError)
 		return obj, ok
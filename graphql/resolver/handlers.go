@@ -18,6 +18,7 @@ import (
 	"github.com/SplitFi/go-splitfi/graphql/model"
 	"github.com/SplitFi/go-splitfi/service/auth"
 	"github.com/SplitFi/go-splitfi/service/logger"
+	"github.com/SplitFi/go-splitfi/service/persist"
 	sentryutil "github.com/SplitFi/go-splitfi/service/sentry"
 	"github.com/SplitFi/go-splitfi/service/tracing"
 	"github.com/getsentry/sentry-go"
@@ -210,21 +211,12 @@ func AuthRequiredDirectiveHandler() func(ctx context.Context, obj interface{}, n
 		}
 
 		if authError := auth.GetAuthErrorFromCtx(gc); authError != nil {
-			var gqlModel model.AuthorizationError
-			errorMsg := authError.Error()
-
-			switch authError {
-			case auth.ErrNoCookie:
-				gqlModel = model.ErrNoCookie{Message: errorMsg}
-			case auth.ErrInvalidJWT:
-				gqlModel = model.ErrInvalidToken{Message: errorMsg}
-			case auth.ErrSessionInvalidated:
-				gqlModel = model.ErrSessionInvalidated{Message: errorMsg}
-			default:
+			gqlModel, ok := authErrorToModel(authError)
+			if !ok {
 				return nil, authError
 			}
 
-			return makeErrNotAuthorized(errorMsg, gqlModel), nil
+			return makeErrNotAuthorized(authError.Error(), gqlModel), nil
 		}
 
 		userID := auth.GetUserIDFromCtx(gc)
@@ -236,6 +228,144 @@ func AuthRequiredDirectiveHandler() func(ctx context.Context, obj interface{}, n
 	}
 }
 
+// authErrorToModel converts an authentication error to its GraphQL type, if it has one
+func authErrorToModel(authError error) (model.AuthorizationError, bool) {
+	errorMsg := authError.Error()
+
+	switch authError {
+	case auth.ErrNoCookie:
+		return model.ErrNoCookie{Message: errorMsg}, true
+	case auth.ErrInvalidJWT:
+		return model.ErrInvalidToken{Message: errorMsg}, true
+	case auth.ErrSessionInvalidated:
+		return model.ErrSessionInvalidated{Message: errorMsg}, true
+	}
+
+	return nil, false
+}
+
+func SplitRoleDirectiveHandler() func(ctx context.Context, obj interface{}, next gqlgen.Resolver, min model.SplitRole, arg *string) (res interface{}, err error) {
+	return splitRoleDirectiveHandler(func(ctx context.Context, splitID persist.DBID) (persist.SplitRole, error) {
+		return publicapi.For(ctx).Split.GetViewerSplitRole(ctx, splitID)
+	})
+}
+
+// splitRoleDirectiveHandler enforces @splitRole, using getRole to find the viewer's role on the field's split
+func splitRoleDirectiveHandler(getRole func(context.Context, persist.DBID) (persist.SplitRole, error)) func(ctx context.Context, obj interface{}, next gqlgen.Resolver, min model.SplitRole, arg *string) (res interface{}, err error) {
+
+	return func(ctx context.Context, obj interface{}, next gqlgen.Resolver, min model.SplitRole, arg *string) (res interface{}, err error) {
+		gc := util.MustGetGinContext(ctx)
+		fc := gqlgen.GetFieldContext(ctx)
+
+		// Only payload unions can return ErrNotAuthorized, so other fields resolve to an error instead
+		returnsUnion := strings.HasSuffix(fc.Field.Definition.Type.Name(), "OrError")
+
+		notAuthorized := func(e string, c model.AuthorizationError) (interface{}, error) {
+			message := fmt.Sprintf("authorization failed: %s", e)
+			if returnsUnion {
+				return model.ErrNotAuthorized{Message: message, Cause: c}, nil
+			}
+			return nil, errors.New(message)
+		}
+
+		if authError := auth.GetAuthErrorFromCtx(gc); authError != nil {
+			gqlModel, ok := authErrorToModel(authError)
+			if !ok {
+				return nil, authError
+			}
+
+			return notAuthorized(authError.Error(), gqlModel)
+		}
+
+		splitID, err := splitIDForDirective(fc, obj, arg)
+		if err != nil {
+			return nil, err
+		}
+
+		role, err := getRole(ctx, splitID)
+		if err != nil {
+			return nil, err
+		}
+
+		required := persist.SplitRole(strings.ToLower(string(min)))
+		if !role.AtLeast(required) {
+			roleErr := persist.ErrSplitRoleRequired{SplitID: splitID, Role: required}
+			return notAuthorized(roleErr.Error(), model.ErrSplitRoleRequired{Message: roleErr.Error(), Role: min})
+		}
+
+		return next(ctx)
+	}
+}
+
+// splitIDForDirective finds the split a @splitRole field refers to. Fields on Split use their parent split unless
+// arg is set. Otherwise arg is a dot-separated path into the field's arguments, looked up at the top level and then
+// inside the input argument.
+func splitIDForDirective(fc *gqlgen.FieldContext, obj interface{}, arg *string) (persist.DBID, error) {
+	if split, ok := obj.(*model.Split); ok && arg == nil {
+		return split.Dbid, nil
+	}
+
+	path := "splitId"
+	if arg != nil {
+		path = *arg
+	}
+
+	value, ok := lookupFieldArg(fc.Args, strings.Split(path, "."))
+	if !ok {
+		value, ok = lookupFieldArg(fc.Args, append([]string{"input"}, strings.Split(path, ".")...))
+	}
+	if !ok {
+		return "", fmt.Errorf("@splitRole on %s: no split ID found at argument %q", fc.Field.Name, path)
+	}
+
+	switch id := value.(type) {
+	case persist.DBID:
+		return id, nil
+	case *persist.DBID:
+		if id != nil {
+			return *id, nil
+		}
+	}
+
+	return "", fmt.Errorf("@splitRole on %s: argument %q is not a DBID", fc.Field.Name, path)
+}
+
+// lookupFieldArg follows path through a field's arguments, matching struct fields by their json tag
+func lookupFieldArg(args map[string]interface{}, path []string) (interface{}, bool) {
+	value, ok := args[path[0]]
+	if !ok {
+		return nil, false
+	}
+
+	for _, name := range path[1:] {
+		v := reflect.ValueOf(value)
+		for v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return nil, false
+			}
+			v = v.Elem()
+		}
+		if v.Kind() != reflect.Struct {
+			return nil, false
+		}
+
+		found := false
+		for i := 0; i < v.NumField(); i++ {
+			tag := strings.Split(v.Type().Field(i).Tag.Get("json"), ",")[0]
+			if tag == name {
+				value = v.Field(i).Interface()
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, false
+		}
+	}
+
+	return value, true
+}
+
 func RestrictEnvironmentDirectiveHandler() func(ctx context.Context, obj interface{}, next gqlgen.Resolver, allowed []string) (res interface{}, err error) {
 
 	restrictionErr := errors.New("schema restriction: functionality not allowed in the current environment")
@@ -0,0 +1,204 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	gqlgen "github.com/99designs/gqlgen/graphql"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/SplitFi/go-splitfi/graphql/model"
+	"github.com/SplitFi/go-splitfi/service/auth"
+	"github.com/SplitFi/go-splitfi/service/persist"
+	"github.com/SplitFi/go-splitfi/util"
+)
+
+func TestSplitRoleAtLeast(t *testing.T) {
+	roles := []persist.SplitRole{persist.SplitRoleViewer, persist.SplitRoleEditor, persist.SplitRoleController}
+
+	for i, role := range roles {
+		for j, min := range roles {
+			assert.Equal(t, i >= j, role.AtLeast(min), "%s at least %s", role, min)
+		}
+	}
+
+	// Users without a role, or with one that isn't known, have no access
+	assert.False(t, persist.SplitRole("").AtLeast(persist.SplitRoleViewer))
+	assert.False(t, persist.SplitRole("owner").AtLeast(persist.SplitRoleViewer))
+}
+
+func TestSplitIDForDirective(t *testing.T) {
+	splitID := persist.GenerateID()
+	otherID := persist.GenerateID()
+
+	t.Run("fields on a split use the parent split", func(t *testing.T) {
+		fc := directiveFieldContext("members", "SplitMember", map[string]interface{}{"splitId": otherID})
+		id, err := splitIDForDirective(fc, &model.Split{Dbid: splitID}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, splitID, id)
+	})
+
+	t.Run("an explicit arg takes precedence over the parent split", func(t *testing.T) {
+		fc := directiveFieldContext("members", "SplitMember", map[string]interface{}{"splitId": otherID})
+		id, err := splitIDForDirective(fc, &model.Split{Dbid: splitID}, util.ToPointer("splitId"))
+		require.NoError(t, err)
+		assert.Equal(t, otherID, id)
+	})
+
+	t.Run("defaults to a top-level splitId argument", func(t *testing.T) {
+		fc := directiveFieldContext("splitAnalytics", "SplitAnalyticsOrError", map[string]interface{}{"splitId": splitID})
+		id, err := splitIDForDirective(fc, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, splitID, id)
+	})
+
+	t.Run("falls back to the same path under input", func(t *testing.T) {
+		fc := directiveFieldContext("grantSplitRole", "GrantSplitRolePayloadOrError", map[string]interface{}{
+			"input": model.GrantSplitRoleInput{SplitID: splitID, UserID: otherID},
+		})
+		id, err := splitIDForDirective(fc, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, splitID, id)
+	})
+
+	t.Run("follows a nested arg path through pointers", func(t *testing.T) {
+		fc := directiveFieldContext("updateSplitInfo", "UpdateSplitInfoPayloadOrError", map[string]interface{}{
+			"input": &model.UpdateSplitInfoInput{ID: splitID},
+		})
+		id, err := splitIDForDirective(fc, nil, util.ToPointer("input.id"))
+		require.NoError(t, err)
+		assert.Equal(t, splitID, id)
+	})
+
+	t.Run("accepts optional IDs", func(t *testing.T) {
+		fc := directiveFieldContext("split", "Split", map[string]interface{}{"splitId": &splitID})
+		id, err := splitIDForDirective(fc, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, splitID, id)
+	})
+
+	t.Run("errors when no split ID is found", func(t *testing.T) {
+		for _, args := range []map[string]interface{}{
+			{},
+			{"input": (*model.UpdateSplitInfoInput)(nil)},
+			{"splitId": (*persist.DBID)(nil)},
+			{"splitId": "not a DBID"},
+		} {
+			fc := directiveFieldContext("updateSplitInfo", "UpdateSplitInfoPayloadOrError", args)
+			_, err := splitIDForDirective(fc, nil, nil)
+			assert.Error(t, err, "%v", args)
+		}
+	})
+}
+
+func TestLookupFieldArg(t *testing.T) {
+	splitID := persist.GenerateID()
+	args := map[string]interface{}{
+		"input": &model.UpdateSplitInfoInput{ID: splitID, Name: util.ToPointer("name")},
+	}
+
+	value, ok := lookupFieldArg(args, []string{"input", "id"})
+	require.True(t, ok)
+	assert.Equal(t, splitID, value)
+
+	value, ok = lookupFieldArg(args, []string{"input", "name"})
+	require.True(t, ok)
+	assert.Equal(t, "name", *value.(*string))
+
+	_, ok = lookupFieldArg(args, []string{"input", "ID"})
+	assert.False(t, ok, "fields are matched by their json tag")
+	_, ok = lookupFieldArg(args, []string{"input", "id", "more"})
+	assert.False(t, ok)
+	_, ok = lookupFieldArg(args, []string{"other"})
+	assert.False(t, ok)
+}
+
+func TestSplitRoleDirectiveHandler(t *testing.T) {
+	splitID := persist.GenerateID()
+	args := map[string]interface{}{"input": model.GrantSplitRoleInput{SplitID: splitID}}
+
+	next := func(ctx context.Context) (interface{}, error) {
+		return "resolved", nil
+	}
+
+	withRole := func(role persist.SplitRole) func(context.Context, persist.DBID) (persist.SplitRole, error) {
+		return func(ctx context.Context, id persist.DBID) (persist.SplitRole, error) {
+			assert.Equal(t, splitID, id)
+			return role, nil
+		}
+	}
+
+	t.Run("resolves the field when the viewer has the role", func(t *testing.T) {
+		for _, role := range []persist.SplitRole{persist.SplitRoleEditor, persist.SplitRoleController} {
+			ctx := directiveContext(nil, directiveFieldContext("updateSplit", "UpdateSplitPayloadOrError", args))
+			res, err := splitRoleDirectiveHandler(withRole(role))(ctx, nil, next, model.SplitRoleEditor, nil)
+			require.NoError(t, err)
+			assert.Equal(t, "resolved", res)
+		}
+	})
+
+	t.Run("unions return ErrNotAuthorized when the viewer's role is too low", func(t *testing.T) {
+		for _, role := range []persist.SplitRole{"", persist.SplitRoleViewer, persist.SplitRoleEditor} {
+			ctx := directiveContext(nil, directiveFieldContext("grantSplitRole", "GrantSplitRolePayloadOrError", args))
+			res, err := splitRoleDirectiveHandler(withRole(role))(ctx, nil, next, model.SplitRoleController, nil)
+			require.NoError(t, err)
+
+			notAuthorized, ok := res.(model.ErrNotAuthorized)
+			require.True(t, ok, "got %T", res)
+			cause, ok := notAuthorized.Cause.(model.ErrSplitRoleRequired)
+			require.True(t, ok, "got %T", notAuthorized.Cause)
+			assert.Equal(t, model.SplitRoleController, cause.Role)
+		}
+	})
+
+	t.Run("unions return ErrNotAuthorized when the viewer isn't logged in", func(t *testing.T) {
+		ctx := directiveContext(auth.ErrNoCookie, directiveFieldContext("grantSplitRole", "GrantSplitRolePayloadOrError", args))
+		res, err := splitRoleDirectiveHandler(withRole(persist.SplitRoleController))(ctx, nil, next, model.SplitRoleController, nil)
+		require.NoError(t, err)
+
+		notAuthorized, ok := res.(model.ErrNotAuthorized)
+		require.True(t, ok, "got %T", res)
+		assert.IsType(t, model.ErrNoCookie{}, notAuthorized.Cause)
+	})
+
+	t.Run("other fields return an error when the viewer's role is too low", func(t *testing.T) {
+		fc := directiveFieldContext("members", "SplitMember", nil)
+		res, err := splitRoleDirectiveHandler(withRole(persist.SplitRoleEditor))(directiveContext(nil, fc), &model.Split{Dbid: splitID}, next, model.SplitRoleController, nil)
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("errors looking up the role are returned", func(t *testing.T) {
+		lookupErr := errors.New("lookup failed")
+		getRole := func(context.Context, persist.DBID) (persist.SplitRole, error) { return "", lookupErr }
+
+		ctx := directiveContext(nil, directiveFieldContext("grantSplitRole", "GrantSplitRolePayloadOrError", args))
+		_, err := splitRoleDirectiveHandler(getRole)(ctx, nil, next, model.SplitRoleController, nil)
+		assert.ErrorIs(t, err, lookupErr)
+	})
+}
+
+func directiveFieldContext(name, returnType string, args map[string]interface{}) *gqlgen.FieldContext {
+	return &gqlgen.FieldContext{
+		Field: gqlgen.CollectedField{Field: &ast.Field{
+			Name:       name,
+			Definition: &ast.FieldDefinition{Name: name, Type: ast.NamedType(returnType, nil)},
+		}},
+		Args: args,
+	}
+}
+
+// directiveContext returns a context for fc as the auth middleware would leave it, failing with authErr if set
+func directiveContext(authErr error, fc *gqlgen.FieldContext) context.Context {
+	gc, _ := gin.CreateTestContext(httptest.NewRecorder())
+	gc.Set("auth.user_id", persist.GenerateID())
+	gc.Set("auth.auth_error", authErr)
+
+	ctx := context.WithValue(context.Background(), util.GinContextKey, gc)
+	return gqlgen.WithFieldContext(ctx, fc)
+}
@@ -19,7 +19,32 @@ import (
 
 // Token is the resolver for the token field.
 func (r *assetResolver) Token(ctx context.Context, obj *model.Asset) (*model.Token, error) {
-	panic(fmt.Errorf("not implemented: Token - token"))
+	return obj.Token, nil
+}
+
+// DownloadURL is the resolver for the downloadURL field.
+func (r *ledgerExportResolver) DownloadURL(ctx context.Context, obj *model.LedgerExport) (*string, error) {
+	return publicapi.For(ctx).Export.GetLedgerExportDownloadURL(ctx, obj.Dbid)
+}
+
+// Claimable is the resolver for the claimable field.
+func (r *memberSplitResolver) Claimable(ctx context.Context, obj *model.MemberSplit) ([]*model.ClaimableAmount, error) {
+	claims, err := publicapi.For(ctx).Split.GetMemberSplitClaimable(ctx, obj.HelperMemberSplitData.SplitID, obj.HelperMemberSplitData.Addresses)
+	if err != nil {
+		return nil, err
+	}
+
+	return claimsToModels(claims), nil
+}
+
+// LifetimeEarnings is the resolver for the lifetimeEarnings field.
+func (r *memberSplitResolver) LifetimeEarnings(ctx context.Context, obj *model.MemberSplit) ([]*model.TokenAmount, error) {
+	earnings, err := publicapi.For(ctx).Split.GetMemberSplitEarnings(ctx, obj.HelperMemberSplitData.SplitID, obj.HelperMemberSplitData.Addresses)
+	if err != nil {
+		return nil, err
+	}
+
+	return tokenAmountsToModels(earnings), nil
 }
 
 // AddUserWallet is the resolver for the addUserWallet field.
@@ -209,7 +234,20 @@ func (r *mutationResolver) Logout(ctx context.Context, pushTokenToUnregister *st
 
 // UpdateSplit is the resolver for the updateSplit field.
 func (r *mutationResolver) UpdateSplit(ctx context.Context, input model.UpdateSplitInput) (model.UpdateSplitPayloadOrError, error) {
-	panic(fmt.Errorf("not implemented: UpdateSplit - updateSplit"))
+	_, err := publicapi.For(ctx).Split.UpdateSplit(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	// Edits aren't visible until they're published, so return the split as it currently is
+	split, err := resolveSplitBySplitID(ctx, input.SplitID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.UpdateSplitPayload{
+		Split: split,
+	}, nil
 }
 
 // PublishSplit is the resolver for the publishSplit field.
@@ -243,24 +281,384 @@ func (r *mutationResolver) CreateSplit(ctx context.Context, input model.CreateSp
 	return output, nil
 }
 
+// CreateSplitFromTemplate is the resolver for the createSplitFromTemplate field.
+func (r *mutationResolver) CreateSplitFromTemplate(ctx context.Context, templateID persist.DBID, chain persist.Chain) (model.CreateSplitPayloadOrError, error) {
+	split, err := publicapi.For(ctx).Split.CreateSplitFromTemplate(ctx, templateID, chain)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.CreateSplitPayload{
+		Split: splitToModel(ctx, split),
+	}, nil
+}
+
+// CloneSplit is the resolver for the cloneSplit field.
+func (r *mutationResolver) CloneSplit(ctx context.Context, splitID persist.DBID, chain persist.Chain) (model.CreateSplitPayloadOrError, error) {
+	split, err := publicapi.For(ctx).Split.CloneSplit(ctx, splitID, chain)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.CreateSplitPayload{
+		Split: splitToModel(ctx, split),
+	}, nil
+}
+
+// CreateSplitTemplate is the resolver for the createSplitTemplate field.
+func (r *mutationResolver) CreateSplitTemplate(ctx context.Context, input model.CreateSplitTemplateInput) (model.CreateSplitTemplatePayloadOrError, error) {
+	template, err := publicapi.For(ctx).Split.CreateSplitTemplate(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.CreateSplitTemplatePayload{
+		Template: splitTemplateToModel(*template),
+	}, nil
+}
+
+// DeleteSplitTemplate is the resolver for the deleteSplitTemplate field.
+func (r *mutationResolver) DeleteSplitTemplate(ctx context.Context, templateID persist.DBID) (model.DeleteSplitTemplatePayloadOrError, error) {
+	err := publicapi.For(ctx).Split.DeleteSplitTemplate(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.DeleteSplitTemplatePayload{
+		Viewer: resolveViewer(ctx),
+	}, nil
+}
+
 // UpdateSplitHidden is the resolver for the updateSplitHidden field.
 func (r *mutationResolver) UpdateSplitHidden(ctx context.Context, input model.UpdateSplitHiddenInput) (model.UpdateSplitHiddenPayloadOrError, error) {
-	panic(fmt.Errorf("not implemented: UpdateSplitHidden - updateSplitHidden"))
+	split, err := publicapi.For(ctx).Split.UpdateSplitHidden(ctx, input.ID, input.Hidden)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.UpdateSplitHiddenPayload{
+		Split: splitToModel(ctx, split),
+	}, nil
 }
 
 // DeleteSplit is the resolver for the deleteSplit field.
 func (r *mutationResolver) DeleteSplit(ctx context.Context, splitID persist.DBID) (model.DeleteSplitPayloadOrError, error) {
-	panic(fmt.Errorf("not implemented: DeleteSplit - deleteSplit"))
+	request, err := publicapi.For(ctx).Split.DeleteSplit(ctx, splitID)
+	if err != nil {
+		return nil, err
+	}
+
+	if request != nil {
+		return &model.DeleteSplitPayload{
+			PendingDeletion: splitDeletionRequestToModel(*request),
+		}, nil
+	}
+
+	return &model.DeleteSplitPayload{
+		DeletedID: &model.DeletedNode{Dbid: splitID},
+	}, nil
 }
 
 // UpdateSplitOrder is the resolver for the updateSplitOrder field.
 func (r *mutationResolver) UpdateSplitOrder(ctx context.Context, input model.UpdateSplitOrderInput) (model.UpdateSplitOrderPayloadOrError, error) {
-	panic(fmt.Errorf("not implemented: UpdateSplitOrder - updateSplitOrder"))
+	err := publicapi.For(ctx).Split.UpdateSplitOrder(ctx, input.Positions)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.UpdateSplitOrderPayload{
+		Viewer: resolveViewer(ctx),
+	}, nil
 }
 
 // UpdateSplitInfo is the resolver for the updateSplitInfo field.
 func (r *mutationResolver) UpdateSplitInfo(ctx context.Context, input model.UpdateSplitInfoInput) (model.UpdateSplitInfoPayloadOrError, error) {
-	panic(fmt.Errorf("not implemented: UpdateSplitInfo - updateSplitInfo"))
+	err := publicapi.For(ctx).Split.UpdateSplitInfo(ctx, input.ID, input.Name, input.Description, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	split, err := resolveSplitBySplitID(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.UpdateSplitInfoPayload{Split: split}, nil
+}
+
+// SaveContact is the resolver for the saveContact field.
+func (r *mutationResolver) SaveContact(ctx context.Context, input model.SaveContactInput) (model.SaveContactPayloadOrError, error) {
+	contact, err := publicapi.For(ctx).Contact.SaveContact(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.SaveContactPayload{
+		Contact: contactToModel(contact),
+	}, nil
+}
+
+// DeleteContact is the resolver for the deleteContact field.
+func (r *mutationResolver) DeleteContact(ctx context.Context, contactID persist.DBID) (model.DeleteContactPayloadOrError, error) {
+	err := publicapi.For(ctx).Contact.DeleteContact(ctx, contactID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.DeleteContactPayload{
+		Viewer: resolveViewer(ctx),
+	}, nil
+}
+
+// ImportContacts is the resolver for the importContacts field.
+func (r *mutationResolver) ImportContacts(ctx context.Context, csv string) (model.ImportContactsPayloadOrError, error) {
+	imported, err := publicapi.For(ctx).Contact.ImportContacts(ctx, csv)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.ImportContactsPayload{
+		Viewer:   resolveViewer(ctx),
+		Imported: &imported,
+	}, nil
+}
+
+// ExportLedger is the resolver for the exportLedger field.
+func (r *mutationResolver) ExportLedger(ctx context.Context, input model.ExportLedgerInput) (model.ExportLedgerPayloadOrError, error) {
+	export, err := publicapi.For(ctx).Export.ExportLedger(ctx, input.SplitID, input.RecipientAddress, input.From, input.To, ledgerExportFormatToPersist(input.Format))
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ExportLedgerPayload{Export: ledgerExportToModel(export)}, nil
+}
+
+// InviteRecipient is the resolver for the inviteRecipient field.
+func (r *mutationResolver) InviteRecipient(ctx context.Context, recipientID persist.DBID, email persist.Email) (model.InviteRecipientPayloadOrError, error) {
+	invite, err := publicapi.For(ctx).Split.InviteRecipient(ctx, recipientID, email)
+	if err != nil {
+		return nil, err
+	}
+
+	recipient, err := resolveRecipientByInvite(ctx, invite)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.InviteRecipientPayload{Recipient: recipient}, nil
+}
+
+// AcceptRecipientInvite is the resolver for the acceptRecipientInvite field.
+func (r *mutationResolver) AcceptRecipientInvite(ctx context.Context, inviteID persist.DBID) (model.AcceptRecipientInvitePayloadOrError, error) {
+	invite, err := publicapi.For(ctx).Split.AcceptRecipientInvite(ctx, inviteID)
+	if err != nil {
+		return nil, err
+	}
+
+	recipient, err := resolveRecipientByInvite(ctx, invite)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.AcceptRecipientInvitePayload{Recipient: recipient}, nil
+}
+
+// RevokeRecipientInvite is the resolver for the revokeRecipientInvite field.
+func (r *mutationResolver) RevokeRecipientInvite(ctx context.Context, inviteID persist.DBID) (model.RevokeRecipientInvitePayloadOrError, error) {
+	invite, err := publicapi.For(ctx).Split.RevokeRecipientInvite(ctx, inviteID)
+	if err != nil {
+		return nil, err
+	}
+
+	recipient, err := resolveRecipientByInvite(ctx, invite)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.RevokeRecipientInvitePayload{Recipient: recipient}, nil
+}
+
+// GrantSplitRole is the resolver for the grantSplitRole field.
+func (r *mutationResolver) GrantSplitRole(ctx context.Context, input model.GrantSplitRoleInput) (model.GrantSplitRolePayloadOrError, error) {
+	member, err := publicapi.For(ctx).Split.GrantSplitRole(ctx, input.SplitID, input.UserID, splitRoleToPersist(input.Role))
+	if err != nil {
+		return nil, err
+	}
+
+	return model.GrantSplitRolePayload{Member: splitMemberToModel(member)}, nil
+}
+
+// RevokeSplitRole is the resolver for the revokeSplitRole field.
+func (r *mutationResolver) RevokeSplitRole(ctx context.Context, input model.RevokeSplitRoleInput) (model.RevokeSplitRolePayloadOrError, error) {
+	err := publicapi.For(ctx).Split.RevokeSplitRole(ctx, input.SplitID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	split, err := resolveSplitBySplitID(ctx, input.SplitID)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.RevokeSplitRolePayload{Split: split}, nil
+}
+
+// CreateSplitGroup is the resolver for the createSplitGroup field.
+func (r *mutationResolver) CreateSplitGroup(ctx context.Context, input model.CreateSplitGroupInput) (model.CreateSplitGroupPayloadOrError, error) {
+	var name, description string
+	if input.Name != nil {
+		name = *input.Name
+	}
+	if input.Description != nil {
+		description = *input.Description
+	}
+
+	group, err := publicapi.For(ctx).Split.CreateSplitGroup(ctx, name, description, input.SplitIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.CreateSplitGroupPayload{Group: splitGroupToModel(group)}, nil
+}
+
+// AddSplitToGroup is the resolver for the addSplitToGroup field.
+func (r *mutationResolver) AddSplitToGroup(ctx context.Context, input model.SplitGroupMemberInput) (model.AddSplitToGroupPayloadOrError, error) {
+	group, err := publicapi.For(ctx).Split.AddSplitToGroup(ctx, input.GroupID, input.SplitID)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.AddSplitToGroupPayload{Group: splitGroupToModel(group)}, nil
+}
+
+// RemoveSplitFromGroup is the resolver for the removeSplitFromGroup field.
+func (r *mutationResolver) RemoveSplitFromGroup(ctx context.Context, input model.SplitGroupMemberInput) (model.RemoveSplitFromGroupPayloadOrError, error) {
+	group, err := publicapi.For(ctx).Split.RemoveSplitFromGroup(ctx, input.GroupID, input.SplitID)
+	if err != nil {
+		return nil, err
+	}
+
+	split, err := resolveSplitBySplitID(ctx, input.SplitID)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := model.RemoveSplitFromGroupPayload{Split: split}
+	if group != nil {
+		payload.Group = splitGroupToModel(*group)
+	}
+
+	return payload, nil
+}
+
+// UpdateSplitGroupInfo is the resolver for the updateSplitGroupInfo field.
+func (r *mutationResolver) UpdateSplitGroupInfo(ctx context.Context, input model.UpdateSplitGroupInfoInput) (model.UpdateSplitGroupInfoPayloadOrError, error) {
+	group, err := publicapi.For(ctx).Split.UpdateSplitGroupInfo(ctx, input.GroupID, input.Name, input.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.UpdateSplitGroupInfoPayload{Group: splitGroupToModel(group)}, nil
+}
+
+// CreateSplitMediaUpload is the resolver for the createSplitMediaUpload field.
+func (r *mutationResolver) CreateSplitMediaUpload(ctx context.Context, input model.CreateSplitMediaUploadInput) (model.CreateSplitMediaUploadPayloadOrError, error) {
+	upload, err := publicapi.For(ctx).Media.CreateSplitMediaUpload(ctx, input.SplitID, splitMediaKindToPersist(input.Kind), input.ContentType, int64(input.ContentLength))
+	if err != nil {
+		return nil, err
+	}
+
+	headers := make([]*model.UploadHeader, len(upload.Headers))
+	for i, h := range upload.Headers {
+		headers[i] = &model.UploadHeader{Name: h.Name, Value: h.Value}
+	}
+
+	return model.CreateSplitMediaUploadPayload{
+		UploadID:  upload.Media.ID,
+		UploadURL: upload.URL,
+		Headers:   headers,
+		ExpiresAt: upload.ExpiresAt,
+	}, nil
+}
+
+// CompleteSplitMediaUpload is the resolver for the completeSplitMediaUpload field.
+func (r *mutationResolver) CompleteSplitMediaUpload(ctx context.Context, uploadID persist.DBID) (model.CompleteSplitMediaUploadPayloadOrError, error) {
+	m, err := publicapi.For(ctx).Media.CompleteSplitMediaUpload(ctx, uploadID)
+	if err != nil {
+		return nil, err
+	}
+
+	split, err := resolveSplitBySplitID(ctx, m.SplitID)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.CompleteSplitMediaUploadPayload{Split: split}, nil
+}
+
+// FollowSplit is the resolver for the followSplit field.
+func (r *mutationResolver) FollowSplit(ctx context.Context, splitID persist.DBID) (model.FollowSplitPayloadOrError, error) {
+	split, err := publicapi.For(ctx).Split.FollowSplit(ctx, splitID)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.FollowSplitPayload{Viewer: resolveViewer(ctx), Split: splitToModel(ctx, split)}, nil
+}
+
+// UnfollowSplit is the resolver for the unfollowSplit field.
+func (r *mutationResolver) UnfollowSplit(ctx context.Context, splitID persist.DBID) (model.UnfollowSplitPayloadOrError, error) {
+	err := publicapi.For(ctx).Split.UnfollowSplit(ctx, splitID)
+	if err != nil {
+		return nil, err
+	}
+
+	split, err := resolveSplitBySplitID(ctx, splitID)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.UnfollowSplitPayload{Viewer: resolveViewer(ctx), Split: split}, nil
+}
+
+// CreateWebhook is the resolver for the createWebhook field.
+func (r *mutationResolver) CreateWebhook(ctx context.Context, input model.CreateWebhookInput) (model.CreateWebhookPayloadOrError, error) {
+	w, err := publicapi.For(ctx).Webhook.CreateWebhook(ctx, input.URL, input.SplitID, webhookEventTypesToPersist(input.EventTypes))
+	if err != nil {
+		return nil, err
+	}
+
+	return model.CreateWebhookPayload{Webhook: webhookToModel(w), Secret: &w.Secret}, nil
+}
+
+// UpdateWebhook is the resolver for the updateWebhook field.
+func (r *mutationResolver) UpdateWebhook(ctx context.Context, input model.UpdateWebhookInput) (model.UpdateWebhookPayloadOrError, error) {
+	w, err := publicapi.For(ctx).Webhook.UpdateWebhook(ctx, input.WebhookID, input.URL, webhookEventTypesToPersist(input.EventTypes), input.Enabled)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.UpdateWebhookPayload{Webhook: webhookToModel(w)}, nil
+}
+
+// DeleteWebhook is the resolver for the deleteWebhook field.
+func (r *mutationResolver) DeleteWebhook(ctx context.Context, webhookID persist.DBID) (model.DeleteWebhookPayloadOrError, error) {
+	err := publicapi.For(ctx).Webhook.DeleteWebhook(ctx, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.DeleteWebhookPayload{Viewer: resolveViewer(ctx)}, nil
+}
+
+// PingWebhook is the resolver for the pingWebhook field.
+func (r *mutationResolver) PingWebhook(ctx context.Context, webhookID persist.DBID) (model.PingWebhookPayloadOrError, error) {
+	d, err := publicapi.For(ctx).Webhook.PingWebhook(ctx, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.PingWebhookPayload{Delivery: webhookDeliveryToModel(d)}, nil
 }
 
 // ClearAllNotifications is the resolver for the clearAllNotifications field.
@@ -401,20 +799,47 @@ func (r *mutationResolver) RevokeRolesFromUser(ctx context.Context, username str
 	return userToModel(ctx, *user), nil
 }
 
-// UploadPersistedQueries is the resolver for the uploadPersistedQueries field.
-func (r *mutationResolver) UploadPersistedQueries(ctx context.Context, input *model.UploadPersistedQueriesInput) (model.UploadPersistedQueriesPayloadOrError, error) {
-	err := publicapi.For(ctx).APQ.UploadPersistedQueries(ctx, *input.PersistedQueries)
-
+// ResyncSplitFromChain is the resolver for the resyncSplitFromChain field.
+func (r *mutationResolver) ResyncSplitFromChain(ctx context.Context, splitID persist.DBID) (model.ResyncSplitFromChainPayloadOrError, error) {
+	split, err := publicapi.For(ctx).Admin.ResyncSplitFromChain(ctx, splitID)
 	if err != nil {
 		return nil, err
 	}
 
-	message := "Persisted queries uploaded successfully"
-
-	return model.UploadPersistedQueriesPayload{Message: &message}, nil
+	return model.ResyncSplitFromChainPayload{Split: splitToModel(ctx, *split)}, nil
 }
 
-// UpdatePrimaryWallet is the resolver for the updatePrimaryWallet field.
+// SetTokenPrice is the resolver for the setTokenPrice field.
+func (r *mutationResolver) SetTokenPrice(ctx context.Context, input model.SetTokenPriceInput) (model.SetTokenPricePayloadOrError, error) {
+	err := publicapi.For(ctx).Admin.SetTokenPrice(ctx, input.Chain, input.TokenAddress, input.Decimals, input.UsdPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenAddress := persist.Address(input.Chain.NormalizeAddress(input.TokenAddress))
+
+	return model.SetTokenPricePayload{
+		Chain:        &input.Chain,
+		TokenAddress: &tokenAddress,
+		Decimals:     &input.Decimals,
+		UsdPrice:     &input.UsdPrice,
+	}, nil
+}
+
+// UploadPersistedQueries is the resolver for the uploadPersistedQueries field.
+func (r *mutationResolver) UploadPersistedQueries(ctx context.Context, input *model.UploadPersistedQueriesInput) (model.UploadPersistedQueriesPayloadOrError, error) {
+	err := publicapi.For(ctx).APQ.UploadPersistedQueries(ctx, *input.PersistedQueries)
+
+	if err != nil {
+		return nil, err
+	}
+
+	message := "Persisted queries uploaded successfully"
+
+	return model.UploadPersistedQueriesPayload{Message: &message}, nil
+}
+
+// UpdatePrimaryWallet is the resolver for the updatePrimaryWallet field.
 func (r *mutationResolver) UpdatePrimaryWallet(ctx context.Context, walletID persist.DBID) (model.UpdatePrimaryWalletPayloadOrError, error) {
 	err := publicapi.For(ctx).User.UpdateUserPrimaryWallet(ctx, walletID)
 	if err != nil {
@@ -483,14 +908,87 @@ func (r *queryResolver) ViewerSplitByID(ctx context.Context, id persist.DBID) (m
 	return split, nil
 }
 
+// SplitGroupByID is the resolver for the splitGroupById field.
+func (r *queryResolver) SplitGroupByID(ctx context.Context, id persist.DBID) (model.SplitGroupByIDPayloadOrError, error) {
+	group, err := resolveSplitGroupBySplitGroupID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+// SimulateSplitChange is the resolver for the simulateSplitChange field.
+func (r *queryResolver) SimulateSplitChange(ctx context.Context, splitID persist.DBID, newShares []*model.SimulatedShareInput, hypotheticalInflows []*model.HypotheticalInflowInput) (model.SimulateSplitChangePayloadOrError, error) {
+	simulation, err := publicapi.For(ctx).Split.SimulateSplitChange(ctx, splitID, newShares, hypotheticalInflows)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.SimulateSplitChangePayload{
+		Split:               splitToModel(ctx, simulation.Split),
+		Balances:            splitComparisonsToModels(simulation.Balances),
+		HypotheticalInflows: splitComparisonsToModels(simulation.HypotheticalInflows),
+	}, nil
+}
+
 // SearchUsers is the resolver for the searchUsers field.
 func (r *queryResolver) SearchUsers(ctx context.Context, query string, limit *int, usernameWeight *float64) (model.SearchUsersPayloadOrError, error) {
-	panic(fmt.Errorf("not implemented: SearchUsers - searchUsers"))
+	searchLimit := 100
+	if limit != nil {
+		searchLimit = *limit
+	}
+
+	uWeight := float32(0.4)
+	if usernameWeight != nil {
+		uWeight = float32(*usernameWeight)
+	}
+
+	users, err := publicapi.For(ctx).Search.SearchUsers(ctx, query, searchLimit, uWeight)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*model.UserSearchResult, len(users))
+	for i, user := range users {
+		results[i] = &model.UserSearchResult{
+			User: userToModel(ctx, user),
+		}
+	}
+
+	return &model.SearchUsersPayload{Results: results}, nil
 }
 
 // SearchSplits is the resolver for the searchSplits field.
 func (r *queryResolver) SearchSplits(ctx context.Context, query string, limit *int, nameWeight *float64, descriptionWeight *float64) (model.SearchSplitsPayloadOrError, error) {
-	panic(fmt.Errorf("not implemented: SearchSplits - searchSplits"))
+	searchLimit := 100
+	if limit != nil {
+		searchLimit = *limit
+	}
+
+	nWeight := float32(0.4)
+	if nameWeight != nil {
+		nWeight = float32(*nameWeight)
+	}
+
+	dWeight := float32(0.2)
+	if descriptionWeight != nil {
+		dWeight = float32(*descriptionWeight)
+	}
+
+	splits, err := publicapi.For(ctx).Search.SearchSplits(ctx, query, searchLimit, nWeight, dWeight)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*model.SplitSearchResult, len(splits))
+	for i, split := range splits {
+		results[i] = &model.SplitSearchResult{
+			Split: splitToModel(ctx, split),
+		}
+	}
+
+	return &model.SearchSplitsPayload{Results: results}, nil
 }
 
 // IsEmailAddressAvailable is the resolver for the isEmailAddressAvailable field.
@@ -519,17 +1017,304 @@ func (r *queryResolver) UsersByRole(ctx context.Context, role persist.Role, befo
 
 // Split is the resolver for the split field.
 func (r *recipientResolver) Split(ctx context.Context, obj *model.Recipient) (*model.Split, error) {
-	panic(fmt.Errorf("not implemented: Split - split"))
+	return resolveSplitBySplitID(ctx, obj.HelperRecipientData.SplitID)
+}
+
+// RecipientSplit is the resolver for the recipientSplit field.
+func (r *recipientResolver) RecipientSplit(ctx context.Context, obj *model.Recipient) (*model.Split, error) {
+	split, err := publicapi.For(ctx).Split.GetRecipientSplit(ctx, obj.Dbid)
+	if err != nil || split == nil {
+		return nil, err
+	}
+
+	return splitToModel(ctx, *split), nil
+}
+
+// Claimable is the resolver for the claimable field.
+func (r *recipientResolver) Claimable(ctx context.Context, obj *model.Recipient) ([]*model.ClaimableAmount, error) {
+	claims, err := publicapi.For(ctx).Split.GetRecipientClaimable(ctx, obj.Dbid)
+	if err != nil {
+		return nil, err
+	}
+
+	return claimsToModels(claims), nil
+}
+
+// Label is the resolver for the label field.
+func (r *recipientResolver) Label(ctx context.Context, obj *model.Recipient) (*string, error) {
+	return publicapi.For(ctx).Contact.GetRecipientLabel(ctx, obj.SplitID, *obj.Address)
+}
+
+// Invite is the resolver for the invite field.
+func (r *recipientResolver) Invite(ctx context.Context, obj *model.Recipient) (*model.RecipientInvite, error) {
+	invite, err := publicapi.For(ctx).Split.GetRecipientInvite(ctx, obj.Dbid)
+	if err != nil || invite == nil {
+		return nil, err
+	}
+
+	return recipientInviteToModel(*invite), nil
+}
+
+// User is the resolver for the user field.
+func (r *recipientResolver) User(ctx context.Context, obj *model.Recipient) (*model.SplitFiUser, error) {
+	invite, err := publicapi.For(ctx).Split.GetRecipientInvite(ctx, obj.Dbid)
+	if err != nil || invite == nil || persist.RecipientInviteStatus(invite.Status) != persist.RecipientInviteStatusAccepted || invite.InviteeID == "" {
+		return nil, err
+	}
+
+	return resolveSplitFiUserByUserID(ctx, invite.InviteeID)
+}
+
+// Invitee is the resolver for the invitee field.
+func (r *recipientInviteResolver) Invitee(ctx context.Context, obj *model.RecipientInvite) (*model.SplitFiUser, error) {
+	if obj.HelperRecipientInviteData.InviteeID == "" {
+		return nil, nil
+	}
+
+	return resolveSplitFiUserByUserID(ctx, obj.HelperRecipientInviteData.InviteeID)
+}
+
+// Logo is the resolver for the logo field.
+func (r *splitResolver) Logo(ctx context.Context, obj *model.Split) (*model.SplitMedia, error) {
+	m, err := publicapi.For(ctx).Media.GetSplitMedia(ctx, obj.Dbid, persist.SplitMediaKindLogo)
+	if err != nil {
+		return nil, err
+	}
+
+	return splitMediaToModel(ctx, m, obj.LogoURL), nil
+}
+
+// Banner is the resolver for the banner field.
+func (r *splitResolver) Banner(ctx context.Context, obj *model.Split) (*model.SplitMedia, error) {
+	m, err := publicapi.For(ctx).Media.GetSplitMedia(ctx, obj.Dbid, persist.SplitMediaKindBanner)
+	if err != nil {
+		return nil, err
+	}
+
+	return splitMediaToModel(ctx, m, obj.BannerURL), nil
+}
+
+// Badge is the resolver for the badge field.
+func (r *splitResolver) Badge(ctx context.Context, obj *model.Split) (*model.SplitMedia, error) {
+	m, err := publicapi.For(ctx).Media.GetSplitMedia(ctx, obj.Dbid, persist.SplitMediaKindBadge)
+	if err != nil {
+		return nil, err
+	}
+
+	return splitMediaToModel(ctx, m, obj.BadgeURL), nil
 }
 
 // Assets is the resolver for the assets field.
-func (r *splitResolver) Assets(ctx context.Context, obj *model.Split, limit *int) ([]*model.Asset, error) {
-	panic(fmt.Errorf("not implemented: Assets - assets"))
+func (r *splitResolver) Assets(ctx context.Context, obj *model.Split, before *string, after *string, first *int, last *int, filter *model.SplitAssetsFilter, sortBy *model.SplitAssetSort) (*model.SplitAssetsConnection, error) {
+	sort := model.SplitAssetSortValue
+	if sortBy != nil {
+		sort = *sortBy
+	}
+
+	assets, pageInfo, err := publicapi.For(ctx).Split.PaginateSplitAssets(ctx, obj.Dbid, filter, sort, before, after, first, last)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.SplitAssetsConnection{
+		Edges:    splitAssetsToEdges(assets),
+		PageInfo: pageInfoToModel(ctx, pageInfo),
+	}, nil
 }
 
 // Shares is the resolver for the shares field.
-func (r *splitResolver) Shares(ctx context.Context, obj *model.Split, limit *int) ([]*model.Recipient, error) {
-	panic(fmt.Errorf("not implemented: Shares - shares"))
+func (r *splitResolver) Shares(ctx context.Context, obj *model.Split, before *string, after *string, first *int, last *int) (*model.SplitSharesConnection, error) {
+	recipients, pageInfo, err := publicapi.For(ctx).Split.PaginateSplitShares(ctx, obj.Dbid, before, after, first, last)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.SplitSharesConnection{
+		Edges:    recipientsToEdges(ctx, recipients),
+		PageInfo: pageInfoToModel(ctx, pageInfo),
+	}, nil
+}
+
+// OnchainStatus is the resolver for the onchainStatus field.
+func (r *splitResolver) OnchainStatus(ctx context.Context, obj *model.Split) (*model.SplitOnchainStatus, error) {
+	status, err := publicapi.For(ctx).Split.GetSplitOnchainStatus(ctx, obj.Dbid)
+	if err != nil || status == nil {
+		return nil, err
+	}
+
+	return splitOnchainStatusToModel(*status), nil
+}
+
+// DistributionPreview is the resolver for the distributionPreview field.
+func (r *splitResolver) DistributionPreview(ctx context.Context, obj *model.Split) ([]*model.TokenDistribution, error) {
+	distributions, err := publicapi.For(ctx).Split.GetSplitDistributionPreview(ctx, obj.Dbid)
+	if err != nil {
+		return nil, err
+	}
+
+	return tokenDistributionsToModels(distributions), nil
+}
+
+// Distributions is the resolver for the distributions field.
+func (r *splitResolver) Distributions(ctx context.Context, obj *model.Split, before *string, after *string, first *int, last *int) (*model.SplitLedgerEntriesConnection, error) {
+	entries, pageInfo, err := publicapi.For(ctx).Split.PaginateSplitDistributions(ctx, obj.Dbid, before, after, first, last)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.SplitLedgerEntriesConnection{
+		Edges:    ledgerEntriesToEdges(entries),
+		PageInfo: pageInfoToModel(ctx, pageInfo),
+	}, nil
+}
+
+// Revisions is the resolver for the revisions field.
+func (r *splitResolver) Revisions(ctx context.Context, obj *model.Split, before *string, after *string, first *int, last *int) (*model.SplitRevisionsConnection, error) {
+	revisions, pageInfo, err := publicapi.For(ctx).Split.PaginateSplitRevisions(ctx, obj.Dbid, before, after, first, last)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.SplitRevisionsConnection{
+		Edges:    revisionsToEdges(revisions),
+		PageInfo: pageInfoToModel(ctx, pageInfo),
+	}, nil
+}
+
+// RevisionDiff is the resolver for the revisionDiff field.
+func (r *splitResolver) RevisionDiff(ctx context.Context, obj *model.Split, fromRevision int, toRevision int) (*model.SplitRevisionDiff, error) {
+	diff, err := publicapi.For(ctx).Split.GetSplitRevisionDiff(ctx, obj.Dbid, fromRevision, toRevision)
+	if err != nil {
+		return nil, err
+	}
+
+	return revisionDiffToModel(*diff), nil
+}
+
+// Draft is the resolver for the draft field.
+func (r *splitResolver) Draft(ctx context.Context, obj *model.Split, editID string) (*model.SplitDraft, error) {
+	draft, err := publicapi.For(ctx).Split.GetViewerSplitDraft(ctx, obj.Dbid, editID)
+	if err != nil {
+		return nil, err
+	}
+
+	return splitDraftToModel(obj, *draft), nil
+}
+
+// EffectiveOwnership is the resolver for the effectiveOwnership field.
+func (r *splitResolver) EffectiveOwnership(ctx context.Context, obj *model.Split) ([]*model.EffectiveOwnership, error) {
+	shares, err := publicapi.For(ctx).Split.GetSplitEffectiveOwnership(ctx, obj.Dbid)
+	if err != nil {
+		return nil, err
+	}
+
+	return effectiveSharesToModels(shares), nil
+}
+
+// PendingDeletion is the resolver for the pendingDeletion field.
+func (r *splitResolver) PendingDeletion(ctx context.Context, obj *model.Split) (*model.SplitDeletionRequest, error) {
+	request, err := publicapi.For(ctx).Split.GetPendingSplitDeletionRequest(ctx, obj.Dbid)
+	if err != nil || request == nil {
+		return nil, err
+	}
+
+	return splitDeletionRequestToModel(*request), nil
+}
+
+// Analytics is the resolver for the analytics field.
+func (r *splitResolver) Analytics(ctx context.Context, obj *model.Split, window model.Window, topPayersLimit *int) (*model.SplitAnalytics, error) {
+	report, err := publicapi.For(ctx).Split.GetSplitInflowReport(ctx, obj.Dbid, window, topPayersLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	return splitInflowReportToModel(report), nil
+}
+
+// ViewerRole is the resolver for the viewerRole field.
+func (r *splitResolver) ViewerRole(ctx context.Context, obj *model.Split) (*model.SplitRole, error) {
+	role, err := publicapi.For(ctx).Split.GetViewerSplitRole(ctx, obj.Dbid)
+	if err != nil || role == "" {
+		return nil, err
+	}
+
+	return splitRoleToModel(role), nil
+}
+
+// Members is the resolver for the members field.
+func (r *splitResolver) Members(ctx context.Context, obj *model.Split) ([]*model.SplitMember, error) {
+	members, err := publicapi.For(ctx).Split.GetSplitMembers(ctx, obj.Dbid)
+	if err != nil {
+		return nil, err
+	}
+
+	models := make([]*model.SplitMember, len(members))
+	for i, member := range members {
+		models[i] = splitMemberToModel(member)
+	}
+
+	return models, nil
+}
+
+// Group is the resolver for the group field.
+func (r *splitResolver) Group(ctx context.Context, obj *model.Split) (*model.SplitGroup, error) {
+	group, err := publicapi.For(ctx).Split.GetSplitGroupBySplitID(ctx, obj.Dbid)
+	if err != nil || group == nil {
+		return nil, err
+	}
+
+	return splitGroupToModel(*group), nil
+}
+
+// CardURL is the resolver for the cardURL field.
+func (r *splitResolver) CardURL(ctx context.Context, obj *model.Split, format *model.SplitCardFormat, theme *model.SplitCardTheme, size *model.SplitCardSize) (*string, error) {
+	url, err := publicapi.For(ctx).Split.GetSplitCardURL(ctx, obj.Dbid, cardOptionToPersist(format), cardOptionToPersist(theme), cardOptionToPersist(size))
+	if err != nil {
+		return nil, err
+	}
+
+	return &url, nil
+}
+
+// Split is the resolver for the split field.
+func (r *splitActivityResolver) Split(ctx context.Context, obj *model.SplitActivity) (*model.Split, error) {
+	return resolveSplitBySplitID(ctx, obj.HelperSplitActivityData.SplitID)
+}
+
+// Split is the resolver for the split field.
+func (r *splitBalancesUpdateResolver) Split(ctx context.Context, obj *model.SplitBalancesUpdate) (*model.Split, error) {
+	return resolveSplitBySplitID(ctx, obj.HelperSplitBalancesUpdateData.SplitID)
+}
+
+// Approver is the resolver for the approver field.
+func (r *splitDeletionApprovalResolver) Approver(ctx context.Context, obj *model.SplitDeletionApproval) (*model.SplitFiUser, error) {
+	return resolveSplitFiUserByUserID(ctx, obj.HelperSplitDeletionApprovalData.ApproverID)
+}
+
+// Requester is the resolver for the requester field.
+func (r *splitDeletionRequestResolver) Requester(ctx context.Context, obj *model.SplitDeletionRequest) (*model.SplitFiUser, error) {
+	return resolveSplitFiUserByUserID(ctx, obj.HelperSplitDeletionRequestData.RequesterID)
+}
+
+// ApprovedOwnership is the resolver for the approvedOwnership field.
+func (r *splitDeletionRequestResolver) ApprovedOwnership(ctx context.Context, obj *model.SplitDeletionRequest) (*int, error) {
+	ownership, err := publicapi.For(ctx).Split.GetSplitDeletionApprovedOwnership(ctx, obj.HelperSplitDeletionRequestData.SplitID, obj.Dbid)
+	if err != nil {
+		return nil, err
+	}
+
+	approved := int(ownership)
+	return &approved, nil
+}
+
+// Approvals is the resolver for the approvals field.
+func (r *splitDeletionRequestResolver) Approvals(ctx context.Context, obj *model.SplitDeletionRequest) ([]*model.SplitDeletionApproval, error) {
+	approvals, err := publicapi.For(ctx).Split.GetSplitDeletionApprovals(ctx, obj.Dbid)
+	if err != nil {
+		return nil, err
+	}
+
+	return splitDeletionApprovalsToModels(approvals), nil
 }
 
 // Roles is the resolver for the roles field.
@@ -560,7 +1345,20 @@ func (r *splitFiUserResolver) PrimaryWallet(ctx context.Context, obj *model.Spli
 
 // Splits is the resolver for the splits field.
 func (r *splitFiUserResolver) Splits(ctx context.Context, obj *model.SplitFiUser) ([]*model.Split, error) {
-	panic(fmt.Errorf("not implemented: Splits - splits"))
+	return resolveSplitsByUserID(ctx, obj.Dbid)
+}
+
+// SplitsConnection is the resolver for the splitsConnection field.
+func (r *splitFiUserResolver) SplitsConnection(ctx context.Context, obj *model.SplitFiUser, before *string, after *string, first *int, last *int) (*model.SplitsConnection, error) {
+	splits, pageInfo, err := publicapi.For(ctx).Split.PaginateSplitsByUserID(ctx, obj.Dbid, before, after, first, last)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.SplitsConnection{
+		Edges:    splitsToEdges(ctx, splits),
+		PageInfo: pageInfoToModel(ctx, pageInfo),
+	}, nil
 }
 
 // SplitsByChain is the resolver for the splitsByChain field.
@@ -568,6 +1366,98 @@ func (r *splitFiUserResolver) SplitsByChain(ctx context.Context, obj *model.Spli
 	panic(fmt.Errorf("not implemented: SplitsByChain - splitsByChain"))
 }
 
+// Splits is the resolver for the splits field.
+func (r *splitGroupResolver) Splits(ctx context.Context, obj *model.SplitGroup) ([]*model.Split, error) {
+	splits, err := publicapi.For(ctx).Split.GetSplitGroupSplits(ctx, obj.Dbid)
+	if err != nil {
+		return nil, err
+	}
+
+	models := make([]*model.Split, len(splits))
+	for i, split := range splits {
+		models[i] = splitToModel(ctx, split)
+	}
+
+	return models, nil
+}
+
+// Balances is the resolver for the balances field.
+func (r *splitGroupResolver) Balances(ctx context.Context, obj *model.SplitGroup) ([]*model.TokenAmount, error) {
+	balances, err := publicapi.For(ctx).Split.GetSplitGroupBalances(ctx, obj.Dbid)
+	if err != nil {
+		return nil, err
+	}
+
+	return tokenAmountsToModels(balances), nil
+}
+
+// DistributionPreview is the resolver for the distributionPreview field.
+func (r *splitGroupResolver) DistributionPreview(ctx context.Context, obj *model.SplitGroup) ([]*model.TokenDistribution, error) {
+	distributions, err := publicapi.For(ctx).Split.GetSplitGroupDistributionPreview(ctx, obj.Dbid)
+	if err != nil {
+		return nil, err
+	}
+
+	return tokenDistributionsToModels(distributions), nil
+}
+
+// Distributions is the resolver for the distributions field.
+func (r *splitGroupResolver) Distributions(ctx context.Context, obj *model.SplitGroup, before *string, after *string, first *int, last *int) (*model.SplitLedgerEntriesConnection, error) {
+	entries, pageInfo, err := publicapi.For(ctx).Split.PaginateSplitGroupDistributions(ctx, obj.Dbid, before, after, first, last)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.SplitLedgerEntriesConnection{
+		Edges:    ledgerEntriesToEdges(entries),
+		PageInfo: pageInfoToModel(ctx, pageInfo),
+	}, nil
+}
+
+// Analytics is the resolver for the analytics field.
+func (r *splitGroupResolver) Analytics(ctx context.Context, obj *model.SplitGroup, window model.Window, topPayersLimit *int) (*model.SplitAnalytics, error) {
+	report, err := publicapi.For(ctx).Split.GetSplitGroupInflowReport(ctx, obj.Dbid, window, topPayersLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	return splitInflowReportToModel(report), nil
+}
+
+// Split is the resolver for the split field.
+func (r *splitLedgerEntryResolver) Split(ctx context.Context, obj *model.SplitLedgerEntry) (*model.Split, error) {
+	return resolveSplitBySplitID(ctx, obj.HelperSplitLedgerEntryData.SplitID)
+}
+
+// User is the resolver for the user field.
+func (r *splitMemberResolver) User(ctx context.Context, obj *model.SplitMember) (*model.SplitFiUser, error) {
+	return resolveSplitFiUserByUserID(ctx, obj.HelperSplitMemberData.UserID)
+}
+
+// Split is the resolver for the split field.
+func (r *splitReceivedFundsNotificationResolver) Split(ctx context.Context, obj *model.SplitReceivedFundsNotification) (*model.Split, error) {
+	return resolveSplitBySplitID(ctx, obj.HelperSplitReceivedFundsNotificationData.SplitID)
+}
+
+// Actor is the resolver for the actor field.
+func (r *splitRevisionResolver) Actor(ctx context.Context, obj *model.SplitRevision) (*model.SplitFiUser, error) {
+	if obj.HelperSplitRevisionData.ActorID == "" {
+		return nil, nil
+	}
+
+	return resolveSplitFiUserByUserID(ctx, obj.HelperSplitRevisionData.ActorID)
+}
+
+// Recipients is the resolver for the recipients field.
+func (r *splitTemplateResolver) Recipients(ctx context.Context, obj *model.SplitTemplate) ([]*model.SplitTemplateRecipient, error) {
+	recipients, err := publicapi.For(ctx).Split.GetSplitTemplateRecipients(ctx, obj.Dbid)
+	if err != nil {
+		return nil, err
+	}
+
+	return splitTemplateRecipientsToModels(recipients), nil
+}
+
 // NewNotification is the resolver for the newNotification field.
 func (r *subscriptionResolver) NewNotification(ctx context.Context) (<-chan model.Notification, error) {
 	return resolveNewNotificationSubscription(ctx), nil
@@ -578,6 +1468,16 @@ func (r *subscriptionResolver) NotificationUpdated(ctx context.Context) (<-chan
 	return resolveUpdatedNotificationSubscription(ctx), nil
 }
 
+// SplitActivity is the resolver for the splitActivity field.
+func (r *subscriptionResolver) SplitActivity(ctx context.Context, splitID persist.DBID) (<-chan *model.SplitActivity, error) {
+	return resolveSplitActivitySubscription(ctx, splitID)
+}
+
+// SplitBalancesUpdated is the resolver for the splitBalancesUpdated field.
+func (r *subscriptionResolver) SplitBalancesUpdated(ctx context.Context, splitID persist.DBID) (<-chan *model.SplitBalancesUpdate, error) {
+	return resolveSplitBalancesSubscription(ctx, splitID)
+}
+
 // EmailNotificationSettings is the resolver for the emailNotificationSettings field.
 func (r *userEmailResolver) EmailNotificationSettings(ctx context.Context, obj *model.UserEmail) (*model.EmailNotificationSettings, error) {
 	unsubs, err := publicapi.For(ctx).User.GetCurrentUserEmailNotificationSettings(ctx)
@@ -600,22 +1500,12 @@ func (r *viewerResolver) User(ctx context.Context, obj *model.Viewer) (*model.Sp
 
 // ViewerSplits is the resolver for the viewerSplits field.
 func (r *viewerResolver) ViewerSplits(ctx context.Context, obj *model.Viewer) ([]*model.ViewerSplit, error) {
-	userID := publicapi.For(ctx).User.GetLoggedInUserId(ctx)
-
-	splits, err := resolveSplitsByUserID(ctx, userID)
-
+	splits, err := publicapi.For(ctx).Split.GetViewerSplits(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	output := make([]*model.ViewerSplit, len(splits))
-	for i, split := range splits {
-		output[i] = &model.ViewerSplit{
-			Split: split,
-		}
-	}
-
-	return output, nil
+	return viewerSplitsToModels(ctx, splits), nil
 }
 
 // Email is the resolver for the email field.
@@ -638,11 +1528,172 @@ func (r *viewerResolver) UserExperiences(ctx context.Context, obj *model.Viewer)
 	return resolveViewerExperiencesByUserID(ctx, obj.UserId)
 }
 
+// Earnings is the resolver for the earnings field.
+func (r *viewerResolver) Earnings(ctx context.Context, obj *model.Viewer, before *string, after *string, first *int, last *int) (*model.SplitLedgerEntriesConnection, error) {
+	entries, pageInfo, err := publicapi.For(ctx).Split.PaginateViewerEarnings(ctx, before, after, first, last)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.SplitLedgerEntriesConnection{
+		Edges:    ledgerEntriesToEdges(entries),
+		PageInfo: pageInfoToModel(ctx, pageInfo),
+	}, nil
+}
+
+// SplitTemplates is the resolver for the splitTemplates field.
+func (r *viewerResolver) SplitTemplates(ctx context.Context, obj *model.Viewer) ([]*model.SplitTemplate, error) {
+	templates, err := publicapi.For(ctx).Split.GetViewerSplitTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	models := make([]*model.SplitTemplate, len(templates))
+	for i, template := range templates {
+		models[i] = splitTemplateToModel(template)
+	}
+
+	return models, nil
+}
+
+// Contacts is the resolver for the contacts field.
+func (r *viewerResolver) Contacts(ctx context.Context, obj *model.Viewer, query *string, limit *int) ([]*model.Contact, error) {
+	var q string
+	if query != nil {
+		q = *query
+	}
+
+	contacts, err := publicapi.For(ctx).Contact.GetViewerContacts(ctx, q, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return contactsToModels(contacts), nil
+}
+
+// ExportContacts is the resolver for the exportContacts field.
+func (r *viewerResolver) ExportContacts(ctx context.Context, obj *model.Viewer) (*string, error) {
+	export, err := publicapi.For(ctx).Contact.ExportContacts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &export, nil
+}
+
+// LedgerExport is the resolver for the ledgerExport field.
+func (r *viewerResolver) LedgerExport(ctx context.Context, obj *model.Viewer, id persist.DBID) (*model.LedgerExport, error) {
+	export, err := publicapi.For(ctx).Export.GetViewerLedgerExport(ctx, id)
+	if _, ok := err.(persist.ErrLedgerExportNotFound); ok {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return ledgerExportToModel(export), nil
+}
+
+// MemberSplits is the resolver for the memberSplits field.
+func (r *viewerResolver) MemberSplits(ctx context.Context, obj *model.Viewer, before *string, after *string, first *int, last *int) (*model.MemberSplitsConnection, error) {
+	splits, pageInfo, err := publicapi.For(ctx).Split.PaginateViewerMemberSplits(ctx, before, after, first, last)
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]*model.MemberSplitEdge, len(splits))
+	for i, split := range splits {
+		edges[i] = &model.MemberSplitEdge{
+			Node:   memberSplitToModel(ctx, split),
+			Cursor: nil, // not used by relay, but relay will complain without this field existing
+		}
+	}
+
+	return &model.MemberSplitsConnection{
+		Edges:    edges,
+		PageInfo: pageInfoToModel(ctx, pageInfo),
+	}, nil
+}
+
+// FollowedSplits is the resolver for the followedSplits field.
+func (r *viewerResolver) FollowedSplits(ctx context.Context, obj *model.Viewer, before *string, after *string, first *int, last *int) (*model.SplitsConnection, error) {
+	splits, pageInfo, err := publicapi.For(ctx).Split.PaginateViewerFollowedSplits(ctx, before, after, first, last)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.SplitsConnection{
+		Edges:    splitsToEdges(ctx, splits),
+		PageInfo: pageInfoToModel(ctx, pageInfo),
+	}, nil
+}
+
+// Webhooks is the resolver for the webhooks field.
+func (r *viewerResolver) Webhooks(ctx context.Context, obj *model.Viewer) ([]*model.Webhook, error) {
+	webhooks, err := publicapi.For(ctx).Webhook.GetViewerWebhooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	models := make([]*model.Webhook, len(webhooks))
+	for i, w := range webhooks {
+		models[i] = webhookToModel(w)
+	}
+
+	return models, nil
+}
+
 // Splits is the resolver for the splits field.
 func (r *walletResolver) Splits(ctx context.Context, obj *model.Wallet) ([]*model.Split, error) {
 	panic(fmt.Errorf("not implemented: Splits - splits"))
 }
 
+// RecipientOf is the resolver for the recipientOf field.
+func (r *walletResolver) RecipientOf(ctx context.Context, obj *model.Wallet) ([]*model.MemberSplit, error) {
+	if obj.ChainAddress == nil {
+		return nil, nil
+	}
+
+	splits, err := publicapi.For(ctx).Split.GetWalletMemberSplits(ctx, *obj.ChainAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	models := make([]*model.MemberSplit, len(splits))
+	for i, split := range splits {
+		models[i] = memberSplitToModel(ctx, split)
+	}
+
+	return models, nil
+}
+
+// Split is the resolver for the split field.
+func (r *webhookResolver) Split(ctx context.Context, obj *model.Webhook) (*model.Split, error) {
+	if obj.SplitID == "" {
+		return nil, nil
+	}
+
+	return resolveSplitBySplitID(ctx, obj.SplitID)
+}
+
+// Deliveries is the resolver for the deliveries field.
+func (r *webhookResolver) Deliveries(ctx context.Context, obj *model.Webhook, before *string, after *string, first *int, last *int) (*model.WebhookDeliveriesConnection, error) {
+	deliveries, pageInfo, err := publicapi.For(ctx).Webhook.PaginateWebhookDeliveries(ctx, obj.Dbid, before, after, first, last)
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]*model.WebhookDeliveryEdge, len(deliveries))
+	for i, d := range deliveries {
+		edges[i] = &model.WebhookDeliveryEdge{Node: webhookDeliveryToModel(d)}
+	}
+
+	return &model.WebhookDeliveriesConnection{
+		Edges:    edges,
+		PageInfo: pageInfoToModel(ctx, pageInfo),
+	}, nil
+}
+
 // Address is the resolver for the address field.
 func (r *chainAddressInputResolver) Address(ctx context.Context, obj *persist.ChainAddress, data persist.Address) error {
 	return obj.GQLSetAddressFromResolver(data)
@@ -666,6 +1717,12 @@ func (r *chainPubKeyInputResolver) Chain(ctx context.Context, obj *persist.Chain
 // Asset returns generated.AssetResolver implementation.
 func (r *Resolver) Asset() generated.AssetResolver { return &assetResolver{r} }
 
+// LedgerExport returns generated.LedgerExportResolver implementation.
+func (r *Resolver) LedgerExport() generated.LedgerExportResolver { return &ledgerExportResolver{r} }
+
+// MemberSplit returns generated.MemberSplitResolver implementation.
+func (r *Resolver) MemberSplit() generated.MemberSplitResolver { return &memberSplitResolver{r} }
+
 // Mutation returns generated.MutationResolver implementation.
 func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
 
@@ -675,12 +1732,57 @@ func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
 // Recipient returns generated.RecipientResolver implementation.
 func (r *Resolver) Recipient() generated.RecipientResolver { return &recipientResolver{r} }
 
+// RecipientInvite returns generated.RecipientInviteResolver implementation.
+func (r *Resolver) RecipientInvite() generated.RecipientInviteResolver {
+	return &recipientInviteResolver{r}
+}
+
 // Split returns generated.SplitResolver implementation.
 func (r *Resolver) Split() generated.SplitResolver { return &splitResolver{r} }
 
+// SplitActivity returns generated.SplitActivityResolver implementation.
+func (r *Resolver) SplitActivity() generated.SplitActivityResolver { return &splitActivityResolver{r} }
+
+// SplitBalancesUpdate returns generated.SplitBalancesUpdateResolver implementation.
+func (r *Resolver) SplitBalancesUpdate() generated.SplitBalancesUpdateResolver {
+	return &splitBalancesUpdateResolver{r}
+}
+
+// SplitDeletionApproval returns generated.SplitDeletionApprovalResolver implementation.
+func (r *Resolver) SplitDeletionApproval() generated.SplitDeletionApprovalResolver {
+	return &splitDeletionApprovalResolver{r}
+}
+
+// SplitDeletionRequest returns generated.SplitDeletionRequestResolver implementation.
+func (r *Resolver) SplitDeletionRequest() generated.SplitDeletionRequestResolver {
+	return &splitDeletionRequestResolver{r}
+}
+
 // SplitFiUser returns generated.SplitFiUserResolver implementation.
 func (r *Resolver) SplitFiUser() generated.SplitFiUserResolver { return &splitFiUserResolver{r} }
 
+// SplitGroup returns generated.SplitGroupResolver implementation.
+func (r *Resolver) SplitGroup() generated.SplitGroupResolver { return &splitGroupResolver{r} }
+
+// SplitLedgerEntry returns generated.SplitLedgerEntryResolver implementation.
+func (r *Resolver) SplitLedgerEntry() generated.SplitLedgerEntryResolver {
+	return &splitLedgerEntryResolver{r}
+}
+
+// SplitMember returns generated.SplitMemberResolver implementation.
+func (r *Resolver) SplitMember() generated.SplitMemberResolver { return &splitMemberResolver{r} }
+
+// SplitReceivedFundsNotification returns generated.SplitReceivedFundsNotificationResolver implementation.
+func (r *Resolver) SplitReceivedFundsNotification() generated.SplitReceivedFundsNotificationResolver {
+	return &splitReceivedFundsNotificationResolver{r}
+}
+
+// SplitRevision returns generated.SplitRevisionResolver implementation.
+func (r *Resolver) SplitRevision() generated.SplitRevisionResolver { return &splitRevisionResolver{r} }
+
+// SplitTemplate returns generated.SplitTemplateResolver implementation.
+func (r *Resolver) SplitTemplate() generated.SplitTemplateResolver { return &splitTemplateResolver{r} }
+
 // Subscription returns generated.SubscriptionResolver implementation.
 func (r *Resolver) Subscription() generated.SubscriptionResolver { return &subscriptionResolver{r} }
 
@@ -693,6 +1795,9 @@ func (r *Resolver) Viewer() generated.ViewerResolver { return &viewerResolver{r}
 // Wallet returns generated.WalletResolver implementation.
 func (r *Resolver) Wallet() generated.WalletResolver { return &walletResolver{r} }
 
+// Webhook returns generated.WebhookResolver implementation.
+func (r *Resolver) Webhook() generated.WebhookResolver { return &webhookResolver{r} }
+
 // ChainAddressInput returns generated.ChainAddressInputResolver implementation.
 func (r *Resolver) ChainAddressInput() generated.ChainAddressInputResolver {
 	return &chainAddressInputResolver{r}
@@ -704,14 +1809,28 @@ func (r *Resolver) ChainPubKeyInput() generated.ChainPubKeyInputResolver {
 }
 
 type assetResolver struct{ *Resolver }
+type ledgerExportResolver struct{ *Resolver }
+type memberSplitResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
 type recipientResolver struct{ *Resolver }
+type recipientInviteResolver struct{ *Resolver }
 type splitResolver struct{ *Resolver }
+type splitActivityResolver struct{ *Resolver }
+type splitBalancesUpdateResolver struct{ *Resolver }
+type splitDeletionApprovalResolver struct{ *Resolver }
+type splitDeletionRequestResolver struct{ *Resolver }
 type splitFiUserResolver struct{ *Resolver }
+type splitGroupResolver struct{ *Resolver }
+type splitLedgerEntryResolver struct{ *Resolver }
+type splitMemberResolver struct{ *Resolver }
+type splitReceivedFundsNotificationResolver struct{ *Resolver }
+type splitRevisionResolver struct{ *Resolver }
+type splitTemplateResolver struct{ *Resolver }
 type subscriptionResolver struct{ *Resolver }
 type userEmailResolver struct{ *Resolver }
 type viewerResolver struct{ *Resolver }
 type walletResolver struct{ *Resolver }
+type webhookResolver struct{ *Resolver }
 type chainAddressInputResolver struct{ *Resolver }
 type chainPubKeyInputResolver struct{ *Resolver }
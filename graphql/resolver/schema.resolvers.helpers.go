@@ -14,26 +14,39 @@ import (
 	"github.com/SplitFi/go-splitfi/validate"
 	"github.com/gammazero/workerpool"
 	"github.com/magiclabs/magic-admin-go/token"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/SplitFi/go-splitfi/debugtools"
 
 	db "github.com/SplitFi/go-splitfi/db/gen/coredb"
 	"github.com/SplitFi/go-splitfi/publicapi"
 	"github.com/SplitFi/go-splitfi/service/auth"
+	"github.com/SplitFi/go-splitfi/service/distribution"
+	"github.com/SplitFi/go-splitfi/service/mediamapper"
 	"github.com/SplitFi/go-splitfi/service/persist"
+	"github.com/SplitFi/go-splitfi/service/splitupdates"
+	"github.com/SplitFi/go-splitfi/util"
 )
 
 var errNoAuthMechanismFound = fmt.Errorf("no auth mechanism found")
 
 var nodeFetcher = model.NodeFetcher{
-	OnAsset:       resolveAssetByAssetID,
-	OnToken:       resolveTokenByTokenID,
-	OnSplit:       resolveSplitBySplitID,
-	OnRecipient:   resolveRecipientByRecipientID,
-	OnSplitFiUser: resolveSplitFiUserByUserID,
-	OnWallet:      resolveWalletByAddress,
-	OnViewer:      resolveViewerByID,
-	OnDeletedNode: resolveDeletedNodeByID,
+	OnAsset:                          resolveAssetByAssetID,
+	OnToken:                          resolveTokenByTokenID,
+	OnSplit:                          resolveSplitBySplitID,
+	OnSplitGroup:                     resolveSplitGroupBySplitGroupID,
+	OnRecipient:                      resolveRecipientByRecipientID,
+	OnSplitFiUser:                    resolveSplitFiUserByUserID,
+	OnWallet:                         resolveWalletByAddress,
+	OnViewer:                         resolveViewerByID,
+	OnDeletedNode:                    resolveDeletedNodeByID,
+	OnSplitReceivedFundsNotification: resolveSplitReceivedFundsNotificationByID,
+	OnWebhook:                        resolveWebhookByWebhookID,
+	OnWebhookDelivery:                resolveWebhookDeliveryByDeliveryID,
 }
 
 func init() {
@@ -70,6 +83,17 @@ func errorToGraphqlType(ctx context.Context, err error, gqlTypeName string) (gql
 	//	mappedErr = model.ErrUnknownAction{Message: message}
 	case persist.ErrSplitNotFound:
 		mappedErr = model.ErrSplitNotFound{Message: message}
+	case persist.ErrSplitGroupNotFound:
+		mappedErr = model.ErrSplitGroupNotFound{Message: message}
+	case persist.ErrSplitMediaNotFound:
+		mappedErr = model.ErrSplitMediaNotFound{Message: message}
+	case persist.ErrRecipientInviteNotFound:
+		mappedErr = model.ErrRecipientInviteNotFound{Message: message}
+	case persist.ErrWebhookNotFound:
+		mappedErr = model.ErrWebhookNotFound{Message: message}
+	case persist.ErrSplitRoleRequired:
+		roleErr, _ := err.(persist.ErrSplitRoleRequired)
+		mappedErr = model.ErrNotAuthorized{Message: message, Cause: model.ErrSplitRoleRequired{Message: message, Role: *splitRoleToModel(roleErr.Role)}}
 	}
 	// TODO add missing errors
 	if mappedErr != nil {
@@ -139,11 +163,7 @@ func resolveRecipientByRecipientID(ctx context.Context, recipientID persist.DBID
 		return nil, err
 	}
 
-	//return userToModel(ctx, *user), nil
-
-	return &model.Recipient{
-		Address: &recipient.Address,
-	}, nil
+	return recipientToModel(ctx, *recipient), nil
 }
 
 func resolveSplitFiUserByAddress(ctx context.Context, chainAddress persist.ChainAddress) (*model.SplitFiUser, error) {
@@ -181,6 +201,33 @@ func resolveSplitBySplitID(ctx context.Context, splitID persist.DBID) (*model.Sp
 	return split, nil
 }
 
+func resolveSplitGroupBySplitGroupID(ctx context.Context, groupID persist.DBID) (*model.SplitGroup, error) {
+	group, err := publicapi.For(ctx).Split.GetSplitGroupByID(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	return splitGroupToModel(*group), nil
+}
+
+func resolveWebhookByWebhookID(ctx context.Context, webhookID persist.DBID) (*model.Webhook, error) {
+	w, err := publicapi.For(ctx).Webhook.GetWebhookByID(ctx, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	return webhookToModel(w), nil
+}
+
+func resolveWebhookDeliveryByDeliveryID(ctx context.Context, deliveryID persist.DBID) (*model.WebhookDelivery, error) {
+	d, err := publicapi.For(ctx).Webhook.GetWebhookDeliveryByID(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	return webhookDeliveryToModel(d), nil
+}
+
 func resolveViewerSplitBySplitID(ctx context.Context, splitID persist.DBID) (*model.ViewerSplit, error) {
 	split, err := publicapi.For(ctx).Split.GetViewerSplitById(ctx, splitID)
 
@@ -368,11 +415,37 @@ func notificationsToEdges(notifs []db.Notification) ([]*model.NotificationEdge,
 func notificationToModel(notif db.Notification) (model.Notification, error) {
 	switch notif.Action {
 	// TODO extend with custom notification actions
+	case persist.ActionSplitReceivedFunds:
+		return splitReceivedFundsNotificationToModel(notif), nil
 	default:
 		return nil, fmt.Errorf("unknown notification action: %s", notif.Action)
 	}
 }
 
+func splitReceivedFundsNotificationToModel(notif db.Notification) *model.SplitReceivedFundsNotification {
+	count := int(notif.Amount)
+	return &model.SplitReceivedFundsNotification{
+		HelperSplitReceivedFundsNotificationData: model.HelperSplitReceivedFundsNotificationData{
+			SplitID: notif.SplitID,
+		},
+		Dbid:         notif.ID,
+		Seen:         &notif.Seen,
+		CreationTime: &notif.CreatedAt,
+		UpdatedTime:  &notif.LastUpdated,
+		Count:        &count,
+		Split:        nil, // handled by dedicated resolver
+	}
+}
+
+func resolveSplitReceivedFundsNotificationByID(ctx context.Context, notificationID persist.DBID) (*model.SplitReceivedFundsNotification, error) {
+	notif, err := publicapi.For(ctx).Notifications.GetByID(ctx, notificationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return splitReceivedFundsNotificationToModel(notif), nil
+}
+
 func resolveViewerNotificationSettings(ctx context.Context) (*model.NotificationSettings, error) {
 
 	userID := publicapi.For(ctx).User.GetLoggedInUserId(ctx)
@@ -455,6 +528,86 @@ func resolveUpdatedNotificationSubscription(ctx context.Context) <-chan model.No
 	return result
 }
 
+func resolveSplitActivitySubscription(ctx context.Context, splitID persist.DBID) (<-chan *model.SplitActivity, error) {
+	sub, err := publicapi.For(ctx).Split.SubscribeSplitUpdates(ctx, splitID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(chan *model.SplitActivity)
+
+	go func() {
+		defer close(result)
+		defer sub.Unsubscribe()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case update, ok := <-sub.Updates():
+				if !ok {
+					return
+				}
+				if update.Type != splitupdates.UpdateTypeActivity {
+					continue
+				}
+				if err := publicapi.For(ctx).Split.RequireSplitUpdatesAccess(ctx, splitID); err != nil {
+					logger.For(ctx).Infof("ending split activity subscription: %s", err)
+					return
+				}
+				for _, activity := range update.Activity {
+					select {
+					case result <- splitActivityToModel(activity):
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}
+	}()
+
+	return result, nil
+}
+
+func resolveSplitBalancesSubscription(ctx context.Context, splitID persist.DBID) (<-chan *model.SplitBalancesUpdate, error) {
+	sub, err := publicapi.For(ctx).Split.SubscribeSplitUpdates(ctx, splitID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(chan *model.SplitBalancesUpdate)
+
+	go func() {
+		defer close(result)
+		defer sub.Unsubscribe()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case update, ok := <-sub.Updates():
+				if !ok {
+					return
+				}
+				if update.Type != splitupdates.UpdateTypeBalances {
+					continue
+				}
+				if err := publicapi.For(ctx).Split.RequireSplitUpdatesAccess(ctx, splitID); err != nil {
+					logger.For(ctx).Infof("ending split balances subscription: %s", err)
+					return
+				}
+				select {
+				case result <- splitBalancesUpdateToModel(update):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return result, nil
+}
+
 func resolveGroupNotificationUsersConnectionByUserIDs(ctx context.Context, userIDs persist.DBIDList, before *string, after *string, first *int, last *int) (*model.GroupNotificationUsersConnection, error) {
 	if len(userIDs) == 0 {
 		return &model.GroupNotificationUsersConnection{
@@ -594,18 +747,667 @@ func unsubscribeFromEmailType(ctx context.Context, input model.UnsubscribeFromEm
 func splitToModel(ctx context.Context, split db.Split) *model.Split {
 
 	return &model.Split{
-		Dbid:        split.ID,
-		Name:        &split.Name,
-		Description: &split.Description,
-		Chain:       &split.Chain,
-		LogoURL:     &split.LogoUrl.String,
-		BannerURL:   &split.BannerUrl.String,
-		BadgeURL:    &split.BadgeUrl.String,
-		Assets:      nil, // handled by dedicated resolver
-		Shares:      nil, // handled by dedicated resolver
+		Dbid:           split.ID,
+		Name:           &split.Name,
+		Description:    &split.Description,
+		Chain:          &split.Chain,
+		LogoURL:        &split.LogoUrl.String,
+		BannerURL:      &split.BannerUrl.String,
+		BadgeURL:       &split.BadgeUrl.String,
+		TotalOwnership: util.ToPointer(int(split.TotalOwnership)),
+		Assets:         nil, // handled by dedicated resolver
+		Shares:         nil, // handled by dedicated resolver
+	}
+}
+
+func splitGroupToModel(group db.SplitGroup) *model.SplitGroup {
+	return &model.SplitGroup{
+		Dbid:         group.ID,
+		Name:         &group.Name,
+		Description:  &group.Description,
+		CreationTime: &group.CreatedAt,
+	}
+}
+
+// splitMediaToModel returns the sizes of a split's processed image. Splits without one fall back to the URL they
+// were given, resized by the media mapper, and splits without either have no image.
+func splitMediaToModel(ctx context.Context, m *db.SplitMedia, fallbackURL *string) *model.SplitMedia {
+	var result model.SplitMedia
+	var small, medium, large string
+
+	switch {
+	case m != nil:
+		small, medium, large = m.SmallUrl.String, m.MediumUrl.String, m.LargeUrl.String
+		if m.Blurhash.Valid {
+			result.Blurhash = &m.Blurhash.String
+		}
+		if m.AspectRatio.Valid {
+			result.AspectRatio = &m.AspectRatio.Float64
+		}
+	case fallbackURL != nil && *fallbackURL != "":
+		small, medium, large = *fallbackURL, *fallbackURL, *fallbackURL
+	default:
+		return nil
+	}
+
+	if mm := mediamapper.For(ctx); mm != nil {
+		small, medium, large = mm.GetSmallImageUrl(small), mm.GetMediumImageUrl(medium), mm.GetLargeImageUrl(large)
+	}
+
+	result.Small, result.Medium, result.Large = &small, &medium, &large
+	return &result
+}
+
+func splitMediaKindToPersist(kind model.SplitMediaKind) persist.SplitMediaKind {
+	return persist.SplitMediaKind(strings.ToLower(string(kind)))
+}
+
+func recipientToModel(ctx context.Context, recipient db.Recipient) *model.Recipient {
+	version := int(recipient.Version.Int32)
+	ownership := int(recipient.Ownership)
+
+	return &model.Recipient{
+		HelperRecipientData: model.HelperRecipientData{
+			SplitID: recipient.SplitID,
+		},
+		Dbid:         recipient.ID,
+		Version:      &version,
+		CreationTime: &recipient.CreatedAt,
+		LastUpdated:  &recipient.LastUpdated,
+		Address:      &recipient.Address,
+		Ownership:    &ownership,
+		Split:        nil, // handled by dedicated resolver
+		Claimable:    nil, // handled by dedicated resolver
+	}
+}
+
+func recipientInviteToModel(invite db.SplitRecipientInvite) *model.RecipientInvite {
+	status := model.RecipientInviteStatus(strings.ToUpper(invite.Status))
+
+	var acceptedTime *time.Time
+	if invite.AcceptedAt.Valid {
+		acceptedTime = &invite.AcceptedAt.Time
+	}
+
+	return &model.RecipientInvite{
+		HelperRecipientInviteData: model.HelperRecipientInviteData{
+			InviteeID: invite.InviteeID,
+		},
+		Dbid:         invite.ID,
+		CreationTime: &invite.CreatedAt,
+		Status:       &status,
+		LastSentTime: &invite.LastSentAt,
+		AcceptedTime: acceptedTime,
+		Invitee:      nil, // handled by dedicated resolver
+	}
+}
+
+// resolveRecipientByInvite returns the recipient an invite was sent for
+func resolveRecipientByInvite(ctx context.Context, invite db.SplitRecipientInvite) (*model.Recipient, error) {
+	recipient, err := publicapi.For(ctx).Split.GetRecipientByRecipientID(ctx, invite.RecipientID)
+	if err != nil {
+		return nil, err
+	}
+
+	return recipientToModel(ctx, *recipient), nil
+}
+
+func recipientsToEdges(ctx context.Context, recipients []db.Recipient) []*model.SplitShareEdge {
+	edges := make([]*model.SplitShareEdge, len(recipients))
+	for i, recipient := range recipients {
+		edges[i] = &model.SplitShareEdge{
+			Node:   recipientToModel(ctx, recipient),
+			Cursor: nil, // not used by relay, but relay will complain without this field existing
+		}
+	}
+	return edges
+}
+
+func splitAssetToModel(asset publicapi.SplitAsset) *model.Asset {
+	t := asset.Token
+	version := int(t.Version.Int32)
+	ownerAddress := persist.NewChainAddress(t.OwnerAddress, t.Chain)
+	amount := t.Balance.BigInt().String()
+
+	// Balances that don't fit in an Int are only available as amount
+	var balance *int
+	if b := t.Balance.BigInt(); b.IsInt64() && b.Int64() <= math.MaxInt32 {
+		balance = util.ToPointer(int(b.Int64()))
+	}
+
+	tokenType := model.TokenTypeErc20
+	if asset.TokenType == persist.TokenTypeNative {
+		tokenType = model.TokenTypeNative
+	}
+
+	var decimals *int
+	if asset.Decimals.Valid {
+		decimals = util.ToPointer(int(asset.Decimals.Int32))
+	}
+
+	return &model.Asset{
+		Dbid:         t.ID,
+		Version:      &version,
+		OwnerAddress: &ownerAddress,
+		Balance:      balance,
+		Amount:       &amount,
+		UsdValue:     asset.USDValue,
+		Token: &model.Token{
+			Dbid:         t.ID,
+			CreationTime: &t.CreatedAt,
+			LastUpdated:  &t.LastUpdated,
+			TokenType:    &tokenType,
+			Chain:        &t.Chain,
+			Name:         &asset.Name.String,
+			Symbol:       &asset.Symbol.String,
+			Decimals:     decimals,
+			Logo:         &asset.Logo.String,
+			IsSpam:       &asset.IsSpam,
+		},
+	}
+}
+
+func splitAssetsToEdges(assets []publicapi.SplitAsset) []*model.SplitAssetEdge {
+	edges := make([]*model.SplitAssetEdge, len(assets))
+	for i, asset := range assets {
+		edges[i] = &model.SplitAssetEdge{
+			Node:   splitAssetToModel(asset),
+			Cursor: nil, // not used by relay, but relay will complain without this field existing
+		}
+	}
+	return edges
+}
+
+func tokenDistributionsToModels(distributions []distribution.TokenDistribution) []*model.TokenDistribution {
+	models := make([]*model.TokenDistribution, len(distributions))
+	for i, d := range distributions {
+		d := d
+		allocations := make([]*model.RecipientAllocation, len(d.Allocations))
+		for j, a := range d.Allocations {
+			a := a
+			allocations[j] = &model.RecipientAllocation{
+				Address: &a.Address,
+				Amount:  util.ToPointer(a.Amount.String()),
+			}
+		}
+		models[i] = &model.TokenDistribution{
+			Chain:         &d.Chain,
+			TokenAddress:  &d.TokenAddress,
+			Balance:       util.ToPointer(d.Balance.String()),
+			Distributed:   util.ToPointer(d.Distributed.String()),
+			Undistributed: util.ToPointer(d.Undistributed.String()),
+			Allocations:   allocations,
+		}
+	}
+
+	return models
+}
+
+func splitComparisonsToModels(comparisons []distribution.Comparison) []*model.SimulatedTokenDistribution {
+	models := make([]*model.SimulatedTokenDistribution, len(comparisons))
+	for i, c := range comparisons {
+		c := c
+		recipients := make([]*model.SimulatedRecipientAllocation, len(c.Recipients))
+		for j, r := range c.Recipients {
+			r := r
+			recipients[j] = &model.SimulatedRecipientAllocation{
+				Address: &r.Address,
+				Before:  util.ToPointer(r.Before.String()),
+				After:   util.ToPointer(r.After.String()),
+				Change:  util.ToPointer(new(big.Int).Sub(r.After, r.Before).String()),
+			}
+		}
+		models[i] = &model.SimulatedTokenDistribution{
+			Chain:               &c.Chain,
+			TokenAddress:        &c.TokenAddress,
+			Amount:              util.ToPointer(c.Amount.String()),
+			UndistributedBefore: util.ToPointer(c.Before.Undistributed.String()),
+			UndistributedAfter:  util.ToPointer(c.After.Undistributed.String()),
+			Recipients:          recipients,
+		}
+	}
+
+	return models
+}
+
+func claimsToModels(claims []distribution.Claim) []*model.ClaimableAmount {
+	models := make([]*model.ClaimableAmount, len(claims))
+	for i, c := range claims {
+		c := c
+		models[i] = &model.ClaimableAmount{
+			Chain:        &c.Chain,
+			TokenAddress: &c.TokenAddress,
+			Amount:       util.ToPointer(c.Amount.String()),
+		}
+	}
+
+	return models
+}
+
+func tokenAmountsToModels(amounts []distribution.Claim) []*model.TokenAmount {
+	models := make([]*model.TokenAmount, len(amounts))
+	for i, a := range amounts {
+		a := a
+		models[i] = &model.TokenAmount{
+			Chain:        &a.Chain,
+			TokenAddress: &a.TokenAddress,
+			Amount:       util.ToPointer(a.Amount.String()),
+		}
+	}
+
+	return models
+}
+
+func memberSplitToModel(ctx context.Context, split publicapi.MemberSplit) *model.MemberSplit {
+	ownership := int(split.Ownership)
+
+	return &model.MemberSplit{
+		HelperMemberSplitData: model.HelperMemberSplitData{
+			SplitID:   split.Split.ID,
+			Addresses: split.Addresses,
+		},
+		Split:            splitToModel(ctx, split.Split),
+		Ownership:        &ownership,
+		Claimable:        nil, // handled by dedicated resolver
+		LifetimeEarnings: nil, // handled by dedicated resolver
 	}
 }
 
+func splitMemberToModel(member db.SplitMember) *model.SplitMember {
+	return &model.SplitMember{
+		HelperSplitMemberData: model.HelperSplitMemberData{
+			UserID: member.UserID,
+		},
+		Dbid:         member.ID,
+		CreationTime: &member.CreatedAt,
+		User:         nil, // handled by dedicated resolver
+		Role:         splitRoleToModel(persist.SplitRole(member.Role)),
+	}
+}
+
+func splitRoleToModel(role persist.SplitRole) *model.SplitRole {
+	r := model.SplitRole(strings.ToUpper(string(role)))
+	return &r
+}
+
+func splitRoleToPersist(role model.SplitRole) persist.SplitRole {
+	return persist.SplitRole(strings.ToLower(string(role)))
+}
+
+func ledgerEntryToModel(entry db.SplitLedgerEntry) *model.SplitLedgerEntry {
+	entryType := model.SplitLedgerEntryTypeDistribution
+	if entry.EntryType == persist.LedgerEntryTypeWithdrawal {
+		entryType = model.SplitLedgerEntryTypeWithdrawal
+	}
+
+	return &model.SplitLedgerEntry{
+		HelperSplitLedgerEntryData: model.HelperSplitLedgerEntryData{
+			SplitID: entry.SplitID,
+		},
+		Dbid:             entry.ID,
+		CreationTime:     &entry.CreatedAt,
+		EntryType:        &entryType,
+		Split:            nil, // handled by dedicated resolver
+		Chain:            &entry.Chain,
+		TokenAddress:     &entry.TokenAddress,
+		RecipientAddress: &entry.RecipientAddress,
+		Amount:           util.ToPointer(entry.Amount.BigInt().String()),
+		SplitBalance:     util.ToPointer(entry.SplitBalance.BigInt().String()),
+		TxHash:           &entry.TxHash,
+		BlockNumber:      util.ToPointer(strconv.FormatInt(entry.BlockNumber, 10)),
+	}
+}
+
+func ledgerEntriesToEdges(entries []db.SplitLedgerEntry) []*model.SplitLedgerEntryEdge {
+	edges := make([]*model.SplitLedgerEntryEdge, len(entries))
+	for i, entry := range entries {
+		edges[i] = &model.SplitLedgerEntryEdge{
+			Node:   ledgerEntryToModel(entry),
+			Cursor: nil, // not used by relay, but relay will complain without this field existing
+		}
+	}
+	return edges
+}
+
+func effectiveSharesToModels(shares []distribution.EffectiveShare) []*model.EffectiveOwnership {
+	models := make([]*model.EffectiveOwnership, len(shares))
+	for i, s := range shares {
+		address := s.Address
+		percentage, _ := new(big.Rat).Mul(s.Fraction, big.NewRat(100, 1)).Float64()
+		models[i] = &model.EffectiveOwnership{
+			Address:    &address,
+			Percentage: &percentage,
+		}
+	}
+	return models
+}
+
+func ledgerExportToModel(export db.LedgerExport) *model.LedgerExport {
+	format := model.LedgerExportFormat(strings.ToUpper(export.Format))
+	status := model.LedgerExportStatus(strings.ToUpper(export.Status))
+	rowCount := int(export.RowCount)
+
+	return &model.LedgerExport{
+		Dbid:         export.ID,
+		CreationTime: &export.CreatedAt,
+		Format:       &format,
+		Status:       &status,
+		RowCount:     &rowCount,
+	}
+}
+
+func ledgerExportFormatToPersist(format model.LedgerExportFormat) persist.LedgerExportFormat {
+	return persist.LedgerExportFormat(strings.ToLower(string(format)))
+}
+
+func webhookToModel(w db.Webhook) *model.Webhook {
+	eventTypes := make([]model.WebhookEventType, 0, len(w.EventTypes))
+	for _, t := range w.EventTypes {
+		if eventType := webhookEventTypeToModel(persist.WebhookEventType(t)); eventType != nil {
+			eventTypes = append(eventTypes, *eventType)
+		}
+	}
+
+	return &model.Webhook{
+		HelperWebhookData: model.HelperWebhookData{SplitID: w.SplitID},
+		Dbid:              w.ID,
+		URL:               &w.Url,
+		EventTypes:        eventTypes,
+		Enabled:           &w.Enabled,
+		CreationTime:      &w.CreatedAt,
+		LastUpdated:       &w.LastUpdated,
+	}
+}
+
+func webhookDeliveryToModel(d db.WebhookDelivery) *model.WebhookDelivery {
+	status := model.WebhookDeliveryStatus(strings.ToUpper(d.Status))
+	payload := string(d.Payload)
+	attempts := int(d.Attempts)
+
+	m := &model.WebhookDelivery{
+		Dbid:         d.ID,
+		EventType:    webhookEventTypeToModel(d.EventType),
+		Status:       &status,
+		Payload:      &payload,
+		Attempts:     &attempts,
+		CreationTime: &d.CreatedAt,
+	}
+	if d.ResponseStatus.Valid {
+		responseStatus := int(d.ResponseStatus.Int32)
+		m.ResponseStatus = &responseStatus
+	}
+	if d.Error.Valid {
+		m.Error = &d.Error.String
+	}
+	if d.NextAttemptAt.Valid {
+		m.NextAttemptTime = &d.NextAttemptAt.Time
+	}
+	if d.CompletedAt.Valid {
+		m.CompletionTime = &d.CompletedAt.Time
+	}
+
+	return m
+}
+
+var webhookEventTypes = map[model.WebhookEventType]persist.WebhookEventType{
+	model.WebhookEventTypeInflow:             persist.WebhookEventTypeInflow,
+	model.WebhookEventTypeDistribution:       persist.WebhookEventTypeDistribution,
+	model.WebhookEventTypeRecipientsChanged:  persist.WebhookEventTypeRecipientsChanged,
+	model.WebhookEventTypeControllersChanged: persist.WebhookEventTypeControllersChanged,
+	model.WebhookEventTypePing:               persist.WebhookEventTypePing,
+}
+
+func webhookEventTypeToModel(eventType persist.WebhookEventType) *model.WebhookEventType {
+	for m, p := range webhookEventTypes {
+		if p == eventType {
+			return &m
+		}
+	}
+	return nil
+}
+
+// webhookEventTypesToPersist converts event types, keeping nil as nil so that unset arguments stay unset
+func webhookEventTypesToPersist(eventTypes []model.WebhookEventType) []persist.WebhookEventType {
+	if eventTypes == nil {
+		return nil
+	}
+
+	converted := make([]persist.WebhookEventType, len(eventTypes))
+	for i, t := range eventTypes {
+		converted[i] = webhookEventTypes[t]
+	}
+	return converted
+}
+
+// cardOptionToPersist converts an optional card format, theme or size to the name the card service knows it by
+func cardOptionToPersist[T model.SplitCardFormat | model.SplitCardTheme | model.SplitCardSize](option *T) string {
+	if option == nil {
+		return ""
+	}
+	return strings.ToLower(string(*option))
+}
+
+func splitInflowReportToModel(report publicapi.SplitInflowReport) *model.SplitAnalytics {
+	inflowCount := report.InflowCount
+
+	tokens := make([]*model.SplitInflowTokenTotal, len(report.Tokens))
+	for i, t := range report.Tokens {
+		t := t
+		count := int(t.InflowCount)
+		tokens[i] = &model.SplitInflowTokenTotal{
+			Chain:        &t.Chain,
+			TokenAddress: &t.TokenAddress,
+			Amount:       &t.Amount,
+			UsdValue:     &t.UsdValue,
+			InflowCount:  &count,
+		}
+	}
+
+	payers := make([]*model.SplitInflowPayer, len(report.TopPayers))
+	for i, p := range report.TopPayers {
+		p := p
+		count := int(p.InflowCount)
+		payers[i] = &model.SplitInflowPayer{
+			Address:     &p.PayerAddress,
+			UsdValue:    &p.UsdValue,
+			InflowCount: &count,
+		}
+	}
+
+	buckets := make([]*model.SplitInflowBucket, len(report.TimeSeries))
+	for i, b := range report.TimeSeries {
+		b := b
+		count := int(b.InflowCount)
+		buckets[i] = &model.SplitInflowBucket{
+			Start:       &b.BucketStart,
+			UsdValue:    &b.UsdValue,
+			InflowCount: &count,
+		}
+	}
+
+	return &model.SplitAnalytics{
+		Window:      &report.Window,
+		TotalUsd:    &report.TotalUSD,
+		InflowCount: &inflowCount,
+		Tokens:      tokens,
+		TopPayers:   payers,
+		TimeSeries:  buckets,
+	}
+}
+
+func splitTemplateToModel(template db.SplitTemplate) *model.SplitTemplate {
+	return &model.SplitTemplate{
+		Dbid:           template.ID,
+		Name:           &template.Name,
+		Description:    &template.Description,
+		LogoURL:        &template.LogoUrl.String,
+		BannerURL:      &template.BannerUrl.String,
+		BadgeURL:       &template.BadgeUrl.String,
+		TotalOwnership: util.ToPointer(int(template.TotalOwnership)),
+		Recipients:     nil, // handled by dedicated resolver
+	}
+}
+
+func splitTemplateRecipientsToModels(recipients []db.SplitTemplateRecipient) []*model.SplitTemplateRecipient {
+	models := make([]*model.SplitTemplateRecipient, len(recipients))
+	for i, r := range recipients {
+		address := r.Address
+		models[i] = &model.SplitTemplateRecipient{
+			Address:   &address,
+			Ownership: util.ToPointer(int(r.Ownership)),
+		}
+	}
+	return models
+}
+
+func contactToModel(contact db.Contact) *model.Contact {
+	return &model.Contact{
+		Dbid:         contact.ID,
+		CreationTime: &contact.CreatedAt,
+		LastUpdated:  &contact.LastUpdated,
+		Chain:        &contact.Chain,
+		Address:      &contact.Address,
+		Label:        &contact.Label,
+		Notes:        &contact.Notes,
+	}
+}
+
+func contactsToModels(contacts []db.Contact) []*model.Contact {
+	models := make([]*model.Contact, len(contacts))
+	for i, c := range contacts {
+		models[i] = contactToModel(c)
+	}
+	return models
+}
+
+// splitDraftToModel previews split with the unpublished edits in draft applied
+func splitDraftToModel(split *model.Split, draft db.SplitDraft) *model.SplitDraft {
+	preview := *split
+	if draft.Name.Valid {
+		preview.Name = &draft.Name.String
+	}
+	if draft.Description.Valid {
+		preview.Description = &draft.Description.String
+	}
+
+	return &model.SplitDraft{
+		EditID:      draft.EditID,
+		Preview:     &preview,
+		LastUpdated: &draft.LastUpdated,
+		ExpiresAt:   &draft.ExpiresAt,
+	}
+}
+
+func revisionToModel(revision db.SplitRevision) *model.SplitRevision {
+	var source model.SplitRevisionSource
+	switch revision.Source {
+	case persist.RevisionSourceWebhook:
+		source = model.SplitRevisionSourceWebhook
+	case persist.RevisionSourceAdmin:
+		source = model.SplitRevisionSourceAdmin
+	default:
+		source = model.SplitRevisionSourceUI
+	}
+
+	recipients := make([]*model.SplitRevisionRecipient, len(revision.Recipients))
+	for i, r := range revision.Recipients {
+		address := r.Address
+		recipients[i] = &model.SplitRevisionRecipient{
+			Address:   &address,
+			Ownership: util.ToPointer(int(r.Ownership)),
+		}
+	}
+
+	return &model.SplitRevision{
+		HelperSplitRevisionData: model.HelperSplitRevisionData{
+			ActorID: persist.DBID(revision.ActorID.String),
+		},
+		Dbid:           revision.ID,
+		Revision:       int(revision.Revision),
+		CreationTime:   &revision.CreatedAt,
+		Source:         &source,
+		Actor:          nil, // handled by dedicated resolver
+		Name:           &revision.Name,
+		Description:    &revision.Description,
+		LogoURL:        &revision.LogoUrl.String,
+		BannerURL:      &revision.BannerUrl.String,
+		BadgeURL:       &revision.BadgeUrl.String,
+		TotalOwnership: util.ToPointer(int(revision.TotalOwnership)),
+		Recipients:     recipients,
+	}
+}
+
+func revisionsToEdges(revisions []db.SplitRevision) []*model.SplitRevisionEdge {
+	edges := make([]*model.SplitRevisionEdge, len(revisions))
+	for i, revision := range revisions {
+		edges[i] = &model.SplitRevisionEdge{
+			Node:   revisionToModel(revision),
+			Cursor: nil, // not used by relay, but relay will complain without this field existing
+		}
+	}
+	return edges
+}
+
+func revisionDiffToModel(diff persist.SplitRevisionDiff) *model.SplitRevisionDiff {
+	fields := make([]*model.SplitRevisionFieldChange, len(diff.Fields))
+	for i, f := range diff.Fields {
+		from, to := f.From, f.To
+		fields[i] = &model.SplitRevisionFieldChange{
+			Field: f.Field,
+			From:  &from,
+			To:    &to,
+		}
+	}
+
+	recipients := make([]*model.SplitRevisionRecipientChange, len(diff.Recipients))
+	for i, r := range diff.Recipients {
+		recipients[i] = &model.SplitRevisionRecipientChange{
+			Address:       r.Address,
+			FromOwnership: int32PointerToIntPointer(r.From),
+			ToOwnership:   int32PointerToIntPointer(r.To),
+		}
+	}
+
+	return &model.SplitRevisionDiff{
+		FromRevision: int(diff.FromRevision),
+		ToRevision:   int(diff.ToRevision),
+		Fields:       fields,
+		Recipients:   recipients,
+	}
+}
+
+func splitOnchainStatusToModel(status persist.SplitOnchainStatus) *model.SplitOnchainStatus {
+	syncStatus := model.SplitOnchainSyncStatusDrifted
+	if status.InSync() {
+		syncStatus = model.SplitOnchainSyncStatusInSync
+	}
+
+	drift := make([]*model.SplitRecipientDrift, len(status.Drift))
+	for i, d := range status.Drift {
+		address := d.Address
+		drift[i] = &model.SplitRecipientDrift{
+			Address:          &address,
+			Ownership:        int32PointerToIntPointer(d.Ownership),
+			OnchainOwnership: int32PointerToIntPointer(d.OnchainOwnership),
+		}
+	}
+
+	return &model.SplitOnchainStatus{
+		Status:                &syncStatus,
+		CheckedAt:             &status.CheckedAt,
+		TotalOwnership:        util.ToPointer(int(status.TotalOwnership)),
+		OnchainTotalOwnership: util.ToPointer(int(status.OnchainTotalOwnership)),
+		Drift:                 drift,
+	}
+}
+
+func int32PointerToIntPointer(i *int32) *int {
+	if i == nil {
+		return nil
+	}
+	return util.ToPointer(int(*i))
+}
+
 func splitsToModels(ctx context.Context, splits []db.Split) []*model.Split {
 	models := make([]*model.Split, len(splits))
 	for i, split := range splits {
@@ -695,3 +1497,108 @@ func pageInfoToModel(ctx context.Context, pageInfo publicapi.PageInfo) *model.Pa
 		EndCursor:       pageInfo.EndCursor,
 	}
 }
+
+func splitDeletionRequestToModel(request db.SplitDeletionRequest) *model.SplitDeletionRequest {
+	return &model.SplitDeletionRequest{
+		HelperSplitDeletionRequestData: model.HelperSplitDeletionRequestData{
+			SplitID:     request.SplitID,
+			RequesterID: request.RequesterID,
+		},
+		Dbid:              request.ID,
+		CreationTime:      &request.CreatedAt,
+		Requester:         nil, // handled by dedicated resolver
+		Quorum:            util.ToPointer(int(request.Quorum)),
+		ApprovedOwnership: nil, // handled by dedicated resolver
+		Approvals:         nil, // handled by dedicated resolver
+	}
+}
+
+func splitDeletionApprovalsToModels(approvals []db.SplitDeletionApproval) []*model.SplitDeletionApproval {
+	models := make([]*model.SplitDeletionApproval, len(approvals))
+	for i, a := range approvals {
+		a := a
+		models[i] = &model.SplitDeletionApproval{
+			HelperSplitDeletionApprovalData: model.HelperSplitDeletionApprovalData{
+				ApproverID: a.ApproverID,
+			},
+			Dbid:         a.ID,
+			CreationTime: &a.CreatedAt,
+			Approver:     nil, // handled by dedicated resolver
+			Address:      &a.Address,
+			Ownership:    util.ToPointer(int(a.Ownership)),
+		}
+	}
+	return models
+}
+
+func splitsToEdges(ctx context.Context, splits []db.Split) []*model.SplitEdge {
+	edges := make([]*model.SplitEdge, len(splits))
+	for i, split := range splits {
+		edges[i] = &model.SplitEdge{
+			Node:   splitToModel(ctx, split),
+			Cursor: nil, // not used by relay, but relay will complain without this field existing
+		}
+	}
+	return edges
+}
+
+func viewerSplitsToModels(ctx context.Context, splits []db.GetSplitsWithUserSettingsByUserIDRow) []*model.ViewerSplit {
+	models := make([]*model.ViewerSplit, len(splits))
+	for i, s := range splits {
+		var position *string
+		if s.Position.Valid {
+			position = util.ToPointer(s.Position.String)
+		}
+
+		models[i] = &model.ViewerSplit{
+			Split:    splitToModel(ctx, s.Split),
+			Hidden:   util.ToPointer(s.Hidden),
+			Position: position,
+		}
+	}
+	return models
+}
+
+func splitActivityToModel(activity splitupdates.Activity) *model.SplitActivity {
+	activityType := splitActivityTypeToModel(activity.Type)
+	return &model.SplitActivity{
+		HelperSplitActivityData: model.HelperSplitActivityData{SplitID: activity.SplitID},
+		Split:                   nil, // handled by dedicated resolver
+		ActivityType:            &activityType,
+		Chain:                   &activity.Chain,
+		TokenAddress:            &activity.TokenAddress,
+		CounterpartyAddress:     &activity.CounterpartyAddress,
+		Amount:                  &activity.Amount,
+		TxHash:                  &activity.TxHash,
+		BlockNumber:             util.ToPointer(strconv.FormatInt(activity.BlockNumber, 10)),
+	}
+}
+
+func splitActivityTypeToModel(t splitupdates.ActivityType) model.SplitActivityType {
+	switch t {
+	case splitupdates.ActivityTypeDistribution:
+		return model.SplitActivityTypeDistribution
+	case splitupdates.ActivityTypeWithdrawal:
+		return model.SplitActivityTypeWithdrawal
+	default:
+		return model.SplitActivityTypeInflow
+	}
+}
+
+func splitBalancesUpdateToModel(update splitupdates.Update) *model.SplitBalancesUpdate {
+	balances := make([]*model.TokenAmount, len(update.Balances))
+	for i, b := range update.Balances {
+		b := b
+		balances[i] = &model.TokenAmount{
+			Chain:        &b.Chain,
+			TokenAddress: &b.TokenAddress,
+			Amount:       &b.Amount,
+		}
+	}
+	return &model.SplitBalancesUpdate{
+		HelperSplitBalancesUpdateData: model.HelperSplitBalancesUpdateData{SplitID: update.SplitID},
+		Split:                         nil, // handled by dedicated resolver
+		Balances:                      balances,
+		UpdatedTime:                   &update.CreatedAt,
+	}
+}
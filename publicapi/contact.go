@@ -0,0 +1,304 @@
+package publicapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jackc/pgx/v4"
+
+	db "github.com/SplitFi/go-splitfi/db/gen/coredb"
+	"github.com/SplitFi/go-splitfi/graphql/dataloader"
+	"github.com/SplitFi/go-splitfi/graphql/model"
+	"github.com/SplitFi/go-splitfi/service/persist"
+	"github.com/SplitFi/go-splitfi/service/persist/postgres"
+	"github.com/SplitFi/go-splitfi/util"
+	"github.com/SplitFi/go-splitfi/validate"
+)
+
+const maxContactLabelLength = 100
+const maxContactNotesLength = 600
+const maxContactsImported = 1000
+const defaultContactsLimit = 20
+
+// contactsCSVHeader is the header row of an exported address book, which is also what an import expects
+var contactsCSVHeader = []string{"chain", "address", "label", "notes"}
+
+type ContactAPI struct {
+	repos     *postgres.Repositories
+	queries   *db.Queries
+	loaders   *dataloader.Loaders
+	validator *validator.Validate
+}
+
+// SaveContact adds an address to the viewer's address book, or updates its label and notes if it is already there
+func (api ContactAPI) SaveContact(ctx context.Context, input model.SaveContactInput) (db.Contact, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"address": validate.WithTag(input.Address, "required"),
+		"label":   validate.WithTag(strings.TrimSpace(input.Label), fmt.Sprintf("required,max=%d", maxContactLabelLength)),
+		"notes":   validate.WithTag(input.Notes, fmt.Sprintf("omitempty,max=%d", maxContactNotesLength)),
+	}); err != nil {
+		return db.Contact{}, err
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return db.Contact{}, err
+	}
+
+	return api.queries.UpsertContact(ctx, db.UpsertContactParams{
+		ID:      persist.GenerateID(),
+		OwnerID: userID,
+		Chain:   input.Chain,
+		Address: persist.Address(input.Chain.NormalizeAddress(input.Address)),
+		Label:   strings.TrimSpace(input.Label),
+		Notes:   util.FromPointer(input.Notes),
+	})
+}
+
+// DeleteContact removes a contact from the viewer's address book
+func (api ContactAPI) DeleteContact(ctx context.Context, contactID persist.DBID) error {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"contactID": validate.WithTag(contactID, "required"),
+	}); err != nil {
+		return err
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	contact, err := api.queries.GetContactByID(ctx, contactID)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && contact.OwnerID != userID) {
+		return persist.ErrContactNotFound{ID: contactID}
+	}
+	if err != nil {
+		return err
+	}
+
+	return api.queries.DeleteContact(ctx, contactID)
+}
+
+// GetViewerContacts returns the viewer's address book ordered by label. If query is not empty, only contacts whose
+// label contains it or whose address starts with it are returned, up to limit.
+func (api ContactAPI) GetViewerContacts(ctx context.Context, query string, limit *int) ([]db.Contact, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"query": validate.WithTag(query, fmt.Sprintf("max=%d", maxContactLabelLength)),
+		"limit": validate.WithTag(limit, "omitempty,min=1,max=100"),
+	}); err != nil {
+		return nil, err
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query = strings.TrimSpace(query)
+	if query == "" {
+		contacts, err := api.queries.GetContactsByOwnerID(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		if limit != nil && len(contacts) > *limit {
+			contacts = contacts[:*limit]
+		}
+		return contacts, nil
+	}
+
+	searchLimit := defaultContactsLimit
+	if limit != nil {
+		searchLimit = *limit
+	}
+
+	return api.queries.SearchContactsByOwnerID(ctx, db.SearchContactsByOwnerIDParams{
+		OwnerID: userID,
+		Query:   escapeLikePattern(query),
+		Limit:   int32(searchLimit),
+	})
+}
+
+// GetRecipientLabel returns the viewer's label for a split recipient's address, or nil if the viewer is logged out
+// or hasn't labeled the address
+func (api ContactAPI) GetRecipientLabel(ctx context.Context, splitID persist.DBID, address persist.Address) (*string, error) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, nil
+	}
+
+	split, err := api.loaders.GetSplitByIdBatch.Load(splitID)
+	if err != nil {
+		return nil, err
+	}
+
+	contact, err := api.loaders.GetContactByOwnerChainAddressBatch.Load(db.GetContactByOwnerChainAddressBatchParams{
+		OwnerID: userID,
+		Chain:   split.Chain,
+		Address: persist.Address(split.Chain.NormalizeAddress(address)),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &contact.Label, nil
+}
+
+// ExportContacts returns the viewer's address book as CSV
+func (api ContactAPI) ExportContacts(ctx context.Context) (string, error) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	contacts, err := api.queries.GetContactsByOwnerID(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	if err := w.Write(contactsCSVHeader); err != nil {
+		return "", err
+	}
+
+	for _, c := range contacts {
+		if err := w.Write([]string{c.Chain.Name(), c.Address.String(), c.Label, c.Notes}); err != nil {
+			return "", err
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// ImportContacts adds every contact in a CSV export to the viewer's address book, updating contacts that
+// are already there. It returns the number of contacts imported.
+func (api ContactAPI) ImportContacts(ctx context.Context, data string) (int, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"csv": validate.WithTag(data, "required"),
+	}); err != nil {
+		return 0, err
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	invalid := func(reason string) error {
+		return validate.ErrInvalidInput{Parameters: []string{"csv"}, Reasons: []string{reason}}
+	}
+
+	r := csv.NewReader(strings.NewReader(data))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if err != nil {
+		return 0, invalid("missing header row")
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, h := range header {
+		columns[strings.ToLower(strings.TrimSpace(h))] = i
+	}
+	for _, required := range contactsCSVHeader[:3] {
+		if _, ok := columns[required]; !ok {
+			return 0, invalid(fmt.Sprintf("missing %s column", required))
+		}
+	}
+
+	field := func(record []string, column string) string {
+		if i, ok := columns[column]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	var params db.UpsertContactsParams
+	params.OwnerID = userID.String()
+
+	// Later rows win if the same address appears more than once, as the insert can't update a row twice
+	index := make(map[string]int)
+
+	for line := 2; ; line++ {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, invalid(err.Error())
+		}
+
+		var chain persist.Chain
+		name := field(record, "chain")
+		if err := chain.UnmarshalGQL(name); err != nil || !strings.EqualFold(chain.Name(), name) {
+			return 0, invalid(fmt.Sprintf("line %d: invalid chain", line))
+		}
+
+		address := chain.NormalizeAddress(persist.Address(field(record, "address")))
+		label := field(record, "label")
+		notes := field(record, "notes")
+
+		if address == "" {
+			return 0, invalid(fmt.Sprintf("line %d: missing address", line))
+		}
+		if label == "" || len(label) > maxContactLabelLength {
+			return 0, invalid(fmt.Sprintf("line %d: label must be between 1 and %d characters", line, maxContactLabelLength))
+		}
+		if len(notes) > maxContactNotesLength {
+			return 0, invalid(fmt.Sprintf("line %d: notes must be at most %d characters", line, maxContactNotesLength))
+		}
+
+		key := fmt.Sprintf("%d:%s", chain, address)
+		if i, ok := index[key]; ok {
+			params.Labels[i] = label
+			params.Notes[i] = notes
+			continue
+		}
+
+		if len(params.Ids) >= maxContactsImported {
+			return 0, invalid(fmt.Sprintf("at most %d contacts can be imported at once", maxContactsImported))
+		}
+
+		index[key] = len(params.Ids)
+		params.Ids = append(params.Ids, persist.GenerateID().String())
+		params.Chains = append(params.Chains, int32(chain))
+		params.Addresses = append(params.Addresses, address)
+		params.Labels = append(params.Labels, label)
+		params.Notes = append(params.Notes, notes)
+	}
+
+	if len(params.Ids) == 0 {
+		return 0, nil
+	}
+
+	if err := api.queries.UpsertContacts(ctx, params); err != nil {
+		return 0, err
+	}
+
+	return len(params.Ids), nil
+}
+
+// escapeLikePattern escapes the characters that have a special meaning in a LIKE pattern
+func escapeLikePattern(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
@@ -0,0 +1,45 @@
+package publicapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	db "github.com/SplitFi/go-splitfi/db/gen/coredb"
+	"github.com/SplitFi/go-splitfi/graphql/dataloader"
+	"github.com/SplitFi/go-splitfi/service/persist"
+)
+
+func TestGetRecipientLabel(t *testing.T) {
+	userID := persist.GenerateID()
+	split := db.Split{ID: persist.GenerateID(), Chain: persist.ChainBase}
+	labeled := persist.Address("0x00000000000000000000000000000000000000aa")
+
+	// Labels are read through the loaders, so that resolving every recipient of a split batches into one query
+	fake := newFakeDB(nil)
+	loaders := dataloader.NewLoaders(context.Background(), db.New(fake), false, nil, nil)
+	loaders.GetSplitByIdBatch.Prime(split.ID, split)
+	loaders.GetContactByOwnerChainAddressBatch.Prime(db.GetContactByOwnerChainAddressBatchParams{
+		OwnerID: userID,
+		Chain:   split.Chain,
+		Address: labeled,
+	}, db.Contact{OwnerID: userID, Chain: split.Chain, Address: labeled, Label: "Alice"})
+	api := ContactAPI{queries: db.New(fake), loaders: loaders}
+
+	t.Run("returns the viewer's label for the address", func(t *testing.T) {
+		label, err := api.GetRecipientLabel(withViewer(userID), split.ID, "0x00000000000000000000000000000000000000AA")
+		require.NoError(t, err)
+		require.NotNil(t, label)
+		assert.Equal(t, "Alice", *label)
+	})
+
+	t.Run("logged out viewers don't see labels", func(t *testing.T) {
+		label, err := api.GetRecipientLabel(withoutViewer(), split.ID, labeled)
+		require.NoError(t, err)
+		assert.Nil(t, label)
+	})
+
+	assert.Empty(t, fake.calls)
+}
@@ -0,0 +1,183 @@
+package publicapi
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/go-playground/validator/v10"
+	"github.com/jackc/pgx/v4"
+
+	db "github.com/SplitFi/go-splitfi/db/gen/coredb"
+	"github.com/SplitFi/go-splitfi/graphql/dataloader"
+	"github.com/SplitFi/go-splitfi/service/export"
+	"github.com/SplitFi/go-splitfi/service/persist"
+	"github.com/SplitFi/go-splitfi/service/task"
+	"github.com/SplitFi/go-splitfi/validate"
+)
+
+// maxInlineExportRows is the most rows an export can have to be generated while it is requested.
+// Larger exports are generated by a task.
+const maxInlineExportRows = 500
+
+// ledgerExportLinkTTL is how long a download link for an export is valid for
+const ledgerExportLinkTTL = time.Hour
+
+type ExportAPI struct {
+	queries       *db.Queries
+	loaders       *dataloader.Loaders
+	validator     *validator.Validate
+	storageClient *storage.Client
+	taskClient    *task.Client
+	ethClient     *ethclient.Client
+	splits        *SplitAPI
+}
+
+// ExportLedger exports the inflows, distributions and withdrawals of a split, or the distributions and withdrawals
+// paid to one of the viewer's wallets, that were recorded between from and to. Exactly one of splitID and
+// recipientAddress must be set, and the viewer must be able to view the split's analytics. Small exports are
+// complete when returned, while larger exports are pending until a task generates them.
+func (api ExportAPI) ExportLedger(ctx context.Context, splitID *persist.DBID, recipientAddress *persist.Address, from, to time.Time, format persist.LedgerExportFormat) (db.LedgerExport, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"from":   validate.WithTag(from, "required"),
+		"to":     validate.WithTag(to, "required"),
+		"format": validate.WithTag(format, "required,oneof=csv json"),
+	}); err != nil {
+		return db.LedgerExport{}, err
+	}
+
+	if (splitID == nil || *splitID == "") == (recipientAddress == nil || *recipientAddress == "") {
+		return db.LedgerExport{}, validate.ErrInvalidInput{Parameters: []string{"splitID", "recipientAddress"}, Reasons: []string{"exactly one of splitID and recipientAddress must be set"}}
+	}
+
+	if !from.Before(to) {
+		return db.LedgerExport{}, validate.ErrInvalidInput{Parameters: []string{"from", "to"}, Reasons: []string{"from must be before to"}}
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return db.LedgerExport{}, err
+	}
+
+	params := db.InsertLedgerExportParams{
+		ID:          persist.GenerateID(),
+		RequesterID: userID,
+		Format:      string(format),
+		FromTime:    from,
+		ToTime:      to,
+	}
+
+	var count int64
+	if splitID != nil && *splitID != "" {
+		if _, err := api.splits.requireViewerSplitRole(ctx, userID, *splitID, persist.SplitRoleViewer); err != nil {
+			return db.LedgerExport{}, err
+		}
+
+		params.SplitID = splitID.String()
+		count, err = api.queries.CountSplitLedgerExportRows(ctx, db.CountSplitLedgerExportRowsParams{
+			SplitID:  *splitID,
+			FromTime: from,
+			ToTime:   to,
+		})
+		if err != nil {
+			return db.LedgerExport{}, err
+		}
+	} else {
+		address, err := api.viewerWalletAddress(ctx, userID, *recipientAddress)
+		if err != nil {
+			return db.LedgerExport{}, err
+		}
+
+		params.RecipientAddress = address.String()
+		count, err = api.queries.CountRecipientLedgerExportRows(ctx, db.CountRecipientLedgerExportRowsParams{
+			RecipientAddress: address,
+			FromTime:         from,
+			ToTime:           to,
+		})
+		if err != nil {
+			return db.LedgerExport{}, err
+		}
+	}
+
+	e, err := api.queries.InsertLedgerExport(ctx, params)
+	if err != nil {
+		return db.LedgerExport{}, err
+	}
+
+	if count <= maxInlineExportRows {
+		return export.Run(ctx, api.queries, api.storageClient, api.ethClient, e)
+	}
+
+	if err := api.taskClient.CreateTaskForLedgerExport(ctx, task.LedgerExportMessage{ExportID: e.ID}); err != nil {
+		return db.LedgerExport{}, err
+	}
+
+	return e, nil
+}
+
+// GetViewerLedgerExport returns one of the viewer's exports
+func (api ExportAPI) GetViewerLedgerExport(ctx context.Context, exportID persist.DBID) (db.LedgerExport, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"exportID": validate.WithTag(exportID, "required"),
+	}); err != nil {
+		return db.LedgerExport{}, err
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return db.LedgerExport{}, err
+	}
+
+	e, err := api.queries.GetLedgerExportByID(ctx, exportID)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && e.RequesterID != userID) {
+		return db.LedgerExport{}, persist.ErrLedgerExportNotFound{ID: exportID}
+	}
+
+	return e, err
+}
+
+// GetLedgerExportDownloadURL returns a signed link to download one of the viewer's exports, or nil if the export
+// isn't complete yet
+func (api ExportAPI) GetLedgerExportDownloadURL(ctx context.Context, exportID persist.DBID) (*string, error) {
+	e, err := api.GetViewerLedgerExport(ctx, exportID)
+	if err != nil {
+		return nil, err
+	}
+
+	if persist.LedgerExportStatus(e.Status) != persist.LedgerExportStatusComplete || !e.ObjectName.Valid {
+		return nil, nil
+	}
+
+	url, err := api.storageClient.Bucket(export.Bucket()).SignedURL(e.ObjectName.String, &storage.SignedURLOptions{
+		Scheme:  storage.SigningSchemeV4,
+		Method:  "GET",
+		Expires: time.Now().Add(ledgerExportLinkTTL),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &url, nil
+}
+
+// viewerWalletAddress returns address normalized for the viewer's wallet it belongs to, or ErrInvalidInput if
+// none of the viewer's wallets have that address
+func (api ExportAPI) viewerWalletAddress(ctx context.Context, userID persist.DBID, address persist.Address) (persist.Address, error) {
+	wallets, err := api.queries.GetWalletsByUserID(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+
+	for _, w := range wallets {
+		normalized := persist.Address(w.Chain.NormalizeAddress(address))
+		if persist.Address(w.Chain.NormalizeAddress(w.Address)) == normalized {
+			return normalized, nil
+		}
+	}
+
+	return "", validate.ErrInvalidInput{Parameters: []string{"recipientAddress"}, Reasons: []string{"not one of your wallets"}}
+}
@@ -0,0 +1,53 @@
+package publicapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	db "github.com/SplitFi/go-splitfi/db/gen/coredb"
+	"github.com/SplitFi/go-splitfi/graphql/dataloader"
+	"github.com/SplitFi/go-splitfi/service/persist"
+	"github.com/SplitFi/go-splitfi/validate"
+)
+
+func TestExportLedgerRequiresSplitRole(t *testing.T) {
+	userID := persist.GenerateID()
+	split := db.Split{ID: persist.GenerateID(), Chain: persist.ChainBase}
+	to := time.Now()
+	from := to.Add(-24 * time.Hour)
+
+	newAPI := func(fake *fakeDB) ExportAPI {
+		loaders := dataloader.NewLoaders(context.Background(), db.New(fake), false, nil, nil)
+		loaders.GetSplitByIdBatch.Prime(split.ID, split)
+		splits := newTestSplitAPI(fake)
+		splits.loaders = loaders
+		return ExportAPI{queries: db.New(fake), loaders: loaders, validator: validate.WithCustomValidators(), splits: &splits}
+	}
+
+	t.Run("users without a role can't export a split's ledger", func(t *testing.T) {
+		fake := newFakeDB(nil)
+
+		_, err := newAPI(fake).ExportLedger(withViewer(userID), &split.ID, nil, from, to, persist.LedgerExportFormatCSV)
+		assert.Equal(t, persist.ErrSplitRoleRequired{SplitID: split.ID, Role: persist.SplitRoleViewer}, err)
+		assert.Empty(t, fake.called("CountSplitLedgerExportRows"))
+		assert.Empty(t, fake.called("InsertLedgerExport"))
+	})
+
+	t.Run("viewers can export a split's ledger", func(t *testing.T) {
+		fake := newFakeDB(map[string][]any{
+			"GetSplitMember": {db.SplitMember{SplitID: split.ID, UserID: userID, Role: string(persist.SplitRoleViewer)}},
+		})
+
+		// The export stops once it needs to count rows, since the fake has none to count
+		_, err := newAPI(fake).ExportLedger(withViewer(userID), &split.ID, nil, from, to, persist.LedgerExportFormatCSV)
+		assert.Error(t, err)
+
+		counts := fake.called("CountSplitLedgerExportRows")
+		require.Len(t, counts, 1)
+		assert.Equal(t, split.ID, counts[0][0])
+	})
+}
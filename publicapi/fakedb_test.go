@@ -0,0 +1,129 @@
+package publicapi
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgproto3/v2"
+	"github.com/jackc/pgx/v4"
+
+	db "github.com/SplitFi/go-splitfi/db/gen/coredb"
+	"github.com/SplitFi/go-splitfi/service/auth"
+	"github.com/SplitFi/go-splitfi/service/persist"
+	"github.com/SplitFi/go-splitfi/util"
+)
+
+// fakeDB is a db.DBTX that answers queries by their sqlc name with canned results, so that the API's logic can be
+// tested without a database. Each result is a generated model whose fields are scanned in order, which matches the
+// column order of queries that select a whole table. Queries without a result return pgx.ErrNoRows.
+type fakeDB struct {
+	results map[string][]any
+	calls   []fakeCall
+}
+
+type fakeCall struct {
+	name string
+	args []any
+}
+
+func newFakeDB(results map[string][]any) *fakeDB {
+	return &fakeDB{results: results}
+}
+
+// called returns the arguments of every call made to the named query
+func (f *fakeDB) called(name string) [][]any {
+	var args [][]any
+	for _, c := range f.calls {
+		if c.name == name {
+			args = append(args, c.args)
+		}
+	}
+	return args
+}
+
+func (f *fakeDB) record(sql string, args []any) []any {
+	// sqlc prefixes every query with "-- name: <Name> :<cmd>"
+	name := strings.Fields(strings.SplitN(sql, "\n", 2)[0])[2]
+	f.calls = append(f.calls, fakeCall{name: name, args: args})
+	return f.results[name]
+}
+
+func (f *fakeDB) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	f.record(sql, args)
+	return pgconn.CommandTag("OK"), nil
+}
+
+func (f *fakeDB) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	return &fakeRows{results: f.record(sql, args), cur: -1}, nil
+}
+
+func (f *fakeDB) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	return &fakeRows{results: f.record(sql, args)}
+}
+
+func (f *fakeDB) SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults {
+	panic("fakeDB doesn't support batches")
+}
+
+type fakeRows struct {
+	results []any
+	cur     int
+}
+
+func (r *fakeRows) Close()                                         {}
+func (r *fakeRows) Err() error                                     { return nil }
+func (r *fakeRows) CommandTag() pgconn.CommandTag                  { return nil }
+func (r *fakeRows) FieldDescriptions() []pgproto3.FieldDescription { return nil }
+func (r *fakeRows) Values() ([]interface{}, error)                 { return nil, nil }
+func (r *fakeRows) RawValues() [][]byte                            { return nil }
+
+func (r *fakeRows) Next() bool {
+	r.cur++
+	return r.cur < len(r.results)
+}
+
+func (r *fakeRows) Scan(dest ...interface{}) error {
+	if r.cur >= len(r.results) {
+		return pgx.ErrNoRows
+	}
+
+	result := reflect.ValueOf(r.results[r.cur])
+	if result.Kind() != reflect.Struct || result.NumField() < len(dest) {
+		return fmt.Errorf("can't scan %d columns from %T", len(dest), r.results[r.cur])
+	}
+
+	for i, d := range dest {
+		target := reflect.ValueOf(d).Elem()
+		field := result.Field(i)
+		if !field.Type().AssignableTo(target.Type()) {
+			return fmt.Errorf("can't scan %s into column %d of type %s", field.Type(), i, target.Type())
+		}
+		target.Set(field)
+	}
+
+	return nil
+}
+
+// withViewer returns a context authenticated as the given user, as the auth middleware would leave it
+func withViewer(userID persist.DBID) context.Context {
+	gc, _ := gin.CreateTestContext(httptest.NewRecorder())
+	gc.Set("auth.user_id", userID)
+	gc.Set("auth.auth_error", nil)
+	return context.WithValue(context.Background(), util.GinContextKey, gc)
+}
+
+// withoutViewer returns a context for a request that isn't logged in
+func withoutViewer() context.Context {
+	gc, _ := gin.CreateTestContext(httptest.NewRecorder())
+	gc.Set("auth.auth_error", auth.ErrNoCookie)
+	return context.WithValue(context.Background(), util.GinContextKey, gc)
+}
+
+func newTestSplitAPI(fake *fakeDB) SplitAPI {
+	return SplitAPI{queries: db.New(fake)}
+}
@@ -0,0 +1,196 @@
+package publicapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/go-playground/validator/v10"
+	"github.com/jackc/pgx/v4"
+
+	db "github.com/SplitFi/go-splitfi/db/gen/coredb"
+	"github.com/SplitFi/go-splitfi/graphql/dataloader"
+	"github.com/SplitFi/go-splitfi/service/media"
+	"github.com/SplitFi/go-splitfi/service/persist"
+	"github.com/SplitFi/go-splitfi/service/persist/postgres"
+	"github.com/SplitFi/go-splitfi/validate"
+)
+
+// mediaUploadLinkTTL is how long a client has to upload an image once it has asked to
+const mediaUploadLinkTTL = 15 * time.Minute
+
+type MediaAPI struct {
+	repos         *postgres.Repositories
+	queries       *db.Queries
+	loaders       *dataloader.Loaders
+	validator     *validator.Validate
+	storageClient *storage.Client
+}
+
+// UploadHeader is a header that a client must send with its upload for the signed link to accept it
+type UploadHeader struct {
+	Name  string
+	Value string
+}
+
+// SplitMediaUpload is where a client uploads an image to before completing the upload
+type SplitMediaUpload struct {
+	Media     db.SplitMedia
+	URL       string
+	Headers   []UploadHeader
+	ExpiresAt time.Time
+}
+
+// CreateSplitMediaUpload returns a signed link that the viewer can upload one of a split's images to. The upload
+// must have exactly contentLength bytes of contentType, and is only used once it's completed with
+// CompleteSplitMediaUpload. The viewer must be an editor of the split.
+func (api MediaAPI) CreateSplitMediaUpload(ctx context.Context, splitID persist.DBID, kind persist.SplitMediaKind, contentType string, contentLength int64) (SplitMediaUpload, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"splitID":       validate.WithTag(splitID, "required"),
+		"kind":          validate.WithTag(kind, "required,oneof=logo banner badge"),
+		"contentType":   validate.WithTag(contentType, "required"),
+		"contentLength": validate.WithTag(contentLength, fmt.Sprintf("required,min=1,max=%d", media.MaxUploadSize)),
+	}); err != nil {
+		return SplitMediaUpload{}, err
+	}
+
+	if !media.ContentTypes[contentType] {
+		return SplitMediaUpload{}, validate.ErrInvalidInput{Parameters: []string{"contentType"}, Reasons: []string{"must be a PNG, JPEG, GIF or WebP image"}}
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return SplitMediaUpload{}, err
+	}
+
+	if err := api.requireSplitEditor(ctx, splitID); err != nil {
+		return SplitMediaUpload{}, err
+	}
+
+	m, err := api.queries.InsertSplitMediaUpload(ctx, db.InsertSplitMediaUploadParams{
+		ID:            persist.GenerateID(),
+		SplitID:       splitID,
+		Kind:          string(kind),
+		UploaderID:    userID,
+		ContentType:   contentType,
+		ContentLength: contentLength,
+	})
+	if err != nil {
+		return SplitMediaUpload{}, err
+	}
+
+	// Signing the length range makes storage reject uploads of any other size
+	lengthRange := UploadHeader{Name: "x-goog-content-length-range", Value: fmt.Sprintf("%d,%d", contentLength, contentLength)}
+	expiresAt := time.Now().Add(mediaUploadLinkTTL)
+
+	url, err := api.storageClient.Bucket(media.Bucket()).SignedURL(media.UploadObjectName(m), &storage.SignedURLOptions{
+		Scheme:      storage.SigningSchemeV4,
+		Method:      "PUT",
+		ContentType: contentType,
+		Headers:     []string{fmt.Sprintf("%s:%s", lengthRange.Name, lengthRange.Value)},
+		Expires:     expiresAt,
+	})
+	if err != nil {
+		return SplitMediaUpload{}, err
+	}
+
+	return SplitMediaUpload{
+		Media:     m,
+		URL:       url,
+		Headers:   []UploadHeader{{Name: "Content-Type", Value: contentType}, lengthRange},
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// CompleteSplitMediaUpload processes an image the viewer has uploaded and makes it the split's image. Uploads that
+// aren't valid images are rejected with ErrInvalidInput.
+func (api MediaAPI) CompleteSplitMediaUpload(ctx context.Context, mediaID persist.DBID) (db.SplitMedia, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"mediaID": validate.WithTag(mediaID, "required"),
+	}); err != nil {
+		return db.SplitMedia{}, err
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return db.SplitMedia{}, err
+	}
+
+	m, err := api.queries.GetSplitMediaByID(ctx, mediaID)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && m.UploaderID != userID) {
+		return db.SplitMedia{}, persist.ErrSplitMediaNotFound{ID: mediaID}
+	}
+	if err != nil {
+		return db.SplitMedia{}, err
+	}
+
+	if persist.SplitMediaStatus(m.Status) != persist.SplitMediaStatusPending {
+		return db.SplitMedia{}, validate.ErrInvalidInput{Parameters: []string{"uploadID"}, Reasons: []string{"upload has already been completed"}}
+	}
+
+	// The viewer's role may have changed since the upload was created
+	if err := api.requireSplitEditor(ctx, m.SplitID); err != nil {
+		return db.SplitMedia{}, err
+	}
+
+	m, err = media.Process(ctx, api.queries, api.storageClient, m)
+	if errors.Is(err, media.ErrInvalidImage) {
+		return db.SplitMedia{}, validate.ErrInvalidInput{Parameters: []string{"uploadID"}, Reasons: []string{err.Error()}}
+	}
+	if err != nil {
+		return db.SplitMedia{}, err
+	}
+
+	tx, err := api.repos.BeginTx(ctx)
+	if err != nil {
+		return db.SplitMedia{}, err
+	}
+	queries := api.queries.WithTx(tx)
+	defer tx.Rollback(ctx)
+
+	// Keep the split's image URL pointing at the largest size for clients that don't use the processed sizes
+	err = queries.UpdateSplitImageUrl(ctx, db.UpdateSplitImageUrlParams{
+		Kind: m.Kind,
+		Url:  m.LargeUrl.String,
+		ID:   m.SplitID,
+	})
+	if err != nil {
+		return db.SplitMedia{}, err
+	}
+
+	if _, err := createSplitRevision(ctx, queries, m.SplitID, persist.RevisionSourceUI); err != nil {
+		return db.SplitMedia{}, err
+	}
+
+	return m, tx.Commit(ctx)
+}
+
+// GetSplitMedia returns the processed image a split is currently using, or nil if it has never had one uploaded
+func (api MediaAPI) GetSplitMedia(ctx context.Context, splitID persist.DBID, kind persist.SplitMediaKind) (*db.SplitMedia, error) {
+	m, err := api.queries.GetCurrentSplitMedia(ctx, db.GetCurrentSplitMediaParams{SplitID: splitID, Kind: string(kind)})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (api MediaAPI) requireSplitEditor(ctx context.Context, splitID persist.DBID) error {
+	role, err := For(ctx).Split.GetViewerSplitRole(ctx, splitID)
+	if err != nil {
+		return err
+	}
+
+	if !role.AtLeast(persist.SplitRoleEditor) {
+		return persist.ErrSplitRoleRequired{SplitID: splitID, Role: persist.SplitRoleEditor}
+	}
+
+	return nil
+}
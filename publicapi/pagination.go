@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"github.com/go-playground/validator/v10"
 	"math"
+	"sort"
 	"strings"
 	"time"
 
@@ -742,6 +743,73 @@ func (p *intTimeIDPaginator[Node]) paginate(before *string, after *string, first
 	return paginator.paginate(before, after, first, last)
 }
 
+// sortedPaginator paginates results that are fetched in full and sorted in memory, for results that are
+// small enough to fetch at once but are sorted on values that can't be ordered by in SQL. Nodes are compared
+// to the before and after cursors by their keys rather than matched against them, so a cursor still pages from
+// the right place when results have been added, removed or reordered since it was returned.
+type sortedPaginator[Node any, Cur cursor] struct {
+	// Cursorable produces a cursor for encoding nodes to cursor strings
+	Cursorable cursorable[Node, Cur]
+
+	// NewCursor returns an empty cursor that before and after cursors are unpacked into
+	NewCursor func() Cur
+
+	// Less reports whether a sorts before b. It must be a total order, so keys should end with a unique value.
+	Less func(a, b Cur) bool
+}
+
+func (p *sortedPaginator[Node, Cur]) paginate(nodes []Node, before *string, after *string, first *int, last *int) ([]Node, PageInfo, error) {
+	keys := make([]Cur, len(nodes))
+	for i, node := range nodes {
+		key, err := p.Cursorable(node)
+		if err != nil {
+			return nil, PageInfo{}, err
+		}
+		keys[i] = key
+	}
+
+	order := make([]int, len(nodes))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(i, j int) bool { return p.Less(keys[order[i]], keys[order[j]]) })
+
+	var beforeCur, afterCur Cur
+	if before != nil {
+		beforeCur = p.NewCursor()
+		if err := beforeCur.Unpack(*before); err != nil {
+			return nil, PageInfo{}, err
+		}
+	}
+	if after != nil {
+		afterCur = p.NewCursor()
+		if err := afterCur.Unpack(*after); err != nil {
+			return nil, PageInfo{}, err
+		}
+	}
+
+	cursorEdges := make([]Node, 0, len(nodes))
+	for _, i := range order {
+		if before != nil && !p.Less(keys[i], beforeCur) {
+			continue
+		}
+		if after != nil && !p.Less(afterCur, keys[i]) {
+			continue
+		}
+		cursorEdges = append(cursorEdges, nodes[i])
+	}
+
+	edgesPaged, err := pageEdgesFrom(cursorEdges, before, after, first, last)
+	if err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	countF := func() (int, error) { return len(nodes), nil }
+
+	pageInfo, err := pageInfoFrom(cursorEdges, edgesPaged, countF, p.Cursorable, before, after, first, last)
+	return edgesPaged, pageInfo, err
+}
+
 //------------------------------------------------------------------------------
 
 type cursorEncoder struct {
@@ -1016,6 +1084,22 @@ func newBoolIDFloatIDCursor[Node any](f func(Node) (bool, persist.DBID, float64,
 	}
 }
 
+func newFloatStringIDCursor[Node any](f func(Node) (float64, string, persist.DBID, error)) cursorable[Node, *floatStringIDCursor] {
+	return func(node Node) (c *floatStringIDCursor, err error) {
+		c = cursors.NewFloatStringIDCursor()
+		c.Float, c.String, c.ID, err = f(node)
+		return c, err
+	}
+}
+
+func newIntStringCursor[Node any](f func(Node) (int64, string, error)) cursorable[Node, *intStringCursor] {
+	return func(node Node) (c *intStringCursor, err error) {
+		c = cursors.NewIntStringCursor()
+		c.Int, c.String, err = f(node)
+		return c, err
+	}
+}
+
 //------------------------------------------------------------------------------
 
 type timeIDCursor struct {
@@ -1132,6 +1216,35 @@ func (cursorN) NewBoolIDFloatIDCursor() *boolIDFloatIDCursor {
 
 //------------------------------------------------------------------------------
 
+type floatStringIDCursor struct {
+	*baseCursor
+	Float  float64
+	String string
+	ID     persist.DBID
+}
+
+func (cursorN) NewFloatStringIDCursor() *floatStringIDCursor {
+	c := floatStringIDCursor{baseCursor: &baseCursor{}}
+	initCursor(c.baseCursor, &c.Float, &c.String, &c.ID)
+	return &c
+}
+
+//------------------------------------------------------------------------------
+
+type intStringCursor struct {
+	*baseCursor
+	Int    int64
+	String string
+}
+
+func (cursorN) NewIntStringCursor() *intStringCursor {
+	c := intStringCursor{baseCursor: &baseCursor{}}
+	initCursor(c.baseCursor, &c.Int, &c.String)
+	return &c
+}
+
+//------------------------------------------------------------------------------
+
 func initCursor(cur *baseCursor, vals ...any) {
 	cur.packVals = vals
 	d, _ := newCursorDecoder("")
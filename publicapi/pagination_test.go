@@ -102,6 +102,36 @@ func TestMain(t *testing.T) {
 				assert.Equal(t, curA.CurrentPosition, curB.CurrentPosition)
 				assert.Equal(t, curA.IDs, curB.IDs)
 			})
+
+			t.Run("can decode floatStringID", func(t *testing.T) {
+				curA := cursors.NewFloatStringIDCursor()
+				curA.Float = 13.37
+				curA.String = "1000000000000000000000"
+				curA.ID = persist.GenerateID()
+				packed, err := curA.Pack()
+				assert.NoError(t, err)
+
+				curB := cursors.NewFloatStringIDCursor()
+
+				assert.NoError(t, curB.Unpack(packed))
+				assert.Equal(t, curA.Float, curB.Float)
+				assert.Equal(t, curA.String, curB.String)
+				assert.Equal(t, curA.ID, curB.ID)
+			})
+
+			t.Run("can decode intString", func(t *testing.T) {
+				curA := cursors.NewIntStringCursor()
+				curA.Int = 1337
+				curA.String = "0xabc"
+				packed, err := curA.Pack()
+				assert.NoError(t, err)
+
+				curB := cursors.NewIntStringCursor()
+
+				assert.NoError(t, curB.Unpack(packed))
+				assert.Equal(t, curA.Int, curB.Int)
+				assert.Equal(t, curA.String, curB.String)
+			})
 		})
 
 		t.Run("cursor pagination returns expected edges", func(t *testing.T) {
@@ -301,6 +331,53 @@ func TestMain(t *testing.T) {
 			assert.Equal(t, "e", pageInfo.EndCursor)
 		})
 	})
+
+	t.Run("test sorted pagination", func(t *testing.T) {
+		t.Run("should sort edges", func(t *testing.T) {
+			p := newStubSortedPaginator()
+			first := 3
+
+			actual, pageInfo, err := p.paginate([]string{"d", "b", "e", "a", "c"}, nil, nil, &first, nil)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"a", "b", "c"}, actual)
+			assert.Equal(t, true, pageInfo.HasNextPage)
+			assert.Equal(t, 5, *pageInfo.Total)
+		})
+
+		t.Run("should return expected edges after cursor", func(t *testing.T) {
+			p := newStubSortedPaginator()
+			first := 2
+			after := stubSortedCursor(t, "b")
+
+			actual, _, err := p.paginate([]string{"d", "b", "e", "a", "c"}, nil, &after, &first, nil)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"c", "d"}, actual)
+		})
+
+		t.Run("should return expected edges before cursor", func(t *testing.T) {
+			p := newStubSortedPaginator()
+			last := 2
+			before := stubSortedCursor(t, "d")
+
+			actual, _, err := p.paginate([]string{"d", "b", "e", "a", "c"}, &before, nil, nil, &last)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"b", "c"}, actual)
+		})
+
+		t.Run("should page from cursor of a removed edge", func(t *testing.T) {
+			p := newStubSortedPaginator()
+			first := 2
+			after := stubSortedCursor(t, "b")
+
+			actual, _, err := p.paginate([]string{"d", "e", "a", "c"}, nil, &after, &first, nil)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"c", "d"}, actual)
+		})
+	})
 }
 
 type stubCursor struct{ ID string }
@@ -318,3 +395,19 @@ func newStubPaginator(ret []string) keysetPaginator[string, stubCursor] {
 	p.Cursorable = stubbedCursor
 	return p
 }
+
+func newStubSortedPaginator() sortedPaginator[string, *stringIDCursor] {
+	return sortedPaginator[string, *stringIDCursor]{
+		Cursorable: newStringIDCursor(func(node string) (string, persist.DBID, error) { return node, persist.DBID(node), nil }),
+		NewCursor:  cursors.NewStringIDCursor,
+		Less:       func(a, b *stringIDCursor) bool { return a.String < b.String },
+	}
+}
+
+func stubSortedCursor(t *testing.T, node string) string {
+	c := cursors.NewStringIDCursor()
+	c.String, c.ID = node, persist.DBID(node)
+	packed, err := c.Pack()
+	assert.NoError(t, err)
+	return packed
+}
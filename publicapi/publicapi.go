@@ -23,9 +23,11 @@ import (
 	"github.com/SplitFi/go-splitfi/service/auth"
 	"github.com/SplitFi/go-splitfi/service/multichain"
 	"github.com/SplitFi/go-splitfi/service/persist"
+	"github.com/SplitFi/go-splitfi/service/rpc"
 	"github.com/SplitFi/go-splitfi/service/throttle"
 	"github.com/SplitFi/go-splitfi/util"
 	"github.com/SplitFi/go-splitfi/validate"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/everFinance/goar"
 	"github.com/go-playground/validator/v10"
@@ -51,6 +53,10 @@ type PublicAPI struct {
 	Notifications *NotificationsAPI
 	Admin         *admin.AdminAPI
 	Search        *SearchAPI
+	Contact       *ContactAPI
+	Export        *ExportAPI
+	Media         *MediaAPI
+	Webhook       *WebhookAPI
 }
 
 func New(ctx context.Context, disableDataloaderCaching bool, repos *postgres.Repositories, queries *db.Queries, httpClient *http.Client, ethClient *ethclient.Client, ipfsClient *shell.Shell, arweaveClient *goar.Client, storageClient *storage.Client, taskClient *task.Client, throttler *throttle.Locker, secrets *secretmanager.Client, apq *apq.APQCache, authRefreshCache, oneTimeLoginCache *redis.Cache, magicClient *magicclient.API) *PublicAPI {
@@ -64,6 +70,12 @@ func NewWithMultichainProvider(ctx context.Context, disableDataloaderCaching boo
 
 	//privyClient := privy.NewPrivyClient(httpClient)
 
+	controllers := dataloader.NewGetSplitControllerBatch(ctx, disableDataloaderCaching, func(ctx context.Context, address persist.Address) (persist.Address, error) {
+		return rpc.GetSplitController(ctx, common.HexToAddress(address.String()), ethClient)
+	})
+
+	splitAPI := &SplitAPI{repos: repos, queries: queries, loaders: loaders, controllers: controllers, validator: validator, ethClient: ethClient}
+
 	return &PublicAPI{
 		repos:         repos,
 		queries:       queries,
@@ -71,13 +83,17 @@ func NewWithMultichainProvider(ctx context.Context, disableDataloaderCaching boo
 		validator:     validator,
 		APQ:           apq,
 		Auth:          &AuthAPI{repos: repos, queries: queries, loaders: loaders, validator: validator, ethClient: ethClient, multiChainProvider: multichainProvider, magicLinkClient: magicClient, oneTimeLoginCache: oneTimeLoginCache, authRefreshCache: authRefreshCache}, // privyClient: privyClient
-		Split:         &SplitAPI{repos: repos, queries: queries, loaders: loaders, validator: validator, ethClient: ethClient},
+		Split:         splitAPI,
 		User:          &UserAPI{repos: repos, queries: queries, loaders: loaders, validator: validator, ethClient: ethClient, ipfsClient: ipfsClient, arweaveClient: arweaveClient, storageClient: storageClient, multichainProvider: multichainProvider},
 		Asset:         &AssetAPI{repos: repos, queries: queries, loaders: loaders, validator: validator, ethClient: ethClient, multichainProvider: multichainProvider, throttler: throttler},
 		Wallet:        &WalletAPI{repos: repos, queries: queries, loaders: loaders, validator: validator, ethClient: ethClient, multichainProvider: multichainProvider},
 		Notifications: &NotificationsAPI{queries: queries, loaders: loaders, validator: validator},
-		Admin:         admin.NewAPI(repos, queries, authRefreshCache, validator, multichainProvider),
-		Search:        &SearchAPI{queries: queries, loaders: loaders, validator: validator},
+		Admin:         admin.NewAPI(repos, queries, authRefreshCache, validator, multichainProvider, ethClient),
+		Search:        &SearchAPI{queries: queries, loaders: loaders, validator: validator, ethClient: ethClient},
+		Contact:       &ContactAPI{repos: repos, queries: queries, loaders: loaders, validator: validator},
+		Export:        &ExportAPI{queries: queries, loaders: loaders, validator: validator, storageClient: storageClient, taskClient: taskClient, ethClient: ethClient, splits: splitAPI},
+		Media:         &MediaAPI{repos: repos, queries: queries, loaders: loaders, validator: validator, storageClient: storageClient},
+		Webhook:       &WebhookAPI{queries: queries, validator: validator, taskClient: taskClient, splits: splitAPI},
 	}
 }
 
@@ -1,9 +1,20 @@
 package publicapi
 
 import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/go-playground/validator/v10"
+
 	db "github.com/SplitFi/go-splitfi/db/gen/coredb"
 	"github.com/SplitFi/go-splitfi/graphql/dataloader"
-	"github.com/go-playground/validator/v10"
+	"github.com/SplitFi/go-splitfi/service/eth"
+	"github.com/SplitFi/go-splitfi/service/logger"
+	"github.com/SplitFi/go-splitfi/service/persist"
+	"github.com/SplitFi/go-splitfi/validate"
 )
 
 const maxSearchQueryLength = 256
@@ -13,20 +24,17 @@ type SearchAPI struct {
 	queries   *db.Queries
 	loaders   *dataloader.Loaders
 	validator *validator.Validate
+	ethClient *ethclient.Client
 }
 
-/*
-TODO custom search
-
 // SearchUsers searches for users with the given query, limit, and optional weights. Weights may be nil to accept default values.
 // Weighting will probably be removed after we settle on defaults that feel correct!
-func (api SearchAPI) SearchUsers(ctx context.Context, query string, limit int, usernameWeight float32, bioWeight float32) ([]db.User, error) {
+func (api SearchAPI) SearchUsers(ctx context.Context, query string, limit int, usernameWeight float32) ([]db.User, error) {
 	// Validate
 	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
 		"query":          validate.WithTag(query, fmt.Sprintf("required,min=1,max=%d", maxSearchQueryLength)),
 		"limit":          validate.WithTag(limit, fmt.Sprintf("min=1,max=%d", maxSearchResults)),
 		"usernameWeight": validate.WithTag(usernameWeight, "gte=0.0,lte=1.0"),
-		"bioWeight":      validate.WithTag(bioWeight, "gte=0.0,lte=1.0"),
 	}); err != nil {
 		return nil, err
 	}
@@ -35,10 +43,10 @@ func (api SearchAPI) SearchUsers(ctx context.Context, query string, limit int, u
 	query = validate.SanitizationPolicy.Sanitize(query)
 
 	return api.queries.SearchUsers(ctx, db.SearchUsersParams{
-		Limit:          int32(limit),
+		Addresses:      api.searchAddresses(ctx, query),
 		Query:          query,
 		UsernameWeight: usernameWeight,
-		BioWeight:      bioWeight,
+		Limit:          int32(limit),
 	})
 }
 
@@ -47,10 +55,10 @@ func (api SearchAPI) SearchUsers(ctx context.Context, query string, limit int, u
 func (api SearchAPI) SearchSplits(ctx context.Context, query string, limit int, nameWeight float32, descriptionWeight float32) ([]db.Split, error) {
 	// Validate
 	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
-		"query":             {query, fmt.Sprintf("required,min=1,max=%d", maxSearchQueryLength)},
-		"limit":             {limit, fmt.Sprintf("min=1,max=%d", maxSearchResults)},
-		"nameWeight":        {nameWeight, "gte=0.0,lte=1.0"},
-		"descriptionWeight": {descriptionWeight, "gte=0.0,lte=1.0"},
+		"query":             validate.WithTag(query, fmt.Sprintf("required,min=1,max=%d", maxSearchQueryLength)),
+		"limit":             validate.WithTag(limit, fmt.Sprintf("min=1,max=%d", maxSearchResults)),
+		"nameWeight":        validate.WithTag(nameWeight, "gte=0.0,lte=1.0"),
+		"descriptionWeight": validate.WithTag(descriptionWeight, "gte=0.0,lte=1.0"),
 	}); err != nil {
 		return nil, err
 	}
@@ -59,35 +67,36 @@ func (api SearchAPI) SearchSplits(ctx context.Context, query string, limit int,
 	query = validate.SanitizationPolicy.Sanitize(query)
 
 	return api.queries.SearchSplits(ctx, db.SearchSplitsParams{
-		Limit:             int32(limit),
+		Addresses:         api.searchAddresses(ctx, query),
 		Query:             query,
 		NameWeight:        nameWeight,
 		DescriptionWeight: descriptionWeight,
+		Limit:             int32(limit),
 	})
 }
 
+// searchAddresses returns the addresses a query refers to exactly, either because the query is an address
+// or because it is an ENS name that resolves to one. A name that fails to resolve only logs a warning, so
+// that the rest of the search can still return results.
+func (api SearchAPI) searchAddresses(ctx context.Context, query string) []string {
+	query = strings.TrimSpace(query)
 
-// SearchContracts searches for contracts with the given query, limit, and optional weights. Weights may be nil to accept default values.
-// Weighting will probably be removed after we settle on defaults that feel correct!
-func (api SearchAPI) SearchContracts(ctx context.Context, query string, limit int, nameWeight float32, descriptionWeight float32) ([]db.Contract, error) {
-	// Validate
-	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
-		"query":             {query, fmt.Sprintf("required,min=1,max=%d", maxSearchQueryLength)},
-		"limit":             {limit, fmt.Sprintf("min=1,max=%d", maxSearchResults)},
-		"nameWeight":        {nameWeight, "gte=0.0,lte=1.0"},
-		"descriptionWeight": {descriptionWeight, "gte=0.0,lte=1.0"},
-	}); err != nil {
-		return nil, err
+	if common.IsHexAddress(query) {
+		return []string{persist.ChainETH.NormalizeAddress(persist.Address(query))}
 	}
 
-	// Sanitize
-	query = validate.SanitizationPolicy.Sanitize(query)
+	if !strings.HasSuffix(strings.ToLower(query), ".eth") || api.ethClient == nil {
+		return []string{}
+	}
 
-	return api.queries.SearchContracts(ctx, db.SearchContractsParams{
-		Limit:             int32(limit),
-		Query:             query,
-		NameWeight:        nameWeight,
-		DescriptionWeight: descriptionWeight,
-	})
+	address, err := eth.ResolveENSAddress(ctx, query, api.ethClient)
+	if err != nil {
+		logger.For(ctx).Warnf("failed to resolve ENS name %s: %s", query, err)
+		return []string{}
+	}
+	if address == "" {
+		return []string{}
+	}
+
+	return []string{address.String()}
 }
-*/
@@ -2,23 +2,41 @@ package publicapi
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
 	db "github.com/SplitFi/go-splitfi/db/gen/coredb"
+	"github.com/SplitFi/go-splitfi/env"
+	"github.com/SplitFi/go-splitfi/event"
 	"github.com/SplitFi/go-splitfi/graphql/dataloader"
 	"github.com/SplitFi/go-splitfi/graphql/model"
+	"github.com/SplitFi/go-splitfi/service/distribution"
+	"github.com/SplitFi/go-splitfi/service/emails"
+	"github.com/SplitFi/go-splitfi/service/logger"
 	"github.com/SplitFi/go-splitfi/service/persist"
 	"github.com/SplitFi/go-splitfi/service/persist/postgres"
+	"github.com/SplitFi/go-splitfi/service/rpc"
+	"github.com/SplitFi/go-splitfi/service/splitupdates"
 	"github.com/SplitFi/go-splitfi/util"
 	"github.com/SplitFi/go-splitfi/validate"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/go-playground/validator/v10"
+	"github.com/jackc/pgx/v4"
 )
 
 type SplitAPI struct {
-	repos     *postgres.Repositories
-	queries   *db.Queries
-	loaders   *dataloader.Loaders
-	validator *validator.Validate
-	ethClient *ethclient.Client
+	repos       *postgres.Repositories
+	queries     *db.Queries
+	loaders     *dataloader.Loaders
+	controllers *dataloader.GetSplitControllerBatch
+	validator   *validator.Validate
+	ethClient   *ethclient.Client
 }
 
 func (api SplitAPI) CreateSplit(ctx context.Context, name, description, logoUrl *string) (db.Split, error) {
@@ -31,12 +49,25 @@ func (api SplitAPI) CreateSplit(ctx context.Context, name, description, logoUrl
 		return db.Split{}, err
 	}
 
-	split, err := api.queries.CreateSplit(ctx, db.CreateSplitParams{
-		SplitID:     persist.GenerateID(),
-		Name:        util.FromPointer(name),
-		Description: util.FromPointer(description),
-		LogoUrl:     util.ToNullString(util.FromPointer(logoUrl), false),
-	})
+	tx, err := api.repos.BeginTx(ctx)
+	if err != nil {
+		return db.Split{}, err
+	}
+	queries := api.queries.WithTx(tx)
+	defer tx.Rollback(ctx)
+
+	split, err := createSplit(ctx, queries, db.CreateSplitParams{
+		SplitID:        persist.GenerateID(),
+		Name:           util.FromPointer(name),
+		Description:    util.FromPointer(description),
+		LogoUrl:        util.ToNullString(util.FromPointer(logoUrl), false),
+		TotalOwnership: int32(persist.OwnershipScale),
+	}, nil)
+	if err != nil {
+		return db.Split{}, err
+	}
+
+	err = tx.Commit(ctx)
 	if err != nil {
 		return db.Split{}, err
 	}
@@ -44,244 +75,2301 @@ func (api SplitAPI) CreateSplit(ctx context.Context, name, description, logoUrl
 	return split, nil
 }
 
-func (api SplitAPI) PublishSplit(ctx context.Context, update model.PublishSplitInput) error {
+// createSplit creates a split with the given shares, makes the viewer its controller and records the result as the
+// split's first revision. params.SplitID must already be set so that shares can refer to the new split. The split
+// has no address until it's deployed.
+func createSplit(ctx context.Context, queries *db.Queries, params db.CreateSplitParams, shares []*model.SplitShareInput) (db.Split, error) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return db.Split{}, err
+	}
 
-	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
-		"splitID": {update.SplitID, "required"},
-		"editID":  {update.EditID, "required"},
-	}); err != nil {
-		return err
+	split, err := queries.CreateSplit(ctx, params)
+	if err != nil {
+		return db.Split{}, err
 	}
 
-	//err := publishEventGroup(ctx, update.EditID, persist.ActionSplitUpdated, update.Caption)
-	//if err != nil {
-	//	return err
-	//}
+	// Nobody controls the split on-chain until it's deployed, so its creator is granted the role instead
+	_, err = queries.UpsertSplitMember(ctx, db.UpsertSplitMemberParams{
+		ID:        persist.GenerateID(),
+		SplitID:   split.ID,
+		UserID:    userID,
+		Role:      string(persist.SplitRoleController),
+		GrantedBy: userID,
+	})
+	if err != nil {
+		return db.Split{}, err
+	}
 
-	return nil
-}
+	if len(shares) > 0 {
+		err = updateSplitShares(ctx, queries, shares)
+		if err != nil {
+			return db.Split{}, err
+		}
+	}
 
-func (api SplitAPI) GetViewerSplitById(ctx context.Context, splitID persist.DBID) (*db.Split, error) {
+	revision, err := createSplitRevision(ctx, queries, split.ID, persist.RevisionSourceUI)
+	if err != nil {
+		return db.Split{}, err
+	}
+
+	split.Version = sql.NullInt32{Int32: revision.Revision, Valid: true}
+
+	return split, nil
+}
 
+// CloneSplit creates a new split on chain with the same info, media and recipients as an existing split
+func (api SplitAPI) CloneSplit(ctx context.Context, splitID persist.DBID, chain persist.Chain) (db.Split, error) {
+	// Validate
 	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
 		"splitID": validate.WithTag(splitID, "required"),
+		"chain":   validate.WithTag(chain, "chain"),
 	}); err != nil {
-		return nil, err
+		return db.Split{}, err
 	}
 
-	userID, err := getAuthenticatedUserID(ctx)
-
+	source, err := api.GetSplitById(ctx, splitID)
 	if err != nil {
-		return nil, err
+		return db.Split{}, err
 	}
 
-	split, err := api.queries.GetSplitByUserID(ctx, db.GetSplitByUserIDParams{
-		UserID:  userID,
-		SplitID: splitID,
-	})
+	recipients, err := api.queries.GetRecipientsBySplitID(ctx, splitID)
 	if err != nil {
-		return nil, err
+		return db.Split{}, err
 	}
 
-	return &split, nil
+	params := db.CreateSplitParams{
+		SplitID:        persist.GenerateID(),
+		Chain:          chain,
+		Name:           source.Name,
+		Description:    source.Description,
+		LogoUrl:        source.LogoUrl,
+		BannerUrl:      source.BannerUrl,
+		BadgeUrl:       source.BadgeUrl,
+		TotalOwnership: source.TotalOwnership,
+	}
+
+	shares := make([]*model.SplitShareInput, len(recipients))
+	for i, r := range recipients {
+		shares[i] = &model.SplitShareInput{
+			SplitID:          params.SplitID,
+			RecipientAddress: persist.Address(chain.NormalizeAddress(r.Address)),
+			Ownership:        int(r.Ownership),
+		}
+	}
+
+	return api.createSplitWithShares(ctx, params, shares)
 }
 
-func (api SplitAPI) GetSplitsByUserID(ctx context.Context, userID persist.DBID) ([]db.Split, error) {
+// CreateSplitFromTemplate creates a new split on chain from one of the viewer's templates
+func (api SplitAPI) CreateSplitFromTemplate(ctx context.Context, templateID persist.DBID, chain persist.Chain) (db.Split, error) {
 	// Validate
 	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
-		"userID": validate.WithTag(userID, "required"),
+		"templateID": validate.WithTag(templateID, "required"),
+		"chain":      validate.WithTag(chain, "chain"),
 	}); err != nil {
-		return nil, err
+		return db.Split{}, err
 	}
 
-	splits, err := api.loaders.GetSplitsByUserIDBatch.Load(userID)
+	template, err := api.getViewerSplitTemplate(ctx, templateID)
 	if err != nil {
-		return nil, err
+		return db.Split{}, err
 	}
 
-	return splits, nil
+	recipients, err := api.queries.GetSplitTemplateRecipients(ctx, templateID)
+	if err != nil {
+		return db.Split{}, err
+	}
+
+	params := db.CreateSplitParams{
+		SplitID:        persist.GenerateID(),
+		Chain:          chain,
+		Name:           template.Name,
+		Description:    template.Description,
+		LogoUrl:        template.LogoUrl,
+		BannerUrl:      template.BannerUrl,
+		BadgeUrl:       template.BadgeUrl,
+		TotalOwnership: template.TotalOwnership,
+	}
+
+	shares := make([]*model.SplitShareInput, len(recipients))
+	for i, r := range recipients {
+		shares[i] = &model.SplitShareInput{
+			SplitID:          params.SplitID,
+			RecipientAddress: persist.Address(chain.NormalizeAddress(r.Address)),
+			Ownership:        int(r.Ownership),
+		}
+	}
+
+	return api.createSplitWithShares(ctx, params, shares)
 }
 
-func (api SplitAPI) GetSplitById(ctx context.Context, splitID persist.DBID) (*db.Split, error) {
+func (api SplitAPI) createSplitWithShares(ctx context.Context, params db.CreateSplitParams, shares []*model.SplitShareInput) (db.Split, error) {
+	tx, err := api.repos.BeginTx(ctx)
+	if err != nil {
+		return db.Split{}, err
+	}
+	queries := api.queries.WithTx(tx)
+	defer tx.Rollback(ctx)
+
+	split, err := createSplit(ctx, queries, params, shares)
+	if err != nil {
+		return db.Split{}, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return db.Split{}, err
+	}
+
+	return split, nil
+}
+
+// CreateSplitTemplate saves a set of recipients and default media that new splits can be created from
+func (api SplitAPI) CreateSplitTemplate(ctx context.Context, input model.CreateSplitTemplateInput) (*db.SplitTemplate, error) {
 	// Validate
 	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
-		"splitID": {splitID, "required"},
+		"name":        validate.WithTag(input.Name, "required,max=200"),
+		"description": validate.WithTag(input.Description, "omitempty,max=600"),
+		"logoURL":     validate.WithTag(input.LogoURL, "omitempty,max=200"),
+		"bannerURL":   validate.WithTag(input.BannerURL, "omitempty,max=200"),
+		"badgeURL":    validate.WithTag(input.BadgeURL, "omitempty,max=200"),
+		"recipients":  validate.WithTag(input.Recipients, "required,min=1"),
 	}); err != nil {
 		return nil, err
 	}
 
-	split, err := api.loaders.GetSplitByIdBatch.Load(splitID)
-	if err != nil {
+	// Templates aren't tied to a chain, but every supported chain is EVM-compatible, so normalize
+	// addresses the same way before checking for duplicate recipients.
+	shares := make([]validate.OwnershipShare, len(input.Recipients))
+	for i, r := range input.Recipients {
+		address := persist.Address(persist.ChainETH.NormalizeAddress(r.Address))
+		shares[i] = validate.OwnershipShare{Address: address, Ownership: r.Ownership}
+	}
+
+	if err := validate.ValidateOwnership("recipients", shares, input.TotalOwnership); err != nil {
 		return nil, err
 	}
 
-	return &split, nil
-}
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-func (api SplitAPI) GetSplitsByIds(ctx context.Context, splitIDs []persist.DBID) ([]*db.Split, []error) {
-	splitThunk := func(splitID persist.DBID) func() (db.Split, error) {
-		if err := validate.ValidateFields(api.validator, validate.ValidationMap{
-			"splitIDs": {splitID, "required"},
-		}); err != nil {
-			return func() (db.Split, error) { return db.Split{}, err }
-		}
+	ids := make([]string, len(shares))
+	addresses := make([]string, len(shares))
+	ownerships := make([]int32, len(shares))
 
-		return api.loaders.GetSplitByIdBatch.LoadThunk(splitID)
+	for i, s := range shares {
+		ids[i] = persist.GenerateID().String()
+		addresses[i] = s.Address.String()
+		ownerships[i] = int32(s.Ownership)
 	}
 
-	// A "thunk" will add this request to a batch, and then return a function that will block to fetch
-	// data when called. By creating all of the thunks first (without invoking the functions they return),
-	// we're setting up a batch that will eventually fetch all of these requests at the same time when
-	// their functions are invoked. "LoadAll" would accomplish something similar, but wouldn't let us
-	// validate each splitID parameter first.
-	thunks := make([]func() (db.Split, error), len(splitIDs))
+	tx, err := api.repos.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	queries := api.queries.WithTx(tx)
+	defer tx.Rollback(ctx)
 
-	for i, splitID := range splitIDs {
-		thunks[i] = splitThunk(splitID)
+	template, err := queries.CreateSplitTemplate(ctx, db.CreateSplitTemplateParams{
+		ID:             persist.GenerateID(),
+		OwnerID:        userID,
+		Name:           input.Name,
+		Description:    util.FromPointer(input.Description),
+		LogoUrl:        util.ToNullString(util.FromPointer(input.LogoURL), true),
+		BannerUrl:      util.ToNullString(util.FromPointer(input.BannerURL), true),
+		BadgeUrl:       util.ToNullString(util.FromPointer(input.BadgeURL), true),
+		TotalOwnership: int32(input.TotalOwnership),
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	splits := make([]*db.Split, len(splitIDs))
-	errors := make([]error, len(splitIDs))
+	err = queries.InsertSplitTemplateRecipients(ctx, db.InsertSplitTemplateRecipientsParams{
+		Ids:        ids,
+		TemplateID: template.ID.String(),
+		Addresses:  addresses,
+		Ownerships: ownerships,
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	for i := range splitIDs {
-		split, err := thunks[i]()
-		if err == nil {
-			splits[i] = &split
-		} else {
-			errors[i] = err
-		}
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	return splits, errors
+	return &template, nil
 }
 
-func (api SplitAPI) GetSplitByChainAddress(ctx context.Context, chainAddress persist.ChainAddress) (*db.Split, error) {
+// DeleteSplitTemplate deletes one of the viewer's templates. Splits created from it are unaffected.
+func (api SplitAPI) DeleteSplitTemplate(ctx context.Context, templateID persist.DBID) error {
 	// Validate
 	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
-		"chainAddress": {chainAddress, "required"},
+		"templateID": validate.WithTag(templateID, "required"),
 	}); err != nil {
-		return nil, err
+		return err
 	}
 
-	split, err := api.loaders.GetSplitByChainAddressBatch.Load(db.GetSplitByChainAddressBatchParams{
-		Address: chainAddress.Address(),
-		Chain:   chainAddress.Chain(),
-	})
+	if _, err := api.getViewerSplitTemplate(ctx, templateID); err != nil {
+		return err
+	}
+
+	return api.queries.DeleteSplitTemplate(ctx, templateID)
+}
+
+// GetViewerSplitTemplates returns the viewer's templates, newest first
+func (api SplitAPI) GetViewerSplitTemplates(ctx context.Context) ([]db.SplitTemplate, error) {
+	userID, err := getAuthenticatedUserID(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return &split, nil
+	return api.queries.GetSplitTemplatesByOwnerID(ctx, userID)
 }
 
-func (api SplitAPI) GetRecipientByRecipientID(ctx context.Context, recipientID persist.DBID) (*db.Recipient, error) {
+// GetSplitTemplateRecipients returns the recipients saved in a template
+func (api SplitAPI) GetSplitTemplateRecipients(ctx context.Context, templateID persist.DBID) ([]db.SplitTemplateRecipient, error) {
 	// Validate
 	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
-		"recipientID": validate.WithTag(recipientID, "required"),
+		"templateID": validate.WithTag(templateID, "required"),
 	}); err != nil {
 		return nil, err
 	}
 
-	/*	recipient, err := api.loaders.GetRecipientByRecipienID.Load(recipientID)
-		if err != nil {
-			return nil, err
-		}
-	*/
-	return nil, nil
+	return api.queries.GetSplitTemplateRecipients(ctx, templateID)
 }
 
-func (api SplitAPI) UpdateSplitInfo(ctx context.Context, splitID persist.DBID, name, description, logoUrl *string) error {
+// getViewerSplitTemplate returns a template if it belongs to the viewer. Other users' templates are
+// reported as not found.
+func (api SplitAPI) getViewerSplitTemplate(ctx context.Context, templateID persist.DBID) (db.SplitTemplate, error) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return db.SplitTemplate{}, err
+	}
+
+	template, err := api.queries.GetSplitTemplateByID(ctx, templateID)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && template.OwnerID != userID) {
+		return db.SplitTemplate{}, persist.ErrSplitTemplateNotFound{ID: templateID}
+	}
+
+	return template, err
+}
+
+// splitDraftTTL is how long an edit session survives without being updated before it is discarded
+const splitDraftTTL = 24 * time.Hour
+
+// UpdateSplit records edits to a split under the edit session identified by editID. Edits stay private to the
+// viewer until the session is published with PublishSplit. Sessions that go untouched for splitDraftTTL expire.
+// Splits in a group can't be edited this way, since their name and description belong to the group.
+// Ordering is not stored on splits, so update.Order is ignored.
+func (api SplitAPI) UpdateSplit(ctx context.Context, update model.UpdateSplitInput) (*db.SplitDraft, error) {
 	// Validate
 	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
-		"splitID":     {splitID, "required"},
-		"name":        {name, "max=200"},
-		"description": {description, "max=600"},
-		"logoUrl":     {logoUrl, "max=200"},
+		"splitID":     validate.WithTag(update.SplitID, "required"),
+		"editID":      validate.WithTag(update.EditID, "required,max=255"),
+		"name":        validate.WithTag(update.Name, "omitempty,max=200"),
+		"description": validate.WithTag(update.Description, "omitempty,max=600"),
 	}); err != nil {
-		return err
+		return nil, err
 	}
 
-	var nullName, nullDesc, nullLogoUrl string
-	var nameSet, descSet, logoUrlSet bool
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	if name != nil {
-		nullName = *name
-		nameSet = true
+	if _, err := api.requireViewerSplitRole(ctx, userID, update.SplitID, persist.SplitRoleEditor); err != nil {
+		return nil, err
 	}
-	if description != nil {
-		nullDesc = *description
-		descSet = true
+
+	if err := api.checkSplitInfoEditable(ctx, update.SplitID); err != nil {
+		return nil, err
 	}
-	if logoUrl != nil {
-		nullLogoUrl = *logoUrl
-		logoUrlSet = true
+
+	tx, err := api.repos.BeginTx(ctx)
+	if err != nil {
+		return nil, err
 	}
+	queries := api.queries.WithTx(tx)
+	defer tx.Rollback(ctx)
 
-	err := api.queries.UpdateSplitInfo(ctx, db.UpdateSplitInfoParams{
-		ID:             splitID,
-		Name:           nullName,
-		Description:    nullDesc,
-		LogoUrl:        util.ToNullString(nullLogoUrl, false),
-		NameSet:        nameSet,
-		DescriptionSet: descSet,
-		LogoUrlSet:     logoUrlSet,
+	// Clear out abandoned sessions so that their edit IDs can't collide with new ones
+	err = queries.DeleteExpiredSplitDrafts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	draft, err := queries.UpsertSplitDraft(ctx, db.UpsertSplitDraftParams{
+		ID:          persist.GenerateID(),
+		SplitID:     update.SplitID,
+		EditID:      update.EditID,
+		OwnerID:     userID,
+		Name:        persist.StrPtrToNullStr(update.Name),
+		Description: persist.StrPtrToNullStr(update.Description),
+		ExpiresAt:   time.Now().Add(splitDraftTTL),
 	})
+	// The edit ID is already in use by another user's session
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, validate.ErrInvalidInput{Parameters: []string{"editID"}, Reasons: []string{"edit ID is already in use"}}
+	}
+	if err != nil {
+		return nil, err
+	}
 
+	err = tx.Commit(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return &draft, nil
 }
 
-/*
-	func (api SplitAPI) UpdateSplitHidden(ctx context.Context, splitID persist.DBID, hidden bool) (db.Split, error) {
-		// Validate
-		if err := validate.ValidateFields(api.validator, validate.ValidationMap{
-			"splitID": validate.WithTag(splitID, "required"),
-		}); err != nil {
-			return db.Split{}, err
-		}
+// GetViewerSplitDraft returns the viewer's unexpired edit session for a split
+func (api SplitAPI) GetViewerSplitDraft(ctx context.Context, splitID persist.DBID, editID string) (*db.SplitDraft, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"splitID": validate.WithTag(splitID, "required"),
+		"editID":  validate.WithTag(editID, "required"),
+	}); err != nil {
+		return nil, err
+	}
 
-		split, err := api.queries.UpdateSplitHidden(ctx, db.UpdateSplitHiddenParams{
-			ID:     splitID,
-			Hidden: hidden,
-		})
-		if err != nil {
-			return db.Split{}, err
-		}
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-		return split, nil
+	draft, err := api.queries.GetSplitDraft(ctx, db.GetSplitDraftParams{
+		SplitID: splitID,
+		EditID:  editID,
+		OwnerID: userID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, persist.ErrSplitDraftNotFound{SplitID: splitID, EditID: editID}
+	}
+	if err != nil {
+		return nil, err
 	}
-*/
 
-func (api SplitAPI) UpdateSplitShares(ctx context.Context, shares []*model.SplitShareInput) error {
-	// Validate
+	return &draft, nil
+}
+
+// PublishSplit applies every edit made under an edit session to the split at once, and dispatches the
+// session's events as a single group with the optional caption
+func (api SplitAPI) PublishSplit(ctx context.Context, update model.PublishSplitInput) error {
+
 	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
-		"shares": validate.WithTag(shares, "required,min=1"),
+		"splitID": {update.SplitID, "required"},
+		"editID":  {update.EditID, "required"},
+		"caption": {update.Caption, "omitempty,max=600"},
 	}); err != nil {
 		return err
 	}
 
-	sids := make([]string, len(shares))
-	adds := make([]string, len(shares))
-	owns := make([]int32, len(shares))
-
-	for i, share := range shares {
-		sids[i] = share.SplitID.String()
-		adds[i] = share.RecipientAddress.String()
-		owns[i] = int32(share.Ownership)
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return err
 	}
 
-	err := api.queries.UpdateSplitShares(ctx, db.UpdateSplitSharesParams{
-		SplitIds:           sids,
-		RecipientAddresses: adds,
-		Ownerships:         owns,
-	})
-
-	if err != nil {
+	// The viewer may have lost their role since they started editing
+	if _, err := api.requireViewerSplitRole(ctx, userID, update.SplitID, persist.SplitRoleEditor); err != nil {
 		return err
 	}
 
+	// The split may have joined a group since the session was started
+	if err := api.checkSplitInfoEditable(ctx, update.SplitID); err != nil {
+		return err
+	}
+
+	tx, err := api.repos.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	queries := api.queries.WithTx(tx)
+	defer tx.Rollback(ctx)
+
+	draft, err := queries.GetSplitDraft(ctx, db.GetSplitDraftParams{
+		SplitID: update.SplitID,
+		EditID:  update.EditID,
+		OwnerID: userID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return validate.ErrInvalidInput{Parameters: []string{"editID"}, Reasons: []string{"no unexpired edits exist for this edit ID"}}
+	}
+	if err != nil {
+		return err
+	}
+
+	err = queries.UpdateSplitInfo(ctx, db.UpdateSplitInfoParams{
+		ID:             draft.SplitID,
+		Name:           draft.Name.String,
+		Description:    draft.Description.String,
+		NameSet:        draft.Name.Valid,
+		DescriptionSet: draft.Description.Valid,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = createSplitRevision(ctx, queries, draft.SplitID, persist.RevisionSourceUI)
+	if err != nil {
+		return err
+	}
+
+	_, err = queries.CreateSplitEvent(ctx, db.CreateSplitEventParams{
+		ID:             persist.GenerateID(),
+		ActorID:        persist.DBIDToNullStr(userID),
+		Action:         persist.ActionSplitUpdated,
+		ResourceTypeID: persist.ResourceTypeSplit,
+		SplitID:        draft.SplitID,
+		GroupID:        persist.StrPtrToNullStr(&update.EditID),
+	})
+	if err != nil {
+		return err
+	}
+
+	err = queries.DeleteSplitDraft(ctx, draft.ID)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return err
+	}
+
+	return event.DispatchGroup(ctx, update.EditID, persist.ActionSplitUpdated, update.Caption)
+}
+
+// DeleteSplit soft deletes a split on behalf of the viewer, keeping its revisions and ledger. Controllers of
+// the split delete it immediately. Otherwise the viewer must be one of the split's recipients,
+// and their request counts as an approval of the split's pending deletion request, which is created if it doesn't
+// exist yet. The split is deleted once recipients holding the request's quorum of its ownership have approved.
+// The pending request is returned while the quorum hasn't been reached, and nil once the split is deleted.
+func (api SplitAPI) DeleteSplit(ctx context.Context, splitID persist.DBID) (*db.SplitDeletionRequest, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"splitID": validate.WithTag(splitID, "required"),
+	}); err != nil {
+		return nil, err
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	split, err := api.loaders.GetSplitByIdBatch.Load(splitID)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := api.getSplitRole(ctx, userID, split)
+	if err != nil {
+		return nil, err
+	}
+	isController := role.AtLeast(persist.SplitRoleController)
+
+	owned, err := api.viewerOwnedAddresses(ctx, userID, split.Chain)
+	if err != nil {
+		return nil, err
+	}
+
+	recipients, err := api.queries.GetRecipientsBySplitID(ctx, splitID)
+	if err != nil {
+		return nil, err
+	}
+
+	var approvals db.InsertSplitDeletionApprovalsParams
+	for _, r := range recipients {
+		address := split.Chain.NormalizeAddress(r.Address)
+		if owned[persist.Address(address)] {
+			approvals.Ids = append(approvals.Ids, persist.GenerateID().String())
+			approvals.Addresses = append(approvals.Addresses, address)
+			approvals.Ownerships = append(approvals.Ownerships, r.Ownership)
+		}
+	}
+
+	if !isController && len(approvals.Ids) == 0 {
+		return nil, validate.ErrInvalidInput{Parameters: []string{"splitID"}, Reasons: []string{"only the split's controller or recipients can delete it"}}
+	}
+
+	tx, err := api.repos.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	queries := api.queries.WithTx(tx)
+	defer tx.Rollback(ctx)
+
+	var request *db.SplitDeletionRequest
+	var requested bool
+
+	if !isController {
+		pending, err := queries.GetPendingSplitDeletionRequest(ctx, splitID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			pending, err = queries.CreateSplitDeletionRequest(ctx, db.CreateSplitDeletionRequestParams{
+				ID:          persist.GenerateID(),
+				SplitID:     splitID,
+				RequesterID: userID,
+				Quorum:      int32(env.GetInt("SPLIT_DELETION_QUORUM")),
+			})
+			requested = true
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		approvals.RequestID = pending.ID.String()
+		approvals.ApproverID = userID.String()
+
+		err = queries.InsertSplitDeletionApprovals(ctx, approvals)
+		if err != nil {
+			return nil, err
+		}
+
+		approved, err := splitDeletionApprovedOwnership(ctx, queries, pending.ID, split.Chain, recipients)
+		if err != nil {
+			return nil, err
+		}
+
+		if !splitDeletionQuorumReached(approved, pending.Quorum, split.TotalOwnership) {
+			request = &pending
+		}
+	}
+
+	if request == nil {
+		_, err = queries.SplitRepoDelete(ctx, splitID)
+		if err != nil {
+			return nil, err
+		}
+
+		err = queries.CompleteSplitDeletionRequests(ctx, splitID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	action := persist.ActionSplitDeleted
+	if request != nil {
+		// Approvals of an existing request don't notify anyone until the split is deleted
+		if !requested {
+			return request, nil
+		}
+		action = persist.ActionSplitDeletionRequested
+	}
+
+	// Send event
+	err = event.Dispatch(ctx, db.Event{
+		ActorID:        persist.DBIDToNullStr(userID),
+		Action:         action,
+		ResourceTypeID: persist.ResourceTypeSplit,
+		SplitID:        splitID,
+		SubjectID:      splitID,
+		Data:           persist.EventData{SplitName: &split.Name},
+	})
+	if err != nil {
+		logger.For(ctx).Errorf("failed to dispatch event: %s", err)
+	}
+
+	return request, nil
+}
+
+// splitDeletionQuorumReached reports whether recipients holding approved parts of a split's total ownership make up
+// the given quorum, which is a percentage
+func splitDeletionQuorumReached(approved, quorum, totalOwnership int32) bool {
+	return int64(approved)*100 >= int64(quorum)*int64(totalOwnership)
+}
+
+// splitDeletionApprovedOwnership sums the current ownership of every recipient that approved the request, so that
+// share changes made while a request is pending are taken into account
+func splitDeletionApprovedOwnership(ctx context.Context, queries *db.Queries, requestID persist.DBID, chain persist.Chain, recipients []db.Recipient) (int32, error) {
+	approvals, err := queries.GetSplitDeletionApprovals(ctx, requestID)
+	if err != nil {
+		return 0, err
+	}
+
+	approved := make(map[persist.Address]bool, len(approvals))
+	for _, a := range approvals {
+		approved[persist.Address(chain.NormalizeAddress(a.Address))] = true
+	}
+
+	var ownership int32
+	for _, r := range recipients {
+		if approved[persist.Address(chain.NormalizeAddress(r.Address))] {
+			ownership += r.Ownership
+		}
+	}
+
+	return ownership, nil
+}
+
+// GetPendingSplitDeletionRequest returns the split's deletion request that is still awaiting approvals, if any
+func (api SplitAPI) GetPendingSplitDeletionRequest(ctx context.Context, splitID persist.DBID) (*db.SplitDeletionRequest, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"splitID": validate.WithTag(splitID, "required"),
+	}); err != nil {
+		return nil, err
+	}
+
+	request, err := api.queries.GetPendingSplitDeletionRequest(ctx, splitID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &request, nil
+}
+
+// GetSplitDeletionApprovals returns every approval given to a deletion request, oldest first
+func (api SplitAPI) GetSplitDeletionApprovals(ctx context.Context, requestID persist.DBID) ([]db.SplitDeletionApproval, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"requestID": validate.WithTag(requestID, "required"),
+	}); err != nil {
+		return nil, err
+	}
+
+	return api.queries.GetSplitDeletionApprovals(ctx, requestID)
+}
+
+// GetSplitDeletionApprovedOwnership returns how much of a split's ownership has approved one of its deletion requests
+func (api SplitAPI) GetSplitDeletionApprovedOwnership(ctx context.Context, splitID, requestID persist.DBID) (int32, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"splitID":   validate.WithTag(splitID, "required"),
+		"requestID": validate.WithTag(requestID, "required"),
+	}); err != nil {
+		return 0, err
+	}
+
+	split, err := api.loaders.GetSplitByIdBatch.Load(splitID)
+	if err != nil {
+		return 0, err
+	}
+
+	recipients, err := api.queries.GetRecipientsBySplitID(ctx, splitID)
+	if err != nil {
+		return 0, err
+	}
+
+	return splitDeletionApprovedOwnership(ctx, api.queries, requestID, split.Chain, recipients)
+}
+
+func (api SplitAPI) GetViewerSplitById(ctx context.Context, splitID persist.DBID) (*db.Split, error) {
+
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"splitID": validate.WithTag(splitID, "required"),
+	}); err != nil {
+		return nil, err
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	split, err := api.requireViewerSplitRole(ctx, userID, splitID, persist.SplitRoleViewer)
+	if _, ok := err.(persist.ErrSplitRoleRequired); ok {
+		return nil, persist.ErrSplitNotFound{ID: splitID}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &split, nil
+}
+
+// GetSplitsByUserID returns the splits the user receives from in the order the user chose, leaving out the
+// splits the user has hidden
+func (api SplitAPI) GetSplitsByUserID(ctx context.Context, userID persist.DBID) ([]db.Split, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"userID": validate.WithTag(userID, "required"),
+	}); err != nil {
+		return nil, err
+	}
+
+	splits, err := api.loaders.GetSplitsByUserIDBatch.Load(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return splits, nil
+}
+
+// GetViewerSplits returns every split the viewer receives from in the viewer's order, along with whether
+// each split is hidden
+func (api SplitAPI) GetViewerSplits(ctx context.Context) ([]db.GetSplitsWithUserSettingsByUserIDRow, error) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.queries.GetSplitsWithUserSettingsByUserID(ctx, userID)
+}
+
+// PaginateSplitsByUserID pages through the splits returned by GetSplitsByUserID. The order is fixed by the
+// first page's cursor, so reordering splits doesn't shift results between pages.
+func (api SplitAPI) PaginateSplitsByUserID(ctx context.Context, userID persist.DBID, before, after *string, first, last *int) ([]db.Split, PageInfo, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"userID": validate.WithTag(userID, "required"),
+	}); err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	if err := validatePaginationParams(api.validator, first, last); err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	cur := cursors.NewPositionCursor()
+
+	switch {
+	case before != nil:
+		if err := cur.Unpack(*before); err != nil {
+			return nil, PageInfo{}, err
+		}
+	case after != nil:
+		if err := cur.Unpack(*after); err != nil {
+			return nil, PageInfo{}, err
+		}
+	default:
+		splits, err := api.GetSplitsByUserID(ctx, userID)
+		if err != nil {
+			return nil, PageInfo{}, err
+		}
+		cur.IDs = util.MapWithoutError(splits, func(s db.Split) persist.DBID { return s.ID })
+		cur.Positions = sliceToMapIndex(cur.IDs)
+	}
+
+	paginator := positionPaginator[db.Split]{
+		QueryFunc: func(p positionPagingParams) ([]db.Split, error) {
+			return api.queries.GetSplitsByPositionPaginate(ctx, db.GetSplitsByPositionPaginateParams{
+				SplitIds: util.MapWithoutError(cur.IDs, func(id persist.DBID) string { return id.String() }),
+				// Postgres uses 1-based indexing
+				CurBeforePos: p.CursorBeforePos + 1,
+				CurAfterPos:  p.CursorAfterPos + 1,
+			})
+		},
+		CursorFunc: func(s db.Split) (int64, []persist.DBID, error) {
+			return cur.Positions[s.ID], cur.IDs, nil
+		},
+	}
+
+	return paginator.paginate(before, after, first, last)
+}
+
+func (api SplitAPI) GetSplitById(ctx context.Context, splitID persist.DBID) (*db.Split, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"splitID": {splitID, "required"},
+	}); err != nil {
+		return nil, err
+	}
+
+	split, err := api.loaders.GetSplitByIdBatch.Load(splitID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &split, nil
+}
+
+func (api SplitAPI) GetSplitsByIds(ctx context.Context, splitIDs []persist.DBID) ([]*db.Split, []error) {
+	splitThunk := func(splitID persist.DBID) func() (db.Split, error) {
+		if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+			"splitIDs": {splitID, "required"},
+		}); err != nil {
+			return func() (db.Split, error) { return db.Split{}, err }
+		}
+
+		return api.loaders.GetSplitByIdBatch.LoadThunk(splitID)
+	}
+
+	// A "thunk" will add this request to a batch, and then return a function that will block to fetch
+	// data when called. By creating all of the thunks first (without invoking the functions they return),
+	// we're setting up a batch that will eventually fetch all of these requests at the same time when
+	// their functions are invoked. "LoadAll" would accomplish something similar, but wouldn't let us
+	// validate each splitID parameter first.
+	thunks := make([]func() (db.Split, error), len(splitIDs))
+
+	for i, splitID := range splitIDs {
+		thunks[i] = splitThunk(splitID)
+	}
+
+	splits := make([]*db.Split, len(splitIDs))
+	errors := make([]error, len(splitIDs))
+
+	for i := range splitIDs {
+		split, err := thunks[i]()
+		if err == nil {
+			splits[i] = &split
+		} else {
+			errors[i] = err
+		}
+	}
+
+	return splits, errors
+}
+
+func (api SplitAPI) GetSplitByChainAddress(ctx context.Context, chainAddress persist.ChainAddress) (*db.Split, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"chainAddress": {chainAddress, "required"},
+	}); err != nil {
+		return nil, err
+	}
+
+	split, err := api.loaders.GetSplitByChainAddressBatch.Load(db.GetSplitByChainAddressBatchParams{
+		Address: chainAddress.Address(),
+		Chain:   chainAddress.Chain(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &split, nil
+}
+
+// GetSplitOnchainStatus compares the recipients stored for a split against the recipients and
+// allocations enforced by its deployed contract. Splits that haven't been deployed have no status.
+func (api SplitAPI) GetSplitOnchainStatus(ctx context.Context, splitID persist.DBID) (*persist.SplitOnchainStatus, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"splitID": validate.WithTag(splitID, "required"),
+	}); err != nil {
+		return nil, err
+	}
+
+	split, err := api.loaders.GetSplitByIdBatch.Load(splitID)
+	if err != nil {
+		return nil, err
+	}
+
+	if split.Address == "" {
+		return nil, nil
+	}
+
+	recipients, err := api.queries.GetRecipientsBySplitID(ctx, splitID)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := rpc.GetSplitContractConfig(ctx, common.HexToAddress(split.Address.String()), api.ethClient)
+	if err != nil {
+		return nil, err
+	}
+
+	ownership := make(map[persist.Address]int32, len(recipients))
+	for _, r := range recipients {
+		ownership[persist.Address(split.Chain.NormalizeAddress(r.Address))] = r.Ownership
+	}
+
+	onchainOwnership := make(map[persist.Address]int32, len(config.Recipients))
+	for _, r := range config.Recipients {
+		onchainOwnership[persist.Address(split.Chain.NormalizeAddress(r.Address))] += int32(r.Allocation)
+	}
+
+	return &persist.SplitOnchainStatus{
+		CheckedAt:             time.Now(),
+		TotalOwnership:        split.TotalOwnership,
+		OnchainTotalOwnership: int32(config.TotalAllocation),
+		Drift:                 persist.DiffRecipientOwnership(ownership, onchainOwnership),
+	}, nil
+}
+
+func (api SplitAPI) GetRecipientByRecipientID(ctx context.Context, recipientID persist.DBID) (*db.Recipient, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"recipientID": validate.WithTag(recipientID, "required"),
+	}); err != nil {
+		return nil, err
+	}
+
+	recipient, err := api.queries.GetRecipientByID(ctx, recipientID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &recipient, nil
+}
+
+func (api SplitAPI) GetRecipientsBySplitID(ctx context.Context, splitID persist.DBID) ([]db.Recipient, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"splitID": validate.WithTag(splitID, "required"),
+	}); err != nil {
+		return nil, err
+	}
+
+	return api.queries.GetRecipientsBySplitID(ctx, splitID)
+}
+
+// GetSplitDistributionPreview computes what each recipient of a split would receive if the split's
+// current token balances were distributed now
+func (api SplitAPI) GetSplitDistributionPreview(ctx context.Context, splitID persist.DBID) ([]distribution.TokenDistribution, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"splitID": validate.WithTag(splitID, "required"),
+	}); err != nil {
+		return nil, err
+	}
+
+	split, err := api.loaders.GetSplitByIdBatch.Load(splitID)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := api.queries.GetTokensByOwnerAddressAndChain(ctx, db.GetTokensByOwnerAddressAndChainParams{
+		OwnerAddress: split.Address,
+		Chain:        split.Chain,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Follow nested splits so that the preview shows what each end recipient receives
+	graph, err := distribution.LoadGraph(ctx, api.queries, split)
+	if err != nil {
+		return nil, err
+	}
+
+	return graph.DistributeTokens(tokens), nil
+}
+
+// GetSplitEffectiveOwnership returns the fraction of a split's funds that each end recipient ultimately
+// receives once funds sent to recipients that are themselves splits are distributed onwards
+func (api SplitAPI) GetSplitEffectiveOwnership(ctx context.Context, splitID persist.DBID) ([]distribution.EffectiveShare, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"splitID": validate.WithTag(splitID, "required"),
+	}); err != nil {
+		return nil, err
+	}
+
+	split, err := api.loaders.GetSplitByIdBatch.Load(splitID)
+	if err != nil {
+		return nil, err
+	}
+
+	graph, err := distribution.LoadGraph(ctx, api.queries, split)
+	if err != nil {
+		return nil, err
+	}
+
+	return graph.EffectiveOwnership(), nil
+}
+
+// GetRecipientSplit returns the split a recipient's address belongs to on the same chain as the recipient's
+// split, or nil if the recipient isn't a split
+func (api SplitAPI) GetRecipientSplit(ctx context.Context, recipientID persist.DBID) (*db.Split, error) {
+	recipient, err := api.GetRecipientByRecipientID(ctx, recipientID)
+	if err != nil {
+		return nil, err
+	}
+
+	split, err := api.loaders.GetSplitByIdBatch.Load(recipient.SplitID)
+	if err != nil {
+		return nil, err
+	}
+
+	child, err := api.loaders.GetSplitByChainAddressBatch.Load(db.GetSplitByChainAddressBatchParams{
+		Address: persist.Address(split.Chain.NormalizeAddress(recipient.Address)),
+		Chain:   split.Chain,
+	})
+	if _, ok := err.(persist.ErrSplitNotFoundByAddress); ok {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &child, nil
+}
+
+// GetRecipientClaimable returns the amount of each token held by a split that a recipient is owed
+func (api SplitAPI) GetRecipientClaimable(ctx context.Context, recipientID persist.DBID) ([]distribution.Claim, error) {
+	recipient, err := api.GetRecipientByRecipientID(ctx, recipientID)
+	if err != nil {
+		return nil, err
+	}
+
+	split, err := api.loaders.GetSplitByIdBatch.Load(recipient.SplitID)
+	if err != nil {
+		return nil, err
+	}
+
+	distributions, err := api.distributeSplitBalances(ctx, split)
+	if err != nil {
+		return nil, err
+	}
+
+	return distribution.ClaimsFor(distributions, recipient.Address), nil
+}
+
+// InviteRecipient emails an invite to claim a recipient's share of a split. Only the split's controller can invite
+// recipients, and inviting a recipient that already has a pending invite re-sends it to the given address.
+func (api SplitAPI) InviteRecipient(ctx context.Context, recipientID persist.DBID, email persist.Email) (db.SplitRecipientInvite, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"recipientID": validate.WithTag(recipientID, "required"),
+		"email":       validate.WithTag(email.String(), "required,email"),
+	}); err != nil {
+		return db.SplitRecipientInvite{}, err
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return db.SplitRecipientInvite{}, err
+	}
+
+	recipient, err := api.GetRecipientByRecipientID(ctx, recipientID)
+	if err != nil {
+		return db.SplitRecipientInvite{}, err
+	}
+
+	split, err := api.requireSplitController(ctx, userID, recipient.SplitID)
+	if err != nil {
+		return db.SplitRecipientInvite{}, err
+	}
+
+	latest, err := api.queries.GetLatestRecipientInvite(ctx, recipient.ID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return db.SplitRecipientInvite{}, err
+	}
+	if err == nil && persist.RecipientInviteStatus(latest.Status) == persist.RecipientInviteStatusAccepted {
+		return db.SplitRecipientInvite{}, validate.ErrInvalidInput{Parameters: []string{"recipientID"}, Reasons: []string{"recipient has already been claimed"}}
+	}
+
+	invite, err := api.queries.UpsertRecipientInvite(ctx, db.UpsertRecipientInviteParams{
+		ID:           persist.GenerateID(),
+		SplitID:      split.ID,
+		RecipientID:  recipient.ID,
+		InviterID:    userID,
+		EmailAddress: email.String(),
+	})
+	if err != nil {
+		return db.SplitRecipientInvite{}, err
+	}
+
+	if err := emails.SendRecipientInviteEmail(ctx, invite.ID); err != nil {
+		return db.SplitRecipientInvite{}, err
+	}
+
+	return invite, nil
+}
+
+// AcceptRecipientInvite claims an invited recipient for the viewer. The viewer must have verified the email address
+// the invite was sent to and added the recipient's wallet to their account.
+func (api SplitAPI) AcceptRecipientInvite(ctx context.Context, inviteID persist.DBID) (db.SplitRecipientInvite, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"inviteID": validate.WithTag(inviteID, "required"),
+	}); err != nil {
+		return db.SplitRecipientInvite{}, err
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return db.SplitRecipientInvite{}, err
+	}
+
+	invite, err := api.queries.GetRecipientInviteByID(ctx, inviteID)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && persist.RecipientInviteStatus(invite.Status) != persist.RecipientInviteStatusPending) {
+		return db.SplitRecipientInvite{}, persist.ErrRecipientInviteNotFound{ID: inviteID}
+	}
+	if err != nil {
+		return db.SplitRecipientInvite{}, err
+	}
+
+	user, err := api.queries.GetUserWithPIIByID(ctx, userID)
+	if err != nil {
+		return db.SplitRecipientInvite{}, err
+	}
+
+	if !strings.EqualFold(user.PiiVerifiedEmailAddress.String(), invite.EmailAddress.String()) {
+		return db.SplitRecipientInvite{}, validate.ErrInvalidInput{Parameters: []string{"inviteID"}, Reasons: []string{"verify the email address this invite was sent to"}}
+	}
+
+	recipient, err := api.queries.GetRecipientByID(ctx, invite.RecipientID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return db.SplitRecipientInvite{}, persist.ErrRecipientInviteNotFound{ID: inviteID}
+	}
+	if err != nil {
+		return db.SplitRecipientInvite{}, err
+	}
+
+	split, err := api.loaders.GetSplitByIdBatch.Load(recipient.SplitID)
+	if err != nil {
+		return db.SplitRecipientInvite{}, err
+	}
+
+	owned, err := api.viewerOwnedAddresses(ctx, userID, split.Chain)
+	if err != nil {
+		return db.SplitRecipientInvite{}, err
+	}
+
+	if !owned[persist.Address(split.Chain.NormalizeAddress(recipient.Address))] {
+		return db.SplitRecipientInvite{}, validate.ErrInvalidInput{Parameters: []string{"inviteID"}, Reasons: []string{"add the recipient's wallet to your account"}}
+	}
+
+	invite, err = api.queries.AcceptRecipientInvite(ctx, db.AcceptRecipientInviteParams{
+		InviteeID: userID,
+		ID:        inviteID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return db.SplitRecipientInvite{}, persist.ErrRecipientInviteNotFound{ID: inviteID}
+	}
+
+	return invite, err
+}
+
+// RevokeRecipientInvite withdraws an invite that hasn't been accepted yet. Only the split's controller can revoke invites.
+func (api SplitAPI) RevokeRecipientInvite(ctx context.Context, inviteID persist.DBID) (db.SplitRecipientInvite, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"inviteID": validate.WithTag(inviteID, "required"),
+	}); err != nil {
+		return db.SplitRecipientInvite{}, err
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return db.SplitRecipientInvite{}, err
+	}
+
+	invite, err := api.queries.GetRecipientInviteByID(ctx, inviteID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return db.SplitRecipientInvite{}, persist.ErrRecipientInviteNotFound{ID: inviteID}
+	}
+	if err != nil {
+		return db.SplitRecipientInvite{}, err
+	}
+
+	if _, err := api.requireSplitController(ctx, userID, invite.SplitID); err != nil {
+		return db.SplitRecipientInvite{}, err
+	}
+
+	invite, err = api.queries.RevokeRecipientInvite(ctx, inviteID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return db.SplitRecipientInvite{}, persist.ErrRecipientInviteNotFound{ID: inviteID}
+	}
+
+	return invite, err
+}
+
+// GetRecipientInvite returns the most recent invite for a recipient that hasn't been revoked, or nil if there isn't one
+func (api SplitAPI) GetRecipientInvite(ctx context.Context, recipientID persist.DBID) (*db.SplitRecipientInvite, error) {
+	invite, err := api.queries.GetLatestRecipientInvite(ctx, recipientID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &invite, nil
+}
+
+// requireSplitController returns the split if the user is one of its controllers, or ErrInvalidInput otherwise
+func (api SplitAPI) requireSplitController(ctx context.Context, userID, splitID persist.DBID) (db.Split, error) {
+	split, err := api.loaders.GetSplitByIdBatch.Load(splitID)
+	if err != nil {
+		return db.Split{}, err
+	}
+
+	role, err := api.getSplitRole(ctx, userID, split)
+	if err != nil {
+		return db.Split{}, err
+	}
+
+	if !role.AtLeast(persist.SplitRoleController) {
+		return db.Split{}, validate.ErrInvalidInput{Parameters: []string{"splitID"}, Reasons: []string{"only the split's controller can manage its recipients"}}
+	}
+
+	return split, nil
+}
+
+// GetViewerSplitRole returns the viewer's role on a split, or the empty role if the viewer is logged out or has
+// no access to the split
+func (api SplitAPI) GetViewerSplitRole(ctx context.Context, splitID persist.DBID) (persist.SplitRole, error) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return "", nil
+	}
+
+	split, err := api.loaders.GetSplitByIdBatch.Load(splitID)
+	if err != nil {
+		return "", err
+	}
+
+	return api.getSplitRole(ctx, userID, split)
+}
+
+// getSplitRole returns the highest role the user has on a split. Whoever controls the split is its controller,
+// recipients are viewers, and anyone else has the role they were granted, if any.
+func (api SplitAPI) getSplitRole(ctx context.Context, userID persist.DBID, split db.Split) (persist.SplitRole, error) {
+	var role persist.SplitRole
+
+	member, err := api.queries.GetSplitMember(ctx, db.GetSplitMemberParams{SplitID: split.ID, UserID: userID})
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return "", err
+	}
+	if err == nil {
+		role = persist.SplitRole(member.Role)
+	}
+
+	if !role.AtLeast(persist.SplitRoleViewer) {
+		_, err := api.queries.GetSplitByUserID(ctx, db.GetSplitByUserIDParams{UserID: userID, SplitID: split.ID})
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return "", err
+		}
+		if err == nil {
+			role = persist.SplitRoleViewer
+		}
+	}
+
+	if role.AtLeast(persist.SplitRoleController) {
+		return role, nil
+	}
+
+	// Nobody controls a split on-chain until it's deployed, so its creator is granted the role instead
+	if split.Address == "" {
+		return role, nil
+	}
+
+	controller, err := api.controllers.Load(split.Address)
+	if err != nil {
+		// Fall back to the user's other roles rather than locking them out while the chain is unreachable
+		logger.For(ctx).Warnf("failed to check controller of split %s: %s", split.ID, err)
+		return role, nil
+	}
+	if controller == "" {
+		return role, nil
+	}
+
+	owned, err := api.viewerOwnedAddresses(ctx, userID, split.Chain)
+	if err != nil {
+		return "", err
+	}
+
+	if owned[persist.Address(split.Chain.NormalizeAddress(controller))] {
+		return persist.SplitRoleController, nil
+	}
+
+	return role, nil
+}
+
+// GrantSplitRole gives a user a role on a split, replacing any role they were granted before. Only controllers
+// can grant roles.
+func (api SplitAPI) GrantSplitRole(ctx context.Context, splitID, userID persist.DBID, role persist.SplitRole) (db.SplitMember, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"splitID": validate.WithTag(splitID, "required"),
+		"userID":  validate.WithTag(userID, "required"),
+		"role":    validate.WithTag(role, "required,oneof=viewer editor controller"),
+	}); err != nil {
+		return db.SplitMember{}, err
+	}
+
+	viewerID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return db.SplitMember{}, err
+	}
+
+	if _, err := api.requireViewerSplitRole(ctx, viewerID, splitID, persist.SplitRoleController); err != nil {
+		return db.SplitMember{}, err
+	}
+
+	if _, err := api.loaders.GetUserByIdBatch.Load(userID); err != nil {
+		return db.SplitMember{}, err
+	}
+
+	previous, err := api.queries.GetSplitMember(ctx, db.GetSplitMemberParams{SplitID: splitID, UserID: userID})
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return db.SplitMember{}, err
+	}
+
+	member, err := api.queries.UpsertSplitMember(ctx, db.UpsertSplitMemberParams{
+		ID:        persist.GenerateID(),
+		SplitID:   splitID,
+		UserID:    userID,
+		Role:      string(role),
+		GrantedBy: viewerID,
+	})
+	if err != nil {
+		return db.SplitMember{}, err
+	}
+
+	wasController := persist.SplitRole(previous.Role) == persist.SplitRoleController
+	if wasController != (role == persist.SplitRoleController) {
+		dispatchSplitControllersUpdated(ctx, viewerID, splitID)
+	}
+
+	return member, nil
+}
+
+// RevokeSplitRole removes the role a user was granted on a split. Roles that come from controlling or receiving
+// from the split can't be revoked. Only controllers can revoke roles.
+func (api SplitAPI) RevokeSplitRole(ctx context.Context, splitID, userID persist.DBID) error {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"splitID": validate.WithTag(splitID, "required"),
+		"userID":  validate.WithTag(userID, "required"),
+	}); err != nil {
+		return err
+	}
+
+	viewerID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := api.requireViewerSplitRole(ctx, viewerID, splitID, persist.SplitRoleController); err != nil {
+		return err
+	}
+
+	member, err := api.queries.GetSplitMember(ctx, db.GetSplitMemberParams{SplitID: splitID, UserID: userID})
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return err
+	}
+
+	revoked, err := api.queries.DeleteSplitMember(ctx, db.DeleteSplitMemberParams{SplitID: splitID, UserID: userID})
+	if err != nil {
+		return err
+	}
+
+	if revoked == 0 {
+		return validate.ErrInvalidInput{Parameters: []string{"userID"}, Reasons: []string{"user has not been granted a role on this split"}}
+	}
+
+	if persist.SplitRole(member.Role) == persist.SplitRoleController {
+		dispatchSplitControllersUpdated(ctx, viewerID, splitID)
+	}
+
+	return nil
+}
+
+// dispatchSplitControllersUpdated dispatches that a user gained or lost the controller role on a split
+func dispatchSplitControllersUpdated(ctx context.Context, actorID, splitID persist.DBID) {
+	err := event.Dispatch(ctx, db.Event{
+		ActorID:        persist.DBIDToNullStr(actorID),
+		Action:         persist.ActionSplitControllersUpdated,
+		ResourceTypeID: persist.ResourceTypeSplit,
+		SplitID:        splitID,
+		SubjectID:      splitID,
+	})
+	if err != nil {
+		logger.For(ctx).Errorf("failed to dispatch event: %s", err)
+	}
+}
+
+// GetSplitMembers returns the users that have been granted a role on a split, in the order they were added
+func (api SplitAPI) GetSplitMembers(ctx context.Context, splitID persist.DBID) ([]db.SplitMember, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"splitID": validate.WithTag(splitID, "required"),
+	}); err != nil {
+		return nil, err
+	}
+
+	return api.queries.GetSplitMembersBySplitID(ctx, splitID)
+}
+
+// SubscribeSplitUpdates subscribes the viewer to the activity and balances of a split as they're processed. Live
+// updates are part of a split's private analytics, so the viewer must be a viewer of the split.
+func (api SplitAPI) SubscribeSplitUpdates(ctx context.Context, splitID persist.DBID) (*splitupdates.Subscription, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"splitID": validate.WithTag(splitID, "required"),
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := api.RequireSplitUpdatesAccess(ctx, splitID); err != nil {
+		return nil, err
+	}
+
+	return splitupdates.For(ctx).Subscribe(splitID), nil
+}
+
+// RequireSplitUpdatesAccess returns an error if the viewer isn't a viewer of the split. Subscriptions outlive the
+// check made when they're created, so it's checked again before each update is sent in case the role was revoked.
+func (api SplitAPI) RequireSplitUpdatesAccess(ctx context.Context, splitID persist.DBID) error {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = api.requireViewerSplitRole(ctx, userID, splitID, persist.SplitRoleViewer)
+	return err
+}
+
+// requireViewerSplitRole returns the split if the user's role on it is at least min, or ErrSplitRoleRequired otherwise
+func (api SplitAPI) requireViewerSplitRole(ctx context.Context, userID, splitID persist.DBID, min persist.SplitRole) (db.Split, error) {
+	split, err := api.loaders.GetSplitByIdBatch.Load(splitID)
+	if err != nil {
+		return db.Split{}, err
+	}
+
+	role, err := api.getSplitRole(ctx, userID, split)
+	if err != nil {
+		return db.Split{}, err
+	}
+
+	if !role.AtLeast(min) {
+		return db.Split{}, persist.ErrSplitRoleRequired{SplitID: splitID, Role: min}
+	}
+
+	return split, nil
+}
+
+// viewerOwnedAddresses returns the addresses of the user's wallets, normalized for chain
+func (api SplitAPI) viewerOwnedAddresses(ctx context.Context, userID persist.DBID, chain persist.Chain) (map[persist.Address]bool, error) {
+	wallets, err := api.queries.GetWalletsByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	owned := make(map[persist.Address]bool, len(wallets))
+	for _, w := range wallets {
+		owned[persist.Address(chain.NormalizeAddress(w.Address))] = true
+	}
+
+	return owned, nil
+}
+
+func (api SplitAPI) distributeSplitBalances(ctx context.Context, split db.Split) ([]distribution.TokenDistribution, error) {
+	tokens, err := api.queries.GetTokensByOwnerAddressAndChain(ctx, db.GetTokensByOwnerAddressAndChainParams{
+		OwnerAddress: split.Address,
+		Chain:        split.Chain,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	recipients, err := api.queries.GetRecipientsBySplitID(ctx, split.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return distribution.DistributeTokens(tokens, recipients, split.TotalOwnership), nil
+}
+
+// UpdateSplitInfo updates a split's name, description and logo. The name and description of a split in a group
+// can't be changed on their own; they're changed for the whole group with UpdateSplitGroupInfo.
+func (api SplitAPI) UpdateSplitInfo(ctx context.Context, splitID persist.DBID, name, description, logoUrl *string) error {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"splitID":     {splitID, "required"},
+		"name":        {name, "max=200"},
+		"description": {description, "max=600"},
+		"logoUrl":     {logoUrl, "max=200"},
+	}); err != nil {
+		return err
+	}
+
+	var nullName, nullDesc, nullLogoUrl string
+	var nameSet, descSet, logoUrlSet bool
+
+	if name != nil {
+		nullName = *name
+		nameSet = true
+	}
+	if description != nil {
+		nullDesc = *description
+		descSet = true
+	}
+	if logoUrl != nil {
+		nullLogoUrl = *logoUrl
+		logoUrlSet = true
+	}
+
+	if nameSet || descSet {
+		if err := api.checkSplitInfoEditable(ctx, splitID); err != nil {
+			return err
+		}
+	}
+
+	tx, err := api.repos.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	queries := api.queries.WithTx(tx)
+	defer tx.Rollback(ctx)
+
+	err = queries.UpdateSplitInfo(ctx, db.UpdateSplitInfoParams{
+		ID:             splitID,
+		Name:           nullName,
+		Description:    nullDesc,
+		LogoUrl:        util.ToNullString(nullLogoUrl, false),
+		NameSet:        nameSet,
+		DescriptionSet: descSet,
+		LogoUrlSet:     logoUrlSet,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	_, err = createSplitRevision(ctx, queries, splitID, persist.RevisionSourceUI)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// UpdateSplitHidden hides or unhides one of the viewer's splits on the viewer's profile
+func (api SplitAPI) UpdateSplitHidden(ctx context.Context, splitID persist.DBID, hidden bool) (db.Split, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"splitID": validate.WithTag(splitID, "required"),
+	}); err != nil {
+		return db.Split{}, err
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return db.Split{}, err
+	}
+
+	split, err := api.queries.GetSplitByUserID(ctx, db.GetSplitByUserIDParams{
+		UserID:  userID,
+		SplitID: splitID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return db.Split{}, validate.ErrInvalidInput{Parameters: []string{"splitID"}, Reasons: []string{"viewer is not a recipient of this split"}}
+	}
+	if err != nil {
+		return db.Split{}, err
+	}
+
+	err = api.queries.UpsertSplitUserHidden(ctx, db.UpsertSplitUserHiddenParams{
+		ID:      persist.GenerateID(),
+		UserID:  userID,
+		SplitID: splitID,
+		Hidden:  hidden,
+	})
+	if err != nil {
+		return db.Split{}, err
+	}
+
+	return split, nil
+}
+
+// UpdateSplitOrder sets the positions of the viewer's splits. Splits are ordered by comparing their positions
+// lexicographically, so a split can be moved between two others by giving it any position that sorts between theirs.
+// Splits without a position come after every positioned split, oldest first.
+func (api SplitAPI) UpdateSplitOrder(ctx context.Context, positions []*model.SplitPositionInput) error {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"positions": validate.WithTag(positions, "required,min=1,dive,required"),
+	}); err != nil {
+		return err
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	splits, err := api.queries.GetSplitsWithUserSettingsByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	member := make(map[persist.DBID]bool, len(splits))
+	for _, s := range splits {
+		member[s.Split.ID] = true
+	}
+
+	params := db.UpsertSplitUserPositionsParams{UserID: userID.String()}
+	seen := make(map[persist.DBID]bool, len(positions))
+	for _, p := range positions {
+		if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+			"position": validate.WithTag(p.Position, "required,max=255"),
+		}); err != nil {
+			return err
+		}
+		if !member[p.SplitID] {
+			return validate.ErrInvalidInput{Parameters: []string{"positions"}, Reasons: []string{"viewer is not a recipient of split " + p.SplitID.String()}}
+		}
+		if seen[p.SplitID] {
+			return validate.ErrInvalidInput{Parameters: []string{"positions"}, Reasons: []string{"split " + p.SplitID.String() + " is positioned more than once"}}
+		}
+		seen[p.SplitID] = true
+
+		params.Ids = append(params.Ids, persist.GenerateID().String())
+		params.SplitIds = append(params.SplitIds, p.SplitID.String())
+		params.Positions = append(params.Positions, p.Position)
+	}
+
+	return api.queries.UpsertSplitUserPositions(ctx, params)
+}
+
+// UpdateSplitShares replaces the recipients of every split in shares with the recipients given for it
+func (api SplitAPI) UpdateSplitShares(ctx context.Context, shares []*model.SplitShareInput) error {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"shares": validate.WithTag(shares, "required,min=1"),
+	}); err != nil {
+		return err
+	}
+
+	tx, err := api.repos.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	queries := api.queries.WithTx(tx)
+	defer tx.Rollback(ctx)
+
+	err = updateSplitShares(ctx, queries, shares)
+	if err != nil {
+		return err
+	}
+
+	// Shares may span several splits, and each changed split gets a single revision
+	seen := make(map[persist.DBID]bool)
+	for _, share := range shares {
+		if seen[share.SplitID] {
+			continue
+		}
+		seen[share.SplitID] = true
+
+		_, err = createSplitRevision(ctx, queries, share.SplitID, persist.RevisionSourceUI)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	actorID, _ := getAuthenticatedUserID(ctx)
+	for splitID := range seen {
+		err := event.Dispatch(ctx, db.Event{
+			ActorID:        persist.DBIDToNullStr(actorID),
+			Action:         persist.ActionSplitRecipientsUpdated,
+			ResourceTypeID: persist.ResourceTypeSplit,
+			SplitID:        splitID,
+			SubjectID:      splitID,
+		})
+		if err != nil {
+			logger.For(ctx).Errorf("failed to dispatch event: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// updateSplitShares replaces the recipients of every split in shares with the recipients given for it. Recipients
+// that aren't given are removed from their split, and recipients that aren't part of their split yet are added.
+// Every changed split's new recipients must add up to exactly its total ownership, and nothing is saved unless
+// they all do.
+func updateSplitShares(ctx context.Context, queries *db.Queries, shares []*model.SplitShareInput) error {
+	// Shares may span several splits, so they're grouped to validate and save each split's recipients together
+	splits := make(map[persist.DBID]db.Split)
+	splitIDs := make([]persist.DBID, 0)
+	splitShares := make(map[persist.DBID][]validate.OwnershipShare)
+
+	for _, share := range shares {
+		split, ok := splits[share.SplitID]
+		if !ok {
+			var err error
+			split, err = queries.GetSplitById(ctx, share.SplitID)
+			if errors.Is(err, pgx.ErrNoRows) {
+				return persist.ErrSplitNotFound{ID: share.SplitID}
+			}
+			if err != nil {
+				return err
+			}
+			splits[share.SplitID] = split
+			splitIDs = append(splitIDs, share.SplitID)
+		}
+
+		address := persist.Address(split.Chain.NormalizeAddress(share.RecipientAddress))
+		splitShares[share.SplitID] = append(splitShares[share.SplitID], validate.OwnershipShare{Address: address, Ownership: share.Ownership})
+	}
+
+	for _, splitID := range splitIDs {
+		if err := validate.ValidateShares("shares", splitShares[splitID]); err != nil {
+			return err
+		}
+	}
+
+	// The shares replace each split's recipients, so they're validated as the splits' recipients before anything
+	// is saved
+	recipients := make(map[persist.DBID][]db.Recipient, len(splitIDs))
+	for _, splitID := range splitIDs {
+		recipients[splitID] = recipientsFromShares(splitID, splitShares[splitID])
+	}
+
+	for _, splitID := range splitIDs {
+		if _, err := validateSplitRecipients(ctx, queries, splits[splitID], recipients); err != nil {
+			return err
+		}
+	}
+
+	for _, splitID := range splitIDs {
+		ids := make([]string, len(splitShares[splitID]))
+		addresses := make([]string, len(splitShares[splitID]))
+		ownerships := make([]int32, len(splitShares[splitID]))
+		for i, share := range splitShares[splitID] {
+			ids[i] = persist.GenerateID().String()
+			addresses[i] = share.Address.String()
+			ownerships[i] = int32(share.Ownership)
+		}
+
+		err := queries.DeleteSplitRecipientsExcept(ctx, db.DeleteSplitRecipientsExceptParams{
+			SplitID:   splitID,
+			Addresses: addresses,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = queries.UpsertSplitRecipients(ctx, db.UpsertSplitRecipientsParams{
+			Ids:        ids,
+			SplitID:    splitID.String(),
+			Addresses:  addresses,
+			Ownerships: ownerships,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
+
+// recipientsFromShares returns the recipients a split has once its recipients are replaced with shares, in the
+// same order as GetRecipientsBySplitID
+func recipientsFromShares(splitID persist.DBID, shares []validate.OwnershipShare) []db.Recipient {
+	recipients := make([]db.Recipient, len(shares))
+	for i, share := range shares {
+		recipients[i] = db.Recipient{SplitID: splitID, Address: share.Address, Ownership: int32(share.Ownership)}
+	}
+
+	sort.SliceStable(recipients, func(i, j int) bool {
+		if recipients[i].Ownership != recipients[j].Ownership {
+			return recipients[i].Ownership > recipients[j].Ownership
+		}
+		return recipients[i].Address < recipients[j].Address
+	})
+
+	return recipients
+}
+
+// validateSplitRecipients checks that split would be valid with the recipients it has in recipients, without saving
+// them: its ownership must add up to its total, and none of its recipients may send funds back into a split they
+// came from. It returns the split's graph with the recipients applied.
+func validateSplitRecipients(ctx context.Context, queries *db.Queries, split db.Split, recipients map[persist.DBID][]db.Recipient) (distribution.Graph, error) {
+	current := make([]validate.OwnershipShare, len(recipients[split.ID]))
+	for i, r := range recipients[split.ID] {
+		current[i] = validate.OwnershipShare{Address: r.Address, Ownership: int(r.Ownership)}
+	}
+
+	if err := validate.ValidateOwnership("shares", current, int(split.TotalOwnership)); err != nil {
+		return distribution.Graph{}, err
+	}
+
+	graph, err := distribution.LoadGraphWithRecipients(ctx, queries, split, recipients)
+	if cycle, ok := err.(persist.ErrSplitCycle); ok {
+		return distribution.Graph{}, validate.ErrInvalidInput{Parameters: []string{"shares"}, Reasons: []string{cycle.Error()}}
+	}
+	if err != nil {
+		return distribution.Graph{}, err
+	}
+
+	return graph, nil
+}
+
+// createSplitRevision records the current state of a split as a new revision made by the
+// authenticated user from source, and bumps the split's version to match
+func createSplitRevision(ctx context.Context, queries *db.Queries, splitID persist.DBID, source persist.RevisionSource) (db.SplitRevision, error) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return db.SplitRevision{}, err
+	}
+
+	revision, err := queries.CreateSplitRevision(ctx, db.CreateSplitRevisionParams{
+		SplitID: splitID,
+		ID:      persist.GenerateID().String(),
+		ActorID: util.ToNullString(userID.String(), true),
+		Source:  string(source),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return db.SplitRevision{}, persist.ErrSplitNotFound{ID: splitID}
+	}
+
+	return revision, err
+}
+
+// PaginateSplitRevisions returns the recorded revisions of a split
+func (api SplitAPI) PaginateSplitRevisions(ctx context.Context, splitID persist.DBID, before, after *string, first, last *int) ([]db.SplitRevision, PageInfo, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"splitID": validate.WithTag(splitID, "required"),
+	}); err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	if err := validatePaginationParams(api.validator, first, last); err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	queryFunc := func(params timeIDPagingParams) ([]db.SplitRevision, error) {
+		return api.queries.GetSplitRevisionsPaginate(ctx, db.GetSplitRevisionsPaginateParams{
+			SplitID:       splitID,
+			Limit:         params.Limit,
+			CurBeforeTime: params.CursorBeforeTime,
+			CurBeforeID:   params.CursorBeforeID,
+			CurAfterTime:  params.CursorAfterTime,
+			CurAfterID:    params.CursorAfterID,
+			PagingForward: params.PagingForward,
+		})
+	}
+
+	countFunc := func() (int, error) {
+		total, err := api.queries.CountSplitRevisions(ctx, splitID)
+		return int(total), err
+	}
+
+	cursorFunc := func(r db.SplitRevision) (time.Time, persist.DBID, error) {
+		return r.CreatedAt, r.ID, nil
+	}
+
+	paginator := timeIDPaginator[db.SplitRevision]{
+		QueryFunc:  queryFunc,
+		CursorFunc: cursorFunc,
+		CountFunc:  countFunc,
+	}
+
+	return paginator.paginate(before, after, first, last)
+}
+
+// GetSplitRevisionDiff compares two revisions of a split
+func (api SplitAPI) GetSplitRevisionDiff(ctx context.Context, splitID persist.DBID, fromRevision, toRevision int) (*persist.SplitRevisionDiff, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"splitID":      validate.WithTag(splitID, "required"),
+		"fromRevision": validate.WithTag(fromRevision, "min=1"),
+		"toRevision":   validate.WithTag(toRevision, "min=1"),
+	}); err != nil {
+		return nil, err
+	}
+
+	getRevision := func(revision int) (db.SplitRevision, error) {
+		r, err := api.queries.GetSplitRevision(ctx, db.GetSplitRevisionParams{
+			SplitID:  splitID,
+			Revision: int32(revision),
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return db.SplitRevision{}, persist.ErrSplitRevisionNotFound{SplitID: splitID, Revision: int32(revision)}
+		}
+		return r, err
+	}
+
+	from, err := getRevision(fromRevision)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := getRevision(toRevision)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := persist.SplitRevisionDiff{
+		FromRevision: from.Revision,
+		ToRevision:   to.Revision,
+		Fields:       make([]persist.RevisionFieldChange, 0),
+		Recipients:   persist.DiffRevisionRecipients(from.Recipients, to.Recipients),
+	}
+
+	addChange := func(field, fromValue, toValue string) {
+		if fromValue != toValue {
+			diff.Fields = append(diff.Fields, persist.RevisionFieldChange{Field: field, From: fromValue, To: toValue})
+		}
+	}
+
+	addChange("name", from.Name, to.Name)
+	addChange("description", from.Description, to.Description)
+	addChange("logoURL", from.LogoUrl.String, to.LogoUrl.String)
+	addChange("bannerURL", from.BannerUrl.String, to.BannerUrl.String)
+	addChange("badgeURL", from.BadgeUrl.String, to.BadgeUrl.String)
+	addChange("totalOwnership", strconv.Itoa(int(from.TotalOwnership)), strconv.Itoa(int(to.TotalOwnership)))
+
+	return &diff, nil
+}
+
+// PaginateSplitDistributions returns the ledger entries recording funds that left a split
+func (api SplitAPI) PaginateSplitDistributions(ctx context.Context, splitID persist.DBID, before, after *string, first, last *int) ([]db.SplitLedgerEntry, PageInfo, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"splitID": validate.WithTag(splitID, "required"),
+	}); err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	if err := validatePaginationParams(api.validator, first, last); err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	queryFunc := func(params timeIDPagingParams) ([]db.SplitLedgerEntry, error) {
+		return api.queries.GetSplitLedgerEntriesPaginate(ctx, db.GetSplitLedgerEntriesPaginateParams{
+			SplitID:       splitID,
+			Limit:         params.Limit,
+			CurBeforeTime: params.CursorBeforeTime,
+			CurBeforeID:   params.CursorBeforeID,
+			CurAfterTime:  params.CursorAfterTime,
+			CurAfterID:    params.CursorAfterID,
+			PagingForward: params.PagingForward,
+		})
+	}
+
+	countFunc := func() (int, error) {
+		total, err := api.queries.CountSplitLedgerEntries(ctx, splitID)
+		return int(total), err
+	}
+
+	cursorFunc := func(e db.SplitLedgerEntry) (time.Time, persist.DBID, error) {
+		return e.CreatedAt, e.ID, nil
+	}
+
+	paginator := timeIDPaginator[db.SplitLedgerEntry]{
+		QueryFunc:  queryFunc,
+		CursorFunc: cursorFunc,
+		CountFunc:  countFunc,
+	}
+
+	return paginator.paginate(before, after, first, last)
+}
+
+// PaginateViewerEarnings returns the ledger entries paid out to any of the viewer's wallets
+func (api SplitAPI) PaginateViewerEarnings(ctx context.Context, before, after *string, first, last *int) ([]db.SplitLedgerEntry, PageInfo, error) {
+	if err := validatePaginationParams(api.validator, first, last); err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	wallets, err := api.queries.GetWalletsByUserID(ctx, userID)
+	if err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	addresses := make([]string, len(wallets))
+	for i, w := range wallets {
+		addresses[i] = w.Chain.NormalizeAddress(w.Address)
+	}
+
+	queryFunc := func(params timeIDPagingParams) ([]db.SplitLedgerEntry, error) {
+		return api.queries.GetLedgerEntriesByRecipientAddressesPaginate(ctx, db.GetLedgerEntriesByRecipientAddressesPaginateParams{
+			RecipientAddresses: addresses,
+			Limit:              params.Limit,
+			CurBeforeTime:      params.CursorBeforeTime,
+			CurBeforeID:        params.CursorBeforeID,
+			CurAfterTime:       params.CursorAfterTime,
+			CurAfterID:         params.CursorAfterID,
+			PagingForward:      params.PagingForward,
+		})
+	}
+
+	countFunc := func() (int, error) {
+		total, err := api.queries.CountLedgerEntriesByRecipientAddresses(ctx, addresses)
+		return int(total), err
+	}
+
+	cursorFunc := func(e db.SplitLedgerEntry) (time.Time, persist.DBID, error) {
+		return e.CreatedAt, e.ID, nil
+	}
+
+	paginator := timeIDPaginator[db.SplitLedgerEntry]{
+		QueryFunc:  queryFunc,
+		CursorFunc: cursorFunc,
+		CountFunc:  countFunc,
+	}
+
+	return paginator.paginate(before, after, first, last)
+}
+
+// MemberSplit is a split that one or more of a user's wallets are recipients of
+type MemberSplit struct {
+	Split db.Split
+	// Ownership is the share held by Addresses, in parts per million
+	Ownership int32
+	// Addresses are the user's addresses that are recipients of the split
+	Addresses []persist.Address
+}
+
+// PaginateViewerMemberSplits returns the splits that any of the viewer's wallets are recipients of, on any chain
+func (api SplitAPI) PaginateViewerMemberSplits(ctx context.Context, before, after *string, first, last *int) ([]MemberSplit, PageInfo, error) {
+	if err := validatePaginationParams(api.validator, first, last); err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	queryFunc := func(params timeIDPagingParams) ([]db.GetMemberSplitsByUserIDPaginateRow, error) {
+		return api.queries.GetMemberSplitsByUserIDPaginate(ctx, db.GetMemberSplitsByUserIDPaginateParams{
+			UserID:        userID,
+			Limit:         params.Limit,
+			CurBeforeTime: params.CursorBeforeTime,
+			CurBeforeID:   params.CursorBeforeID,
+			CurAfterTime:  params.CursorAfterTime,
+			CurAfterID:    params.CursorAfterID,
+			PagingForward: params.PagingForward,
+		})
+	}
+
+	countFunc := func() (int, error) {
+		total, err := api.queries.CountMemberSplitsByUserID(ctx, userID)
+		return int(total), err
+	}
+
+	cursorFunc := func(r db.GetMemberSplitsByUserIDPaginateRow) (time.Time, persist.DBID, error) {
+		return r.Split.CreatedAt, r.Split.ID, nil
+	}
+
+	paginator := timeIDPaginator[db.GetMemberSplitsByUserIDPaginateRow]{
+		QueryFunc:  queryFunc,
+		CursorFunc: cursorFunc,
+		CountFunc:  countFunc,
+	}
+
+	rows, pageInfo, err := paginator.paginate(before, after, first, last)
+	if err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	splits := make([]MemberSplit, len(rows))
+	for i, r := range rows {
+		splits[i] = MemberSplit{
+			Split:     r.Split,
+			Ownership: r.Ownership,
+			Addresses: util.MapWithoutError(r.Addresses, func(a string) persist.Address { return persist.Address(a) }),
+		}
+	}
+
+	return splits, pageInfo, nil
+}
+
+// GetWalletMemberSplits returns the splits that a wallet's address is a recipient of
+func (api SplitAPI) GetWalletMemberSplits(ctx context.Context, chainAddress persist.ChainAddress) ([]MemberSplit, error) {
+	address := persist.Address(chainAddress.Chain().NormalizeAddress(chainAddress.Address()))
+
+	rows, err := api.queries.GetSplitsByRecipientAddress(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	splits := make([]MemberSplit, len(rows))
+	for i, r := range rows {
+		splits[i] = MemberSplit{
+			Split:     r.Split,
+			Ownership: r.Ownership,
+			Addresses: []persist.Address{address},
+		}
+	}
+
+	return splits, nil
+}
+
+// GetMemberSplitClaimable returns the amount of each token held by a split that the given recipient addresses
+// are owed in total
+func (api SplitAPI) GetMemberSplitClaimable(ctx context.Context, splitID persist.DBID, addresses []persist.Address) ([]distribution.Claim, error) {
+	split, err := api.loaders.GetSplitByIdBatch.Load(splitID)
+	if err != nil {
+		return nil, err
+	}
+
+	distributions, err := api.distributeSplitBalances(ctx, split)
+	if err != nil {
+		return nil, err
+	}
+
+	return distribution.ClaimsFor(distributions, addresses...), nil
+}
+
+// GetMemberSplitEarnings returns the total of each token a split has ever paid out to the given recipient
+// addresses, ordered by chain and token address
+func (api SplitAPI) GetMemberSplitEarnings(ctx context.Context, splitID persist.DBID, addresses []persist.Address) ([]distribution.Claim, error) {
+	entries, err := api.queries.GetSplitLedgerEntriesByRecipientAddresses(ctx, db.GetSplitLedgerEntriesByRecipientAddressesParams{
+		SplitID:            splitID,
+		RecipientAddresses: util.MapWithoutError(addresses, func(a persist.Address) string { return a.String() }),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	earnings := make([]distribution.Claim, 0)
+	index := make(map[persist.ChainAddress]int)
+
+	for _, e := range entries {
+		key := persist.NewChainAddress(e.TokenAddress, e.Chain)
+		if i, ok := index[key]; ok {
+			earnings[i].Amount.Add(earnings[i].Amount, e.Amount.BigInt())
+			continue
+		}
+		index[key] = len(earnings)
+		earnings = append(earnings, distribution.Claim{Chain: e.Chain, TokenAddress: e.TokenAddress, Amount: new(big.Int).Set(e.Amount.BigInt())})
+	}
+
+	sort.Slice(earnings, func(i, j int) bool {
+		if earnings[i].Chain != earnings[j].Chain {
+			return earnings[i].Chain < earnings[j].Chain
+		}
+		return earnings[i].TokenAddress < earnings[j].TokenAddress
+	})
+
+	return earnings, nil
+}
+
+const defaultTopPayersLimit = 10
+
+// SplitInflowReport summarizes the funds a split received during a report window
+type SplitInflowReport struct {
+	Window      model.Window
+	TotalUSD    float64
+	InflowCount int
+	// Tokens is the total received of each token, ordered by USD value
+	Tokens    []db.GetSplitInflowTokenTotalsRow
+	TopPayers []db.GetSplitInflowTopPayersRow
+	// TimeSeries has one bucket per day, or per month for ALL_TIME, including buckets with no inflows
+	TimeSeries []db.GetSplitInflowTimeSeriesRow
+}
+
+// GetSplitInflowReport returns the funds a split received during window. USD values use the token's price
+// at the time each transfer was recorded, and transfers of tokens without a price count as zero USD.
+func (api SplitAPI) GetSplitInflowReport(ctx context.Context, splitID persist.DBID, window model.Window, topPayersLimit *int) (SplitInflowReport, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"splitID":        validate.WithTag(splitID, "required"),
+		"topPayersLimit": validate.WithTag(topPayersLimit, "omitempty,min=1,max=100"),
+	}); err != nil {
+		return SplitInflowReport{}, err
+	}
+
+	if !window.IsValid() {
+		return SplitInflowReport{}, validate.ErrInvalidInput{Parameters: []string{"window"}, Reasons: []string{"invalid report window"}}
+	}
+
+	if _, err := api.loaders.GetSplitByIdBatch.Load(splitID); err != nil {
+		return SplitInflowReport{}, err
+	}
+
+	return api.inflowReport(ctx, []persist.DBID{splitID}, window, topPayersLimit)
+}
+
+// inflowReport returns the funds that any of splitIDs received during window, combined as if they were one split
+func (api SplitAPI) inflowReport(ctx context.Context, splitIDs []persist.DBID, window model.Window, topPayersLimit *int) (SplitInflowReport, error) {
+	ids := make([]string, len(splitIDs))
+	for i, id := range splitIDs {
+		ids[i] = id.String()
+	}
+
+	limit := defaultTopPayersLimit
+	if topPayersLimit != nil {
+		limit = *topPayersLimit
+	}
+
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	// Windows count today as their last day
+	var since time.Time
+	bucket := "day"
+	if window.IsAllTime() {
+		bucket = "month"
+	} else {
+		since = today.Add(-window.Duration).AddDate(0, 0, 1)
+	}
+
+	tokens, err := api.queries.GetSplitInflowTokenTotals(ctx, db.GetSplitInflowTokenTotalsParams{
+		SplitIDs: ids,
+		Since:    since,
+	})
+	if err != nil {
+		return SplitInflowReport{}, err
+	}
+
+	payers, err := api.queries.GetSplitInflowTopPayers(ctx, db.GetSplitInflowTopPayersParams{
+		SplitIDs: ids,
+		Since:    since,
+		Limit:    int32(limit),
+	})
+	if err != nil {
+		return SplitInflowReport{}, err
+	}
+
+	buckets, err := api.queries.GetSplitInflowTimeSeries(ctx, db.GetSplitInflowTimeSeriesParams{
+		Bucket:   bucket,
+		SplitIDs: ids,
+		Since:    since,
+	})
+	if err != nil {
+		return SplitInflowReport{}, err
+	}
+
+	report := SplitInflowReport{
+		Window:    window,
+		Tokens:    tokens,
+		TopPayers: payers,
+	}
+
+	for _, t := range tokens {
+		report.TotalUSD += t.UsdValue
+		report.InflowCount += int(t.InflowCount)
+	}
+
+	// Fill in the buckets without inflows so that clients can chart the series directly
+	next := func(t time.Time) time.Time { return t.AddDate(0, 0, 1) }
+	end := today
+	if bucket == "month" {
+		next = func(t time.Time) time.Time { return t.AddDate(0, 1, 0) }
+		end = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+		if len(buckets) > 0 {
+			since = buckets[0].BucketStart.UTC()
+		} else {
+			since = end
+		}
+	}
+
+	byStart := make(map[time.Time]db.GetSplitInflowTimeSeriesRow, len(buckets))
+	for _, b := range buckets {
+		byStart[b.BucketStart.UTC()] = b
+	}
+
+	report.TimeSeries = make([]db.GetSplitInflowTimeSeriesRow, 0)
+	for t := since; !t.After(end); t = next(t) {
+		b, ok := byStart[t]
+		if !ok {
+			b = db.GetSplitInflowTimeSeriesRow{BucketStart: t}
+		}
+		report.TimeSeries = append(report.TimeSeries, b)
+	}
+
+	return report, nil
+}
@@ -0,0 +1,184 @@
+package publicapi
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	db "github.com/SplitFi/go-splitfi/db/gen/coredb"
+	"github.com/SplitFi/go-splitfi/graphql/model"
+	"github.com/SplitFi/go-splitfi/service/persist"
+	"github.com/SplitFi/go-splitfi/util"
+	"github.com/SplitFi/go-splitfi/validate"
+)
+
+// SplitAsset is a token held by a split
+type SplitAsset struct {
+	db.GetTokensWithMetadataByOwnerAddressAndChainRow
+	TokenType persist.TokenType
+	// USDValue is the balance valued at the token's current price, or nil if the token has no price
+	USDValue *float64
+}
+
+// PaginateSplitAssets returns the tokens held by a split. Assets are sorted by USD value or by balance, then by ID.
+// Spam tokens are excluded unless the filter includes them.
+func (api SplitAPI) PaginateSplitAssets(ctx context.Context, splitID persist.DBID, filter *model.SplitAssetsFilter, sortBy model.SplitAssetSort, before, after *string, first, last *int) ([]SplitAsset, PageInfo, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"splitID": validate.WithTag(splitID, "required"),
+	}); err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	if err := validatePaginationParams(api.validator, first, last); err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	split, err := api.loaders.GetSplitByIdBatch.Load(splitID)
+	if err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	if filter == nil {
+		filter = &model.SplitAssetsFilter{}
+	}
+
+	// A split only holds tokens on the chain it's deployed to
+	if len(filter.Chains) > 0 && !util.Contains(filter.Chains, split.Chain) {
+		return []SplitAsset{}, PageInfo{Total: new(int)}, nil
+	}
+
+	tokens, err := api.queries.GetTokensWithMetadataByOwnerAddressAndChain(ctx, db.GetTokensWithMetadataByOwnerAddressAndChainParams{
+		OwnerAddress: split.Address,
+		Chain:        split.Chain,
+	})
+	if err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	tokenTypes := make(map[model.TokenType]bool, len(filter.TokenTypes))
+	for _, t := range filter.TokenTypes {
+		tokenTypes[t] = true
+	}
+
+	assets := make([]SplitAsset, 0, len(tokens))
+	for _, t := range tokens {
+		if t.IsSpam && (filter.IncludeSpam == nil || !*filter.IncludeSpam) {
+			continue
+		}
+
+		asset := SplitAsset{GetTokensWithMetadataByOwnerAddressAndChainRow: t, TokenType: persist.TokenTypeERC20}
+		if isNativeTokenAddress(split.Chain, t.Token.TokenAddress) {
+			asset.TokenType = persist.TokenTypeNative
+		}
+
+		if len(tokenTypes) > 0 && !tokenTypes[tokenTypeToModel(asset.TokenType)] {
+			continue
+		}
+
+		if t.Decimals.Valid && t.UsdPrice.Valid {
+			scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(t.Decimals.Int32)), nil)
+			price := new(big.Rat).SetFloat64(t.UsdPrice.Float64)
+			if price != nil {
+				v, _ := new(big.Rat).Mul(new(big.Rat).SetFrac(t.Token.Balance.BigInt(), scale), price).Float64()
+				asset.USDValue = &v
+			}
+		}
+
+		assets = append(assets, asset)
+	}
+
+	cursorFunc := func(a SplitAsset) (float64, string, persist.DBID, error) {
+		var value float64
+		if sortBy == model.SplitAssetSortValue && a.USDValue != nil {
+			value = *a.USDValue
+		}
+		return value, a.Token.Balance.BigInt().String(), a.Token.ID, nil
+	}
+
+	// Largest value first, then largest balance. Balances are compared as integers, since they're too large
+	// to compare as floats without losing precision.
+	less := func(a, b *floatStringIDCursor) bool {
+		if a.Float != b.Float {
+			return a.Float > b.Float
+		}
+		if c := decimalStringCmp(a.String, b.String); c != 0 {
+			return c > 0
+		}
+		return a.ID < b.ID
+	}
+
+	paginator := sortedPaginator[SplitAsset, *floatStringIDCursor]{
+		Cursorable: newFloatStringIDCursor(cursorFunc),
+		NewCursor:  cursors.NewFloatStringIDCursor,
+		Less:       less,
+	}
+
+	return paginator.paginate(assets, before, after, first, last)
+}
+
+// PaginateSplitShares returns a split's recipients, sorted by ownership and then by address
+func (api SplitAPI) PaginateSplitShares(ctx context.Context, splitID persist.DBID, before, after *string, first, last *int) ([]db.Recipient, PageInfo, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"splitID": validate.WithTag(splitID, "required"),
+	}); err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	if err := validatePaginationParams(api.validator, first, last); err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	// Unknown splits are an error, rather than a split with no recipients
+	if _, err := api.loaders.GetSplitByIdBatch.Load(splitID); err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	recipients, err := api.queries.GetRecipientsBySplitID(ctx, splitID)
+	if err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	// A split has one recipient per address, so the address is unique within the split
+	cursorFunc := func(r db.Recipient) (int64, string, error) {
+		return int64(r.Ownership), r.Address.String(), nil
+	}
+
+	less := func(a, b *intStringCursor) bool {
+		if a.Int != b.Int {
+			return a.Int > b.Int
+		}
+		return a.String < b.String
+	}
+
+	paginator := sortedPaginator[db.Recipient, *intStringCursor]{
+		Cursorable: newIntStringCursor(cursorFunc),
+		NewCursor:  cursors.NewIntStringCursor,
+		Less:       less,
+	}
+
+	return paginator.paginate(recipients, before, after, first, last)
+}
+
+// isNativeTokenAddress reports whether a token is the chain's native token, which is held at the zero address
+func isNativeTokenAddress(chain persist.Chain, address persist.Address) bool {
+	return chain.NormalizeAddress(address) == chain.NormalizeAddress(persist.Address(persist.ZeroAddress))
+}
+
+func tokenTypeToModel(t persist.TokenType) model.TokenType {
+	if t == persist.TokenTypeNative {
+		return model.TokenTypeNative
+	}
+	return model.TokenTypeErc20
+}
+
+// decimalStringCmp compares two non-negative integers written in base 10
+func decimalStringCmp(a, b string) int {
+	x, _ := new(big.Int).SetString(a, 10)
+	y, _ := new(big.Int).SetString(b, 10)
+	if x == nil || y == nil {
+		return strings.Compare(a, b)
+	}
+	return x.Cmp(y)
+}
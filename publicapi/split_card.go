@@ -0,0 +1,32 @@
+package publicapi
+
+import (
+	"context"
+
+	"github.com/SplitFi/go-splitfi/service/card"
+	"github.com/SplitFi/go-splitfi/service/persist"
+	"github.com/SplitFi/go-splitfi/validate"
+)
+
+// GetSplitCardURL returns a link to a split's card. Empty options use the card's defaults.
+func (api SplitAPI) GetSplitCardURL(ctx context.Context, splitID persist.DBID, format, theme, size string) (string, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"splitID": validate.WithTag(splitID, "required"),
+	}); err != nil {
+		return "", err
+	}
+
+	opts, err := card.ParseOptions(format, theme, size)
+	if err != nil {
+		return "", validate.ErrInvalidInput{Parameters: []string{"format", "theme", "size"}, Reasons: []string{err.Error()}}
+	}
+
+	// The link's version is derived from the card itself, so it changes whenever the card does
+	splitCard, err := card.Load(ctx, api.queries, splitID)
+	if err != nil {
+		return "", err
+	}
+
+	return card.URL(splitCard, opts), nil
+}
@@ -0,0 +1,120 @@
+package publicapi
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	db "github.com/SplitFi/go-splitfi/db/gen/coredb"
+	"github.com/SplitFi/go-splitfi/service/persist"
+	"github.com/SplitFi/go-splitfi/validate"
+)
+
+// FollowSplit makes the viewer a follower of a split, so that they're notified when it receives funds. Following a
+// split the viewer already follows does nothing.
+func (api SplitAPI) FollowSplit(ctx context.Context, splitID persist.DBID) (db.Split, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"splitID": validate.WithTag(splitID, "required"),
+	}); err != nil {
+		return db.Split{}, err
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return db.Split{}, err
+	}
+
+	split, err := api.queries.GetSplitById(ctx, splitID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return db.Split{}, persist.ErrSplitNotFound{ID: splitID}
+	}
+	if err != nil {
+		return db.Split{}, err
+	}
+
+	err = api.queries.FollowSplit(ctx, db.FollowSplitParams{
+		ID:      persist.GenerateID(),
+		SplitID: splitID,
+		UserID:  userID,
+	})
+	if err != nil {
+		return db.Split{}, err
+	}
+
+	return split, nil
+}
+
+// UnfollowSplit stops the viewer from following a split. Members of the split are still notified about it.
+func (api SplitAPI) UnfollowSplit(ctx context.Context, splitID persist.DBID) error {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"splitID": validate.WithTag(splitID, "required"),
+	}); err != nil {
+		return err
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = api.queries.UnfollowSplit(ctx, db.UnfollowSplitParams{
+		SplitID: splitID,
+		UserID:  userID,
+	})
+	return err
+}
+
+// PaginateViewerFollowedSplits returns the splits the viewer follows, in the order they followed them
+func (api SplitAPI) PaginateViewerFollowedSplits(ctx context.Context, before, after *string, first, last *int) ([]db.Split, PageInfo, error) {
+	if err := validatePaginationParams(api.validator, first, last); err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	queryFunc := func(params timeIDPagingParams) ([]db.GetFollowedSplitsByUserIDPaginateRow, error) {
+		return api.queries.GetFollowedSplitsByUserIDPaginate(ctx, db.GetFollowedSplitsByUserIDPaginateParams{
+			UserID:        userID,
+			Limit:         params.Limit,
+			CurBeforeTime: params.CursorBeforeTime,
+			CurBeforeID:   params.CursorBeforeID,
+			CurAfterTime:  params.CursorAfterTime,
+			CurAfterID:    params.CursorAfterID,
+			PagingForward: params.PagingForward,
+		})
+	}
+
+	countFunc := func() (int, error) {
+		total, err := api.queries.CountFollowedSplitsByUserID(ctx, userID)
+		return int(total), err
+	}
+
+	cursorFunc := func(r db.GetFollowedSplitsByUserIDPaginateRow) (time.Time, persist.DBID, error) {
+		return r.FollowedAt, r.FollowID, nil
+	}
+
+	paginator := timeIDPaginator[db.GetFollowedSplitsByUserIDPaginateRow]{
+		QueryFunc:  queryFunc,
+		CursorFunc: cursorFunc,
+		CountFunc:  countFunc,
+	}
+
+	rows, pageInfo, err := paginator.paginate(before, after, first, last)
+	if err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	splits := make([]db.Split, len(rows))
+	for i, r := range rows {
+		splits[i] = r.Split
+	}
+
+	return splits, pageInfo, nil
+}
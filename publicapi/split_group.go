@@ -0,0 +1,486 @@
+package publicapi
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	db "github.com/SplitFi/go-splitfi/db/gen/coredb"
+	"github.com/SplitFi/go-splitfi/graphql/model"
+	"github.com/SplitFi/go-splitfi/service/distribution"
+	"github.com/SplitFi/go-splitfi/service/persist"
+	"github.com/SplitFi/go-splitfi/validate"
+)
+
+// maxSplitGroupSize is the most splits a group can have, which is one per chain in an L1 chain group
+const maxSplitGroupSize = 16
+
+// CreateSplitGroup links deployments of the same split on different chains into a group. The viewer must control
+// every split, and the splits must be on different chains within the same L1 chain group.
+func (api SplitAPI) CreateSplitGroup(ctx context.Context, name, description string, splitIDs []persist.DBID) (db.SplitGroup, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"name":        validate.WithTag(name, "max=200"),
+		"description": validate.WithTag(description, "max=600"),
+		"splitIDs":    validate.WithTag(splitIDs, "required,min=1,unique"),
+	}); err != nil {
+		return db.SplitGroup{}, err
+	}
+
+	if len(splitIDs) > maxSplitGroupSize {
+		return db.SplitGroup{}, validate.ErrInvalidInput{Parameters: []string{"splitIDs"}, Reasons: []string{"too many splits for one group"}}
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return db.SplitGroup{}, err
+	}
+
+	splits := make([]db.Split, len(splitIDs))
+	for i, splitID := range splitIDs {
+		splits[i], err = api.requireViewerSplitRole(ctx, userID, splitID, persist.SplitRoleController)
+		if err != nil {
+			return db.SplitGroup{}, err
+		}
+
+		if err := api.checkSplitGroupable(ctx, splits[i], splits[:i]); err != nil {
+			return db.SplitGroup{}, err
+		}
+	}
+
+	tx, err := api.repos.BeginTx(ctx)
+	if err != nil {
+		return db.SplitGroup{}, err
+	}
+	queries := api.queries.WithTx(tx)
+	defer tx.Rollback(ctx)
+
+	group, err := queries.InsertSplitGroup(ctx, db.InsertSplitGroupParams{
+		ID:          persist.GenerateID(),
+		L1Chain:     splits[0].Chain.L1Chain(),
+		Name:        name,
+		Description: description,
+		CreatorID:   userID,
+	})
+	if err != nil {
+		return db.SplitGroup{}, err
+	}
+
+	for _, split := range splits {
+		err = queries.AddSplitGroupMember(ctx, db.AddSplitGroupMemberParams{
+			ID:      persist.GenerateID(),
+			GroupID: group.ID,
+			SplitID: split.ID,
+			Chain:   split.Chain,
+		})
+		if err != nil {
+			return db.SplitGroup{}, err
+		}
+	}
+
+	return group, tx.Commit(ctx)
+}
+
+// AddSplitToGroup adds a split to a group. The viewer must control the split and every split already in the group.
+func (api SplitAPI) AddSplitToGroup(ctx context.Context, groupID, splitID persist.DBID) (db.SplitGroup, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"groupID": validate.WithTag(groupID, "required"),
+		"splitID": validate.WithTag(splitID, "required"),
+	}); err != nil {
+		return db.SplitGroup{}, err
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return db.SplitGroup{}, err
+	}
+
+	group, members, err := api.requireSplitGroupRole(ctx, userID, groupID, persist.SplitRoleController)
+	if err != nil {
+		return db.SplitGroup{}, err
+	}
+
+	split, err := api.requireViewerSplitRole(ctx, userID, splitID, persist.SplitRoleController)
+	if err != nil {
+		return db.SplitGroup{}, err
+	}
+
+	if split.Chain.L1Chain() != group.L1Chain {
+		return db.SplitGroup{}, validate.ErrInvalidInput{Parameters: []string{"splitID"}, Reasons: []string{"split is not on a chain in the group's L1 chain group"}}
+	}
+
+	if len(members) >= maxSplitGroupSize {
+		return db.SplitGroup{}, validate.ErrInvalidInput{Parameters: []string{"groupID"}, Reasons: []string{"too many splits for one group"}}
+	}
+
+	if err := api.checkSplitGroupable(ctx, split, members); err != nil {
+		return db.SplitGroup{}, err
+	}
+
+	err = api.queries.AddSplitGroupMember(ctx, db.AddSplitGroupMemberParams{
+		ID:      persist.GenerateID(),
+		GroupID: group.ID,
+		SplitID: split.ID,
+		Chain:   split.Chain,
+	})
+	if err != nil {
+		return db.SplitGroup{}, err
+	}
+
+	return group, nil
+}
+
+// RemoveSplitFromGroup removes a split from a group. The viewer must control every split in the group. The group
+// is deleted when its last split is removed, in which case the returned group is nil.
+func (api SplitAPI) RemoveSplitFromGroup(ctx context.Context, groupID, splitID persist.DBID) (*db.SplitGroup, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"groupID": validate.WithTag(groupID, "required"),
+		"splitID": validate.WithTag(splitID, "required"),
+	}); err != nil {
+		return nil, err
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	group, members, err := api.requireSplitGroupRole(ctx, userID, groupID, persist.SplitRoleController)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := api.repos.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	queries := api.queries.WithTx(tx)
+	defer tx.Rollback(ctx)
+
+	removed, err := queries.RemoveSplitGroupMember(ctx, db.RemoveSplitGroupMemberParams{GroupID: groupID, SplitID: splitID})
+	if err != nil {
+		return nil, err
+	}
+	if removed == 0 {
+		return nil, validate.ErrInvalidInput{Parameters: []string{"splitID"}, Reasons: []string{"split is not in the group"}}
+	}
+
+	if len(members) <= 1 {
+		if err := queries.DeleteSplitGroup(ctx, groupID); err != nil {
+			return nil, err
+		}
+		return nil, tx.Commit(ctx)
+	}
+
+	return &group, tx.Commit(ctx)
+}
+
+// UpdateSplitGroupInfo updates a group's name and description, and copies them to every split in the group.
+// The viewer must be able to edit every split in the group.
+func (api SplitAPI) UpdateSplitGroupInfo(ctx context.Context, groupID persist.DBID, name, description *string) (db.SplitGroup, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"groupID":     validate.WithTag(groupID, "required"),
+		"name":        validate.WithTag(name, "omitempty,max=200"),
+		"description": validate.WithTag(description, "omitempty,max=600"),
+	}); err != nil {
+		return db.SplitGroup{}, err
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return db.SplitGroup{}, err
+	}
+
+	_, members, err := api.requireSplitGroupRole(ctx, userID, groupID, persist.SplitRoleEditor)
+	if err != nil {
+		return db.SplitGroup{}, err
+	}
+
+	params := db.UpdateSplitGroupInfoParams{ID: groupID}
+	if name != nil {
+		params.Name = *name
+		params.NameSet = true
+	}
+	if description != nil {
+		params.Description = *description
+		params.DescriptionSet = true
+	}
+
+	tx, err := api.repos.BeginTx(ctx)
+	if err != nil {
+		return db.SplitGroup{}, err
+	}
+	queries := api.queries.WithTx(tx)
+	defer tx.Rollback(ctx)
+
+	group, err := queries.UpdateSplitGroupInfo(ctx, params)
+	if err != nil {
+		return db.SplitGroup{}, err
+	}
+
+	for _, split := range members {
+		err = queries.UpdateSplitInfo(ctx, db.UpdateSplitInfoParams{
+			ID:             split.ID,
+			Name:           params.Name,
+			Description:    params.Description,
+			NameSet:        params.NameSet,
+			DescriptionSet: params.DescriptionSet,
+		})
+		if err != nil {
+			return db.SplitGroup{}, err
+		}
+
+		if _, err := createSplitRevision(ctx, queries, split.ID, persist.RevisionSourceUI); err != nil {
+			return db.SplitGroup{}, err
+		}
+	}
+
+	return group, tx.Commit(ctx)
+}
+
+// GetSplitGroupByID returns a split group
+func (api SplitAPI) GetSplitGroupByID(ctx context.Context, groupID persist.DBID) (*db.SplitGroup, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"groupID": validate.WithTag(groupID, "required"),
+	}); err != nil {
+		return nil, err
+	}
+
+	group, err := api.queries.GetSplitGroupByID(ctx, groupID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, persist.ErrSplitGroupNotFound{ID: groupID}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &group, nil
+}
+
+// GetSplitGroupBySplitID returns the group a split belongs to, or nil if it isn't in a group
+func (api SplitAPI) GetSplitGroupBySplitID(ctx context.Context, splitID persist.DBID) (*db.SplitGroup, error) {
+	group, err := api.queries.GetSplitGroupBySplitID(ctx, splitID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &group, nil
+}
+
+// GetSplitGroupSplits returns the splits in a group ordered by chain
+func (api SplitAPI) GetSplitGroupSplits(ctx context.Context, groupID persist.DBID) ([]db.Split, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"groupID": validate.WithTag(groupID, "required"),
+	}); err != nil {
+		return nil, err
+	}
+
+	return api.queries.GetSplitsByGroupID(ctx, groupID)
+}
+
+// GetSplitGroupBalances returns the balance of every token held by the splits in a group, ordered by chain and then
+// by token address
+func (api SplitAPI) GetSplitGroupBalances(ctx context.Context, groupID persist.DBID) ([]distribution.Claim, error) {
+	splits, err := api.GetSplitGroupSplits(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	balances := make([]distribution.Claim, 0)
+	for _, split := range splits {
+		tokens, err := api.queries.GetTokensByOwnerAddressAndChain(ctx, db.GetTokensByOwnerAddressAndChainParams{
+			OwnerAddress: split.Address,
+			Chain:        split.Chain,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, t := range tokens {
+			balances = append(balances, distribution.Claim{Chain: t.Chain, TokenAddress: t.TokenAddress, Amount: new(big.Int).Set(t.Balance.BigInt())})
+		}
+	}
+
+	sort.SliceStable(balances, func(i, j int) bool {
+		if balances[i].Chain != balances[j].Chain {
+			return balances[i].Chain < balances[j].Chain
+		}
+		return balances[i].TokenAddress < balances[j].TokenAddress
+	})
+
+	return balances, nil
+}
+
+// GetSplitGroupDistributionPreview previews how the token balances of every split in a group would be
+// distributed across each split's recipients
+func (api SplitAPI) GetSplitGroupDistributionPreview(ctx context.Context, groupID persist.DBID) ([]distribution.TokenDistribution, error) {
+	splits, err := api.GetSplitGroupSplits(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	distributions := make([]distribution.TokenDistribution, 0)
+	for _, split := range splits {
+		d, err := api.distributeSplitBalances(ctx, split)
+		if err != nil {
+			return nil, err
+		}
+		distributions = append(distributions, d...)
+	}
+
+	return distributions, nil
+}
+
+// PaginateSplitGroupDistributions returns the ledger entries recording funds that left any split in a group
+func (api SplitAPI) PaginateSplitGroupDistributions(ctx context.Context, groupID persist.DBID, before, after *string, first, last *int) ([]db.SplitLedgerEntry, PageInfo, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"groupID": validate.WithTag(groupID, "required"),
+	}); err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	if err := validatePaginationParams(api.validator, first, last); err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	queryFunc := func(params timeIDPagingParams) ([]db.SplitLedgerEntry, error) {
+		return api.queries.GetSplitGroupLedgerEntriesPaginate(ctx, db.GetSplitGroupLedgerEntriesPaginateParams{
+			GroupID:       groupID,
+			Limit:         params.Limit,
+			CurBeforeTime: params.CursorBeforeTime,
+			CurBeforeID:   params.CursorBeforeID,
+			CurAfterTime:  params.CursorAfterTime,
+			CurAfterID:    params.CursorAfterID,
+			PagingForward: params.PagingForward,
+		})
+	}
+
+	countFunc := func() (int, error) {
+		total, err := api.queries.CountSplitGroupLedgerEntries(ctx, groupID)
+		return int(total), err
+	}
+
+	cursorFunc := func(e db.SplitLedgerEntry) (time.Time, persist.DBID, error) {
+		return e.CreatedAt, e.ID, nil
+	}
+
+	paginator := timeIDPaginator[db.SplitLedgerEntry]{
+		QueryFunc:  queryFunc,
+		CursorFunc: cursorFunc,
+		CountFunc:  countFunc,
+	}
+
+	return paginator.paginate(before, after, first, last)
+}
+
+// GetSplitGroupInflowReport returns the funds the splits in a group received during window, combined as if they
+// were one split. The viewer must be able to see the analytics of every split in the group.
+func (api SplitAPI) GetSplitGroupInflowReport(ctx context.Context, groupID persist.DBID, window model.Window, topPayersLimit *int) (SplitInflowReport, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"groupID":        validate.WithTag(groupID, "required"),
+		"topPayersLimit": validate.WithTag(topPayersLimit, "omitempty,min=1,max=100"),
+	}); err != nil {
+		return SplitInflowReport{}, err
+	}
+
+	if !window.IsValid() {
+		return SplitInflowReport{}, validate.ErrInvalidInput{Parameters: []string{"window"}, Reasons: []string{"invalid report window"}}
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return SplitInflowReport{}, err
+	}
+
+	_, members, err := api.requireSplitGroupRole(ctx, userID, groupID, persist.SplitRoleViewer)
+	if err != nil {
+		return SplitInflowReport{}, err
+	}
+
+	splitIDs := make([]persist.DBID, len(members))
+	for i, split := range members {
+		splitIDs[i] = split.ID
+	}
+
+	return api.inflowReport(ctx, splitIDs, window, topPayersLimit)
+}
+
+// requireSplitGroupRole returns the group and its splits if the user's role on every split in the group is at
+// least min, or ErrSplitRoleRequired for the first split it isn't
+func (api SplitAPI) requireSplitGroupRole(ctx context.Context, userID, groupID persist.DBID, min persist.SplitRole) (db.SplitGroup, []db.Split, error) {
+	group, err := api.GetSplitGroupByID(ctx, groupID)
+	if err != nil {
+		return db.SplitGroup{}, nil, err
+	}
+
+	splits, err := api.queries.GetSplitsByGroupID(ctx, groupID)
+	if err != nil {
+		return db.SplitGroup{}, nil, err
+	}
+
+	for _, split := range splits {
+		if _, err := api.requireViewerSplitRole(ctx, userID, split.ID, min); err != nil {
+			return db.SplitGroup{}, nil, err
+		}
+	}
+
+	return *group, splits, nil
+}
+
+// checkSplitGroupable returns ErrInvalidInput if split can't join a group with others, because it is already in a
+// group, it shares a chain with one of the others, or it is in a different L1 chain group
+func (api SplitAPI) checkSplitGroupable(ctx context.Context, split db.Split, others []db.Split) error {
+	invalid := func(reason string) error {
+		return validate.ErrInvalidInput{Parameters: []string{"splitID"}, Reasons: []string{reason}}
+	}
+
+	for _, other := range others {
+		if other.Chain == split.Chain {
+			return invalid("a group can only have one split per chain")
+		}
+		if other.Chain.L1Chain() != split.Chain.L1Chain() {
+			return invalid("every split in a group must be in the same L1 chain group")
+		}
+	}
+
+	group, err := api.GetSplitGroupBySplitID(ctx, split.ID)
+	if err != nil {
+		return err
+	}
+	if group != nil {
+		return invalid("split is already in a group")
+	}
+
+	return nil
+}
+
+// checkSplitInfoEditable returns ErrInvalidInput if split is in a group. A grouped split shares its name and
+// description with the rest of the group, so they can only be changed for the whole group with UpdateSplitGroupInfo.
+func (api SplitAPI) checkSplitInfoEditable(ctx context.Context, splitID persist.DBID) error {
+	group, err := api.GetSplitGroupBySplitID(ctx, splitID)
+	if err != nil {
+		return err
+	}
+	if group != nil {
+		return validate.ErrInvalidInput{
+			Parameters: []string{"splitID"},
+			Reasons:    []string{"split is in a group, so its name and description must be changed with updateSplitGroupInfo"},
+		}
+	}
+
+	return nil
+}
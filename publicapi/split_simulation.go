@@ -0,0 +1,104 @@
+package publicapi
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	db "github.com/SplitFi/go-splitfi/db/gen/coredb"
+	"github.com/SplitFi/go-splitfi/graphql/model"
+	"github.com/SplitFi/go-splitfi/service/distribution"
+	"github.com/SplitFi/go-splitfi/service/persist"
+	"github.com/SplitFi/go-splitfi/validate"
+)
+
+// SplitChangeSimulation compares what a split's recipients receive with its current shares against what they would
+// receive after a change
+type SplitChangeSimulation struct {
+	Split               db.Split
+	Balances            []distribution.Comparison
+	HypotheticalInflows []distribution.Comparison
+}
+
+// SimulateSplitChange previews changing a split's shares. The change is validated exactly as UpdateSplitShares
+// would validate it, but is only applied in memory, so nothing is written. Amounts are distributed through any
+// nested splits to their end recipients. Each of hypotheticalInflows is distributed on its own, as if it were the
+// split's only balance of that token. The viewer must be a controller of the split.
+func (api SplitAPI) SimulateSplitChange(ctx context.Context, splitID persist.DBID, newShares []*model.SimulatedShareInput, hypotheticalInflows []*model.HypotheticalInflowInput) (SplitChangeSimulation, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"splitID":   validate.WithTag(splitID, "required"),
+		"newShares": validate.WithTag(newShares, "required,min=1"),
+	}); err != nil {
+		return SplitChangeSimulation{}, err
+	}
+
+	inflowAmounts := make([]*big.Int, len(hypotheticalInflows))
+	for i, inflow := range hypotheticalInflows {
+		amount, ok := new(big.Int).SetString(inflow.Amount, 10)
+		if !ok || amount.Sign() < 0 {
+			return SplitChangeSimulation{}, validate.ErrInvalidInput{
+				Parameters: []string{fmt.Sprintf("hypotheticalInflows[%d].amount", i)},
+				Reasons:    []string{"must be a non-negative integer in the token's base units"},
+			}
+		}
+		inflowAmounts[i] = amount
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return SplitChangeSimulation{}, err
+	}
+
+	split, err := api.requireViewerSplitRole(ctx, userID, splitID, persist.SplitRoleController)
+	if err != nil {
+		return SplitChangeSimulation{}, err
+	}
+
+	before, err := distribution.LoadGraph(ctx, api.queries, split)
+	if err != nil {
+		return SplitChangeSimulation{}, err
+	}
+
+	// The new shares are applied in memory only, and validated the same way updateSplitShares validates them
+	shares := make([]validate.OwnershipShare, len(newShares))
+	for i, s := range newShares {
+		shares[i] = validate.OwnershipShare{Address: persist.Address(split.Chain.NormalizeAddress(s.RecipientAddress)), Ownership: s.Ownership}
+	}
+
+	if err := validate.ValidateShares("shares", shares); err != nil {
+		return SplitChangeSimulation{}, err
+	}
+
+	after, err := validateSplitRecipients(ctx, api.queries, split, map[persist.DBID][]db.Recipient{
+		split.ID: recipientsFromShares(split.ID, shares),
+	})
+	if err != nil {
+		return SplitChangeSimulation{}, err
+	}
+
+	tokens, err := api.queries.GetTokensByOwnerAddressAndChain(ctx, db.GetTokensByOwnerAddressAndChainParams{
+		OwnerAddress: split.Address,
+		Chain:        split.Chain,
+	})
+	if err != nil {
+		return SplitChangeSimulation{}, err
+	}
+
+	simulation := SplitChangeSimulation{
+		Split:               split,
+		Balances:            make([]distribution.Comparison, len(tokens)),
+		HypotheticalInflows: make([]distribution.Comparison, len(hypotheticalInflows)),
+	}
+
+	for i, t := range tokens {
+		simulation.Balances[i] = distribution.CompareGraphs(t.Chain, t.TokenAddress, t.Balance.BigInt(), before, after)
+	}
+
+	for i, inflow := range hypotheticalInflows {
+		tokenAddress := persist.Address(split.Chain.NormalizeAddress(inflow.TokenAddress))
+		simulation.HypotheticalInflows[i] = distribution.CompareGraphs(split.Chain, tokenAddress, inflowAmounts[i], before, after)
+	}
+
+	return simulation, nil
+}
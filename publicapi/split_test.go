@@ -0,0 +1,226 @@
+package publicapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	db "github.com/SplitFi/go-splitfi/db/gen/coredb"
+	"github.com/SplitFi/go-splitfi/graphql/dataloader"
+	"github.com/SplitFi/go-splitfi/graphql/model"
+	"github.com/SplitFi/go-splitfi/service/persist"
+	"github.com/SplitFi/go-splitfi/util"
+	"github.com/SplitFi/go-splitfi/validate"
+)
+
+func TestGetSplitRole(t *testing.T) {
+	userID := persist.GenerateID()
+	// Undeployed splits have no address, so their roles are resolved without reading the chain
+	split := db.Split{ID: persist.GenerateID(), Chain: persist.ChainBase}
+
+	t.Run("granted controllers control the split", func(t *testing.T) {
+		api := newTestSplitAPI(newFakeDB(map[string][]any{
+			"GetSplitMember": {db.SplitMember{SplitID: split.ID, UserID: userID, Role: string(persist.SplitRoleController)}},
+		}))
+
+		role, err := api.getSplitRole(withViewer(userID), userID, split)
+		require.NoError(t, err)
+		assert.Equal(t, persist.SplitRoleController, role)
+	})
+
+	t.Run("recipients are viewers", func(t *testing.T) {
+		api := newTestSplitAPI(newFakeDB(map[string][]any{
+			"GetSplitByUserID": {split},
+		}))
+
+		role, err := api.getSplitRole(withViewer(userID), userID, split)
+		require.NoError(t, err)
+		assert.Equal(t, persist.SplitRoleViewer, role)
+		assert.False(t, role.AtLeast(persist.SplitRoleController))
+	})
+
+	t.Run("the creator's address doesn't make them controller", func(t *testing.T) {
+		creator := persist.Address("0x0000000000000000000000000000000000000001")
+		api := newTestSplitAPI(newFakeDB(map[string][]any{
+			"GetWalletsByUserID": {db.Wallet{Address: creator, Chain: persist.ChainBase}},
+		}))
+
+		role, err := api.getSplitRole(withViewer(userID), userID, db.Split{ID: split.ID, Chain: persist.ChainBase, CreatorAddress: creator})
+		require.NoError(t, err)
+		assert.False(t, role.AtLeast(persist.SplitRoleViewer))
+	})
+}
+
+func TestCreateSplitGrantsCreatorControl(t *testing.T) {
+	userID := persist.GenerateID()
+	splitID := persist.GenerateID()
+	fake := newFakeDB(map[string][]any{
+		"CreateSplit":         {db.Split{ID: splitID, Chain: persist.ChainBase}},
+		"UpsertSplitMember":   {db.SplitMember{SplitID: splitID, UserID: userID, Role: string(persist.SplitRoleController)}},
+		"CreateSplitRevision": {db.SplitRevision{SplitID: splitID, Revision: 1}},
+	})
+
+	_, err := createSplit(withViewer(userID), db.New(fake), db.CreateSplitParams{SplitID: splitID, Chain: persist.ChainBase}, nil)
+	require.NoError(t, err)
+
+	grants := fake.called("UpsertSplitMember")
+	require.Len(t, grants, 1)
+	assert.Equal(t, splitID, grants[0][1])
+	assert.Equal(t, userID, grants[0][2])
+	assert.Equal(t, string(persist.SplitRoleController), grants[0][3])
+
+	// The grant is what DeleteSplit and the other controller-only operations check for undeployed splits
+	api := newTestSplitAPI(newFakeDB(map[string][]any{
+		"GetSplitMember": {db.SplitMember{SplitID: splitID, UserID: userID, Role: grants[0][3].(string)}},
+	}))
+	role, err := api.getSplitRole(withViewer(userID), userID, db.Split{ID: splitID, Chain: persist.ChainBase})
+	require.NoError(t, err)
+	assert.True(t, role.AtLeast(persist.SplitRoleController))
+}
+
+func TestSplitDeletionQuorumReached(t *testing.T) {
+	total := int32(persist.OwnershipScale)
+
+	assert.False(t, splitDeletionQuorumReached(0, 51, total))
+	assert.False(t, splitDeletionQuorumReached(500000, 51, total))
+	assert.True(t, splitDeletionQuorumReached(510000, 51, total))
+	assert.True(t, splitDeletionQuorumReached(total, 100, total))
+	assert.False(t, splitDeletionQuorumReached(total-1, 100, total))
+
+	// Quorums are a share of the split's total ownership, not of the whole ownership scale
+	assert.True(t, splitDeletionQuorumReached(300000, 60, 500000))
+	assert.False(t, splitDeletionQuorumReached(299999, 60, 500000))
+}
+
+func TestGetSplitRoleCachesController(t *testing.T) {
+	userID := persist.GenerateID()
+	wallet := persist.Address("0x00000000000000000000000000000000000000aa")
+	split := db.Split{ID: persist.GenerateID(), Chain: persist.ChainBase, Address: "0x00000000000000000000000000000000000000bb"}
+
+	var lookups int
+	api := newTestSplitAPI(newFakeDB(map[string][]any{
+		"GetWalletsByUserID": {db.Wallet{Address: wallet, Chain: persist.ChainBase}},
+	}))
+	api.controllers = dataloader.NewGetSplitControllerBatch(context.Background(), false, func(ctx context.Context, address persist.Address) (persist.Address, error) {
+		assert.Equal(t, split.Address, address)
+		lookups++
+		return wallet, nil
+	})
+
+	for i := 0; i < 3; i++ {
+		role, err := api.getSplitRole(withViewer(userID), userID, split)
+		require.NoError(t, err)
+		assert.Equal(t, persist.SplitRoleController, role)
+	}
+
+	assert.Equal(t, 1, lookups, "the controller is read from chain once per request")
+}
+
+func TestUpdateSplitSharesRemovesRecipients(t *testing.T) {
+	userID := persist.GenerateID()
+	split := db.Split{ID: persist.GenerateID(), Chain: persist.ChainBase, TotalOwnership: int32(persist.OwnershipScale)}
+	kept := persist.Address("0x00000000000000000000000000000000000000aa")
+
+	// The new shares leave out every recipient but kept, so kept is all that's saved afterwards
+	fake := newFakeDB(map[string][]any{
+		"GetSplitById":           {split},
+		"GetRecipientsBySplitID": {db.Recipient{SplitID: split.ID, Address: kept, Ownership: int32(persist.OwnershipScale)}},
+	})
+
+	err := updateSplitShares(withViewer(userID), db.New(fake), []*model.SplitShareInput{
+		{SplitID: split.ID, RecipientAddress: "0x00000000000000000000000000000000000000AA", Ownership: int(persist.OwnershipScale)},
+	})
+	require.NoError(t, err)
+
+	deletes := fake.called("DeleteSplitRecipientsExcept")
+	require.Len(t, deletes, 1)
+	assert.Equal(t, split.ID, deletes[0][0])
+	assert.Equal(t, []string{kept.String()}, deletes[0][1], "recipients left out of the shares are deleted")
+
+	upserts := fake.called("UpsertSplitRecipients")
+	require.Len(t, upserts, 1)
+	assert.Equal(t, []string{kept.String()}, upserts[0][2])
+	assert.Equal(t, []int32{int32(persist.OwnershipScale)}, upserts[0][3])
+}
+
+func TestUpdateSplitSharesChecksRemainingOwnership(t *testing.T) {
+	split := db.Split{ID: persist.GenerateID(), Chain: persist.ChainBase, TotalOwnership: int32(persist.OwnershipScale)}
+
+	// The saved recipients only add up to half the split, so the update is rejected
+	fake := newFakeDB(map[string][]any{
+		"GetSplitById":           {split},
+		"GetRecipientsBySplitID": {db.Recipient{SplitID: split.ID, Address: "0x00000000000000000000000000000000000000aa", Ownership: 500000}},
+	})
+
+	err := updateSplitShares(withViewer(persist.GenerateID()), db.New(fake), []*model.SplitShareInput{
+		{SplitID: split.ID, RecipientAddress: "0x00000000000000000000000000000000000000aa", Ownership: 500000},
+	})
+	assert.IsType(t, validate.ErrInvalidInput{}, err)
+}
+
+func TestUpdateSplitInfoRejectsGroupedSplits(t *testing.T) {
+	splitID := persist.GenerateID()
+	fake := newFakeDB(map[string][]any{
+		"GetSplitGroupBySplitID": {db.SplitGroup{ID: persist.GenerateID(), L1Chain: persist.ChainBase.L1Chain(), Name: "group"}},
+	})
+	api := newTestSplitAPI(fake)
+	api.validator = validate.WithCustomValidators()
+
+	// Renaming one split of a group would leave the rest of the group with the old name
+	err := api.UpdateSplitInfo(withViewer(persist.GenerateID()), splitID, util.ToPointer("renamed"), util.ToPointer(""), util.ToPointer(""))
+	assert.IsType(t, validate.ErrInvalidInput{}, err)
+	assert.Empty(t, fake.called("UpdateSplitInfo"))
+
+	lookups := fake.called("GetSplitGroupBySplitID")
+	require.Len(t, lookups, 1)
+	assert.Equal(t, splitID, lookups[0][0])
+}
+
+func TestSimulateSplitChangeDoesNotWrite(t *testing.T) {
+	userID := persist.GenerateID()
+	split := db.Split{ID: persist.GenerateID(), Chain: persist.ChainBase, TotalOwnership: int32(persist.OwnershipScale)}
+	current := persist.Address("0x00000000000000000000000000000000000000aa")
+	added := persist.Address("0x00000000000000000000000000000000000000bb")
+
+	fake := newFakeDB(map[string][]any{
+		"GetSplitMember":         {db.SplitMember{SplitID: split.ID, UserID: userID, Role: string(persist.SplitRoleController)}},
+		"GetRecipientsBySplitID": {db.Recipient{SplitID: split.ID, Address: current, Ownership: int32(persist.OwnershipScale)}},
+	})
+	api := newTestSplitAPI(fake)
+	api.validator = validate.WithCustomValidators()
+	api.loaders = dataloader.NewLoaders(context.Background(), db.New(fake), false, nil, nil)
+	api.loaders.GetSplitByIdBatch.Prime(split.ID, split)
+
+	t.Run("compares the current and simulated recipients", func(t *testing.T) {
+		simulation, err := api.SimulateSplitChange(withViewer(userID), split.ID, []*model.SimulatedShareInput{
+			{RecipientAddress: current, Ownership: 500000},
+			{RecipientAddress: "0x00000000000000000000000000000000000000BB", Ownership: 500000},
+		}, []*model.HypotheticalInflowInput{
+			{TokenAddress: "0x00000000000000000000000000000000000000cc", Amount: "100"},
+		})
+		require.NoError(t, err)
+		require.Len(t, simulation.HypotheticalInflows, 1)
+
+		recipients := simulation.HypotheticalInflows[0].Recipients
+		require.Len(t, recipients, 2)
+		assert.Equal(t, current, recipients[0].Address)
+		assert.Equal(t, int64(100), recipients[0].Before.Int64())
+		assert.Equal(t, int64(50), recipients[0].After.Int64())
+		assert.Equal(t, added, recipients[1].Address)
+		assert.Equal(t, int64(0), recipients[1].Before.Int64())
+		assert.Equal(t, int64(50), recipients[1].After.Int64())
+	})
+
+	t.Run("rejects shares that UpdateSplitShares would reject", func(t *testing.T) {
+		_, err := api.SimulateSplitChange(withViewer(userID), split.ID, []*model.SimulatedShareInput{
+			{RecipientAddress: current, Ownership: 500000},
+		}, nil)
+		assert.IsType(t, validate.ErrInvalidInput{}, err)
+	})
+
+	// The recipients are only replaced in memory, so the split's saved recipients are never touched
+	assert.Empty(t, fake.called("DeleteSplitRecipientsExcept"))
+	assert.Empty(t, fake.called("UpsertSplitRecipients"))
+}
@@ -0,0 +1,291 @@
+package publicapi
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jackc/pgx/v4"
+
+	db "github.com/SplitFi/go-splitfi/db/gen/coredb"
+	"github.com/SplitFi/go-splitfi/env"
+	"github.com/SplitFi/go-splitfi/service/persist"
+	"github.com/SplitFi/go-splitfi/service/task"
+	"github.com/SplitFi/go-splitfi/service/webhook"
+	"github.com/SplitFi/go-splitfi/validate"
+)
+
+const maxWebhookURLLength = 2048
+
+type WebhookAPI struct {
+	queries    *db.Queries
+	validator  *validator.Validate
+	taskClient *task.Client
+	splits     *SplitAPI
+}
+
+// CreateWebhook registers a webhook for the viewer. A webhook with a split is sent events about that split, which
+// the viewer must be able to view. A webhook without a split is sent events about every split the viewer receives
+// from or has a role on; following a split isn't enough, since anyone can follow it. The returned webhook
+// includes the secret its deliveries are signed with.
+func (api WebhookAPI) CreateWebhook(ctx context.Context, webhookURL string, splitID *persist.DBID, eventTypes []persist.WebhookEventType) (db.Webhook, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"url":        validate.WithTag(webhookURL, "required"),
+		"eventTypes": validate.WithTag(eventTypes, "required,min=1"),
+	}); err != nil {
+		return db.Webhook{}, err
+	}
+
+	if err := validateWebhookURL(webhookURL); err != nil {
+		return db.Webhook{}, err
+	}
+
+	types, err := webhookEventTypesToStrings(eventTypes)
+	if err != nil {
+		return db.Webhook{}, err
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return db.Webhook{}, err
+	}
+
+	params := db.CreateWebhookParams{
+		ID:         persist.GenerateID(),
+		OwnerID:    userID,
+		Url:        webhookURL,
+		EventTypes: types,
+	}
+
+	if splitID != nil && *splitID != "" {
+		if _, err := api.splits.requireViewerSplitRole(ctx, userID, *splitID, persist.SplitRoleViewer); err != nil {
+			return db.Webhook{}, err
+		}
+		params.SplitID = splitID.String()
+	}
+
+	params.Secret, err = webhook.GenerateSecret()
+	if err != nil {
+		return db.Webhook{}, err
+	}
+
+	return api.queries.CreateWebhook(ctx, params)
+}
+
+// UpdateWebhook changes the viewer's webhook. Only the arguments that are set are changed.
+func (api WebhookAPI) UpdateWebhook(ctx context.Context, webhookID persist.DBID, webhookURL *string, eventTypes []persist.WebhookEventType, enabled *bool) (db.Webhook, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"webhookID": validate.WithTag(webhookID, "required"),
+	}); err != nil {
+		return db.Webhook{}, err
+	}
+
+	params := db.UpdateWebhookParams{ID: webhookID}
+
+	if webhookURL != nil {
+		if err := validateWebhookURL(*webhookURL); err != nil {
+			return db.Webhook{}, err
+		}
+		params.UrlSet = true
+		params.Url = *webhookURL
+	}
+
+	if eventTypes != nil {
+		if len(eventTypes) == 0 {
+			return db.Webhook{}, validate.ErrInvalidInput{Parameters: []string{"eventTypes"}, Reasons: []string{"at least one event type is required"}}
+		}
+
+		types, err := webhookEventTypesToStrings(eventTypes)
+		if err != nil {
+			return db.Webhook{}, err
+		}
+		params.EventTypesSet = true
+		params.EventTypes = types
+	}
+
+	if enabled != nil {
+		params.EnabledSet = true
+		params.Enabled = *enabled
+	}
+
+	if _, err := api.GetWebhookByID(ctx, webhookID); err != nil {
+		return db.Webhook{}, err
+	}
+
+	return api.queries.UpdateWebhook(ctx, params)
+}
+
+// DeleteWebhook deletes the viewer's webhook. Deliveries that haven't been sent yet are failed when they're attempted.
+func (api WebhookAPI) DeleteWebhook(ctx context.Context, webhookID persist.DBID) error {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"webhookID": validate.WithTag(webhookID, "required"),
+	}); err != nil {
+		return err
+	}
+
+	if _, err := api.GetWebhookByID(ctx, webhookID); err != nil {
+		return err
+	}
+
+	_, err := api.queries.DeleteWebhook(ctx, webhookID)
+	return err
+}
+
+// GetWebhookByID returns one of the viewer's webhooks. Webhooks of other users are not found.
+func (api WebhookAPI) GetWebhookByID(ctx context.Context, webhookID persist.DBID) (db.Webhook, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"webhookID": validate.WithTag(webhookID, "required"),
+	}); err != nil {
+		return db.Webhook{}, err
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return db.Webhook{}, err
+	}
+
+	w, err := api.queries.GetWebhookByID(ctx, webhookID)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && w.OwnerID != userID) {
+		return db.Webhook{}, persist.ErrWebhookNotFound{ID: webhookID}
+	}
+	if err != nil {
+		return db.Webhook{}, err
+	}
+
+	return w, nil
+}
+
+// GetViewerWebhooks returns the viewer's webhooks in the order they were created
+func (api WebhookAPI) GetViewerWebhooks(ctx context.Context) ([]db.Webhook, error) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.queries.GetWebhooksByOwnerID(ctx, userID)
+}
+
+// PingWebhook sends a test delivery to the viewer's webhook, even if it's disabled
+func (api WebhookAPI) PingWebhook(ctx context.Context, webhookID persist.DBID) (db.WebhookDelivery, error) {
+	w, err := api.GetWebhookByID(ctx, webhookID)
+	if err != nil {
+		return db.WebhookDelivery{}, err
+	}
+
+	return webhook.Ping(ctx, api.queries, api.taskClient, w)
+}
+
+// GetWebhookDeliveryByID returns a delivery to one of the viewer's webhooks
+func (api WebhookAPI) GetWebhookDeliveryByID(ctx context.Context, deliveryID persist.DBID) (db.WebhookDelivery, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"deliveryID": validate.WithTag(deliveryID, "required"),
+	}); err != nil {
+		return db.WebhookDelivery{}, err
+	}
+
+	d, err := api.queries.GetWebhookDeliveryByID(ctx, deliveryID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return db.WebhookDelivery{}, persist.ErrWebhookNotFound{ID: deliveryID}
+	}
+	if err != nil {
+		return db.WebhookDelivery{}, err
+	}
+
+	if _, err := api.GetWebhookByID(ctx, d.WebhookID); err != nil {
+		return db.WebhookDelivery{}, err
+	}
+
+	return d, nil
+}
+
+// PaginateWebhookDeliveries returns the deliveries to the viewer's webhook in the order they were created
+func (api WebhookAPI) PaginateWebhookDeliveries(ctx context.Context, webhookID persist.DBID, before, after *string, first, last *int) ([]db.WebhookDelivery, PageInfo, error) {
+	if err := validatePaginationParams(api.validator, first, last); err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	if _, err := api.GetWebhookByID(ctx, webhookID); err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	queryFunc := func(params timeIDPagingParams) ([]db.WebhookDelivery, error) {
+		return api.queries.GetWebhookDeliveriesPaginate(ctx, db.GetWebhookDeliveriesPaginateParams{
+			WebhookID:     webhookID,
+			Limit:         params.Limit,
+			CurBeforeTime: params.CursorBeforeTime,
+			CurBeforeID:   params.CursorBeforeID,
+			CurAfterTime:  params.CursorAfterTime,
+			CurAfterID:    params.CursorAfterID,
+			PagingForward: params.PagingForward,
+		})
+	}
+
+	countFunc := func() (int, error) {
+		total, err := api.queries.CountWebhookDeliveries(ctx, webhookID)
+		return int(total), err
+	}
+
+	cursorFunc := func(d db.WebhookDelivery) (time.Time, persist.DBID, error) {
+		return d.CreatedAt, d.ID, nil
+	}
+
+	paginator := timeIDPaginator[db.WebhookDelivery]{
+		QueryFunc:  queryFunc,
+		CursorFunc: cursorFunc,
+		CountFunc:  countFunc,
+	}
+
+	return paginator.paginate(before, after, first, last)
+}
+
+// validateWebhookURL requires webhooks to be sent over https, except when running locally
+func validateWebhookURL(webhookURL string) error {
+	invalid := func(reason string) error {
+		return validate.ErrInvalidInput{Parameters: []string{"url"}, Reasons: []string{reason}}
+	}
+
+	if len(webhookURL) > maxWebhookURLLength {
+		return invalid("url is too long")
+	}
+
+	u, err := url.Parse(webhookURL)
+	if err != nil || u.Host == "" {
+		return invalid("url must be an absolute url")
+	}
+
+	if u.Scheme != "https" && !(u.Scheme == "http" && env.GetString("ENV") == "local") {
+		return invalid("url must use https")
+	}
+
+	return nil
+}
+
+// webhookEventTypesToStrings returns the distinct event types, which must be ones that can be subscribed to
+func webhookEventTypesToStrings(eventTypes []persist.WebhookEventType) ([]string, error) {
+	subscribable := make(map[persist.WebhookEventType]bool, len(persist.WebhookEventTypes))
+	for _, t := range persist.WebhookEventTypes {
+		subscribable[t] = true
+	}
+
+	seen := make(map[persist.WebhookEventType]bool, len(eventTypes))
+	types := make([]string, 0, len(eventTypes))
+	for _, t := range eventTypes {
+		if !subscribable[t] {
+			return nil, validate.ErrInvalidInput{Parameters: []string{"eventTypes"}, Reasons: []string{"cannot subscribe to " + string(t) + " events"}}
+		}
+		if seen[t] {
+			continue
+		}
+		seen[t] = true
+		types = append(types, string(t))
+	}
+
+	return types, nil
+}
@@ -32,10 +32,15 @@ import (
 	graphql "github.com/SplitFi/go-splitfi/graphql/resolver"
 	"github.com/SplitFi/go-splitfi/middleware"
 	"github.com/SplitFi/go-splitfi/publicapi"
+	"github.com/SplitFi/go-splitfi/service/card"
+	"github.com/SplitFi/go-splitfi/service/export"
+	"github.com/SplitFi/go-splitfi/service/persist"
 	"github.com/SplitFi/go-splitfi/service/mediamapper"
 	"github.com/SplitFi/go-splitfi/service/notifications"
 	sentryutil "github.com/SplitFi/go-splitfi/service/sentry"
+	"github.com/SplitFi/go-splitfi/service/splitupdates"
 	"github.com/SplitFi/go-splitfi/service/throttle"
+	"github.com/SplitFi/go-splitfi/service/webhook"
 	"github.com/SplitFi/go-splitfi/util"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/everFinance/goar"
@@ -52,9 +57,159 @@ func HandlersInit(router *gin.Engine, repos *postgres.Repositories, queries *db.
 		return api
 	}
 	GraphqlHandlersInit(router, queries, taskClient, pub, lock, apqCache, authRefreshCache, publicapiF)
+	JobsHandlersInit(router, queries)
+	TasksHandlersInit(router, queries, storageClient, ethClient, taskClient)
+	CardHandlersInit(router, queries, httpClient)
 	return router
 }
 
+// TasksHandlersInit registers handlers for tasks submitted through the task client
+func TasksHandlersInit(router *gin.Engine, queries *db.Queries, storageClient *storage.Client, ethClient *ethclient.Client, taskClient *task.Client) {
+	tasksGroup := router.Group("/tasks")
+
+	// Return 200 on auth failures to prevent task retries
+	authOpts := middleware.BasicAuthOptionBuilder{}
+	ledgerExportsAuth := middleware.BasicHeaderAuthRequired(env.GetString("LEDGER_EXPORTS_TASK_SECRET"), authOpts.WithFailureStatus(http.StatusOK))
+	tasksGroup.POST("/export-ledger", ledgerExportsAuth, middleware.TaskRequired(), processLedgerExport(queries, storageClient, ethClient))
+
+	webhooksAuth := middleware.BasicHeaderAuthRequired(env.GetString("WEBHOOKS_TASK_SECRET"), authOpts.WithFailureStatus(http.StatusOK))
+	webhookClient := webhook.NewHTTPClient(env.GetString("ENV") == "local")
+	tasksGroup.POST("/deliver-webhook", webhooksAuth, middleware.TaskRequired(), deliverWebhook(queries, webhookClient, taskClient))
+}
+
+// processLedgerExport generates a ledger export that was too large to generate while it was requested
+func processLedgerExport(queries *db.Queries, storageClient *storage.Client, ethClient *ethclient.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var input task.LedgerExportMessage
+		if err := c.ShouldBindJSON(&input); err != nil {
+			// Return OK to remove message from queue
+			util.ErrResponse(c, http.StatusOK, err)
+			return
+		}
+
+		e, err := queries.GetLedgerExportByID(c, input.ExportID)
+		if err != nil {
+			util.ErrResponse(c, http.StatusOK, err)
+			return
+		}
+
+		// The task may be delivered more than once
+		if persist.LedgerExportStatus(e.Status) != persist.LedgerExportStatusPending {
+			c.JSON(http.StatusOK, util.SuccessResponse{Success: true})
+			return
+		}
+
+		// Run records failures to generate the export on the export itself, so an error here means the export
+		// couldn't be updated and the task should be retried
+		if _, err := export.Run(c, queries, storageClient, ethClient, e); err != nil {
+			util.ErrResponse(c, http.StatusInternalServerError, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, util.SuccessResponse{Success: true})
+	}
+}
+
+// deliverWebhook sends a webhook delivery. Unsuccessful deliveries are retried by submitting a new task rather than
+// by failing this one, so that retries can be spaced out.
+func deliverWebhook(queries *db.Queries, httpClient *http.Client, taskClient *task.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var input task.WebhookDeliveryMessage
+		if err := c.ShouldBindJSON(&input); err != nil {
+			// Return OK to remove message from queue
+			util.ErrResponse(c, http.StatusOK, err)
+			return
+		}
+
+		d, err := queries.GetWebhookDeliveryByID(c, input.DeliveryID)
+		if err != nil {
+			util.ErrResponse(c, http.StatusOK, err)
+			return
+		}
+
+		// The task may be delivered more than once
+		if persist.WebhookDeliveryStatus(d.Status) != persist.WebhookDeliveryStatusPending {
+			c.JSON(http.StatusOK, util.SuccessResponse{Success: true})
+			return
+		}
+
+		// Deliver records failures to send the delivery on the delivery itself, so an error here means the delivery
+		// couldn't be updated and the task should be retried
+		if _, err := webhook.Deliver(c, queries, httpClient, taskClient, d); err != nil {
+			util.ErrResponse(c, http.StatusInternalServerError, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, util.SuccessResponse{Success: true})
+	}
+}
+
+// CardHandlersInit registers handlers for the public cards that splits can be embedded and previewed with
+func CardHandlersInit(router *gin.Engine, queries *db.Queries, httpClient *http.Client) {
+	router.GET("/splits/:splitID/card", renderSplitCard(queries, httpClient))
+}
+
+// renderSplitCard renders a split's card. The "v" query parameter isn't read, it only makes links to a split's card
+// change whenever the split does.
+func renderSplitCard(queries *db.Queries, httpClient *http.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		opts, err := card.ParseOptions(c.Query("format"), c.Query("theme"), c.Query("size"))
+		if err != nil {
+			util.ErrResponse(c, http.StatusBadRequest, err)
+			return
+		}
+
+		splitCard, err := card.Load(c, queries, persist.DBID(c.Param("splitID")))
+		if err != nil {
+			if _, ok := err.(persist.ErrSplitNotFound); ok {
+				util.ErrResponse(c, http.StatusNotFound, err)
+				return
+			}
+			util.ErrResponse(c, http.StatusInternalServerError, err)
+			return
+		}
+
+		etag := splitCard.ETag(opts)
+		c.Header("ETag", etag)
+		c.Header("Cache-Control", card.CacheControl)
+		if c.GetHeader("If-None-Match") == etag {
+			c.Status(http.StatusNotModified)
+			return
+		}
+
+		data, err := splitCard.Render(c, httpClient, opts)
+		if err != nil {
+			util.ErrResponse(c, http.StatusInternalServerError, err)
+			return
+		}
+
+		c.Data(http.StatusOK, opts.ContentType(), data)
+	}
+}
+
+// JobsHandlersInit registers handlers for jobs that are run on a schedule
+func JobsHandlersInit(router *gin.Engine, queries *db.Queries) {
+	jobsGroup := router.Group("/jobs")
+	jobsGroup.GET("/refresh-search-relevance", middleware.CloudSchedulerMiddleware, refreshSearchRelevance(queries))
+}
+
+// refreshSearchRelevance recomputes the relevance scores that search results are ranked by
+func refreshSearchRelevance(queries *db.Queries) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if err := queries.RefreshUserRelevance(c); err != nil {
+			util.ErrResponse(c, http.StatusInternalServerError, err)
+			return
+		}
+
+		if err := queries.RefreshSplitRelevance(c); err != nil {
+			util.ErrResponse(c, http.StatusInternalServerError, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, util.SuccessResponse{Success: true})
+	}
+}
+
 func GraphqlHandlersInit(router *gin.Engine, queries *db.Queries, taskClient *task.Client, pub *pubsub.Client, lock *redislock.Client, apqCache *apq.APQCache, authRefreshCache *redis.Cache, publicapiF func(ctx context.Context, disableDataloaderCaching bool) *publicapi.PublicAPI) {
 	graphqlGroup := router.Group("/splitfi/graphql")
 	graphqlHandler := GraphQLHandler(queries, taskClient, pub, lock, apqCache, publicapiF)
@@ -66,6 +221,7 @@ func GraphqlHandlersInit(router *gin.Engine, queries *db.Queries, taskClient *ta
 func GraphQLHandler(queries *db.Queries, taskClient *task.Client, pub *pubsub.Client, lock *redislock.Client, apqCache *apq.APQCache, publicapiF func(ctx context.Context, disableDataloaderCaching bool) *publicapi.PublicAPI) gin.HandlerFunc {
 	config := generated.Config{Resolvers: &graphql.Resolver{}}
 	config.Directives.AuthRequired = graphql.AuthRequiredDirectiveHandler()
+	config.Directives.SplitRole = graphql.SplitRoleDirectiveHandler()
 	config.Directives.RestrictEnvironment = graphql.RestrictEnvironmentDirectiveHandler()
 	config.Directives.BasicAuth = graphql.BasicAuthDirectiveHandler()
 	config.Directives.FrontendBuildAuth = graphql.FrontendBuildAuthDirectiveHandler()
@@ -120,6 +276,7 @@ func GraphQLHandler(queries *db.Queries, taskClient *task.Client, pub *pubsub.Cl
 	h.AroundFields(graphql.RemapAndReportErrors)
 
 	notificationsHandler := notifications.New(queries, pub, taskClient, lock, true)
+	splitUpdatesHandler := splitupdates.New(pub, true)
 
 	h.AroundFields(graphql.MutationCachingHandler(publicapiF))
 
@@ -150,6 +307,7 @@ func GraphQLHandler(queries *db.Queries, taskClient *task.Client, pub *pubsub.Cl
 		mediamapper.AddTo(c)
 		event.AddTo(c, disableDataloaderCaching, notificationsHandler, queries, taskClient)
 		notifications.AddTo(c, notificationsHandler)
+		splitupdates.AddTo(c, splitUpdatesHandler)
 
 		// Use the request context so dataloaders will add their traces to the request span
 		publicapi.AddTo(c, publicapiF(c.Request.Context(), disableDataloaderCaching))
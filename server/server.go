@@ -198,9 +198,11 @@ func SetDefaults() {
 	viper.SetDefault("GAE_VERSION", "")
 	viper.SetDefault("TOKEN_PROCESSING_QUEUE", "projects/gallery-local/locations/here/queues/token-processing")
 	viper.SetDefault("GOOGLE_CLOUD_PROJECT", "gallery-dev-322005")
+	viper.SetDefault("SPLIT_DELETION_QUORUM", 51)
 	viper.SetDefault("PUBSUB_EMULATOR_HOST", "")
 	viper.SetDefault("PUBSUB_TOPIC_NEW_NOTIFICATIONS", "dev-new-notifications")
 	viper.SetDefault("PUBSUB_TOPIC_UPDATED_NOTIFICATIONS", "dev-updated-notifications")
+	viper.SetDefault("PUBSUB_TOPIC_SPLIT_UPDATES", "dev-split-updates")
 	viper.SetDefault("PUBSUB_SUB_NEW_NOTIFICATIONS", "dev-new-notifications-sub")
 	viper.SetDefault("PUBSUB_SUB_UPDATED_NOTIFICATIONS", "dev-updated-notifications-sub")
 	viper.SetDefault("EMAILS_HOST", "http://localhost:5500")
@@ -233,6 +235,13 @@ func SetDefaults() {
 	viper.SetDefault("AUTOSOCIAL_QUEUE", "projects/gallery-local/locations/here/queues/autosocial")
 	viper.SetDefault("AUTOSOCIAL_POLL_QUEUE", "projects/gallery-local/locations/here/queues/autosocial-poll")
 	viper.SetDefault("ACTIVITY_QUEUE", "projects/gallery-local/locations/here/queues/activity")
+	viper.SetDefault("BACKEND_URL", "http://localhost:4000")
+	viper.SetDefault("LEDGER_EXPORTS_QUEUE", "projects/gallery-local/locations/here/queues/ledger-exports")
+	viper.SetDefault("LEDGER_EXPORTS_TASK_SECRET", "ledger-exports-task-secret")
+	viper.SetDefault("GCLOUD_LEDGER_EXPORTS_BUCKET", "dev-ledger-exports")
+	viper.SetDefault("GCLOUD_SPLIT_MEDIA_BUCKET", "dev-split-media")
+	viper.SetDefault("WEBHOOKS_QUEUE", "projects/gallery-local/locations/here/queues/webhooks")
+	viper.SetDefault("WEBHOOKS_TASK_SECRET", "webhooks-task-secret")
 
 	viper.SetDefault("FARCASTER_MNEMONIC", "")
 	viper.SetDefault("FARCASTER_APP_ID", "")
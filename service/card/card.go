@@ -0,0 +1,232 @@
+package card
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	db "github.com/SplitFi/go-splitfi/db/gen/coredb"
+	"github.com/SplitFi/go-splitfi/env"
+	"github.com/SplitFi/go-splitfi/service/media"
+	"github.com/SplitFi/go-splitfi/service/persist"
+)
+
+// CacheControl is sent with every card. Cards are cheap to revalidate with their ETag, and links include the
+// card's Version so that caches fetch a new card as soon as the card changes.
+const CacheControl = "public, max-age=300, stale-while-revalidate=86400"
+
+type Format string
+
+const (
+	FormatSVG Format = "svg"
+	FormatPNG Format = "png"
+)
+
+type Theme string
+
+const (
+	ThemeLight Theme = "light"
+	ThemeDark  Theme = "dark"
+)
+
+type Size string
+
+const (
+	SizeSmall  Size = "small"
+	SizeMedium Size = "medium"
+	// SizeLarge is the size OpenGraph link previews are shown at
+	SizeLarge Size = "large"
+)
+
+// ErrInvalidOptions is returned when a card is requested with an unknown format, theme or size
+var ErrInvalidOptions = errors.New("invalid card options")
+
+// Options are how a card is rendered
+type Options struct {
+	Format Format
+	Theme  Theme
+	Size   Size
+}
+
+// ParseOptions reads Options from their query parameters. Empty parameters use the defaults: a light, medium SVG.
+func ParseOptions(format, theme, size string) (Options, error) {
+	opts := Options{Format: FormatSVG, Theme: ThemeLight, Size: SizeMedium}
+
+	switch Format(strings.ToLower(format)) {
+	case "":
+	case FormatSVG, FormatPNG:
+		opts.Format = Format(strings.ToLower(format))
+	default:
+		return Options{}, fmt.Errorf("%w: unknown format %q", ErrInvalidOptions, format)
+	}
+
+	switch Theme(strings.ToLower(theme)) {
+	case "":
+	case ThemeLight, ThemeDark:
+		opts.Theme = Theme(strings.ToLower(theme))
+	default:
+		return Options{}, fmt.Errorf("%w: unknown theme %q", ErrInvalidOptions, theme)
+	}
+
+	switch Size(strings.ToLower(size)) {
+	case "":
+	case SizeSmall, SizeMedium, SizeLarge:
+		opts.Size = Size(strings.ToLower(size))
+	default:
+		return Options{}, fmt.Errorf("%w: unknown size %q", ErrInvalidOptions, size)
+	}
+
+	return opts, nil
+}
+
+// ContentType returns the MIME type of a card rendered with opts
+func (o Options) ContentType() string {
+	if o.Format == FormatPNG {
+		return "image/png"
+	}
+	return "image/svg+xml"
+}
+
+// Card is what's shown on a split's card
+type Card struct {
+	SplitID          persist.DBID
+	Name             string
+	Chain            persist.Chain
+	RecipientCount   int
+	TotalReceivedUSD float64
+	// LogoURL is empty when the split doesn't have a logo that can be shown
+	LogoURL string
+	// UpdatedAt is when the split last changed
+	UpdatedAt time.Time
+}
+
+// Load returns the card for a split, or persist.ErrSplitNotFound if it doesn't exist
+func Load(ctx context.Context, queries *db.Queries, splitID persist.DBID) (Card, error) {
+	split, err := queries.GetSplitById(ctx, splitID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return Card{}, persist.ErrSplitNotFound{ID: splitID}
+	}
+	if err != nil {
+		return Card{}, err
+	}
+
+	recipients, err := queries.GetRecipientsBySplitID(ctx, splitID)
+	if err != nil {
+		return Card{}, err
+	}
+
+	totals, err := queries.GetSplitInflowTokenTotals(ctx, db.GetSplitInflowTokenTotalsParams{
+		SplitIDs: []string{splitID.String()},
+	})
+	if err != nil {
+		return Card{}, err
+	}
+
+	c := Card{
+		SplitID:        split.ID,
+		Name:           split.Name,
+		Chain:          split.Chain,
+		RecipientCount: len(recipients),
+		UpdatedAt:      split.LastUpdated,
+	}
+
+	for _, t := range totals {
+		c.TotalReceivedUSD += t.UsdValue
+	}
+
+	// Prefer the smallest processed size of an uploaded logo
+	logo, err := queries.GetCurrentSplitMedia(ctx, db.GetCurrentSplitMediaParams{SplitID: splitID, Kind: string(persist.SplitMediaKindLogo)})
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return Card{}, err
+	}
+	if logo.SmallUrl.Valid {
+		c.LogoURL = logo.SmallUrl.String
+	} else if isHostedLogo(split.LogoUrl.String) {
+		c.LogoURL = split.LogoUrl.String
+	}
+
+	return c, nil
+}
+
+// isHostedLogo reports whether a logo is stored in the split media bucket. Cards are rendered on the server, so
+// logos anywhere else aren't fetched.
+func isHostedLogo(logoURL string) bool {
+	return logoURL != "" && strings.HasPrefix(logoURL, media.PublicURL(""))
+}
+
+// ETag identifies the card rendered with opts. It changes whenever anything shown on the card does.
+func (c Card) ETag(opts Options) string {
+	h := sha256.New()
+	c.writeContent(h)
+	fmt.Fprintf(h, "|%s|%s|%s", opts.Format, opts.Theme, opts.Size)
+	return `"` + hex.EncodeToString(h.Sum(nil)[:16]) + `"`
+}
+
+// Version returns a short string for busting caches of the card. It's derived from the same inputs as ETag, so it
+// changes whenever the card does, including when the split receives funds without otherwise changing.
+func (c Card) Version() string {
+	h := sha256.New()
+	c.writeContent(h)
+	return hex.EncodeToString(h.Sum(nil)[:6])
+}
+
+// writeContent writes everything shown on the card to w
+func (c Card) writeContent(w io.Writer) {
+	fmt.Fprintf(w, "%s|%s|%d|%d|%.2f|%s|%d", c.SplitID, c.Name, c.Chain, c.RecipientCount, c.TotalReceivedUSD, c.LogoURL, c.UpdatedAt.UnixNano())
+}
+
+// URL returns the link to a card
+func URL(c Card, opts Options) string {
+	q := url.Values{}
+	q.Set("format", string(opts.Format))
+	q.Set("theme", string(opts.Theme))
+	q.Set("size", string(opts.Size))
+	q.Set("v", c.Version())
+	return fmt.Sprintf("%s/splits/%s/card?%s", strings.TrimSuffix(env.GetString("BACKEND_URL"), "/"), c.SplitID, q.Encode())
+}
+
+// lines returns the card's title and two lines of details
+func (c Card) lines() (title, recipients, received string) {
+	title = c.Name
+	if title == "" {
+		title = "Untitled split"
+	}
+
+	recipients = fmt.Sprintf("%d recipients", c.RecipientCount)
+	if c.RecipientCount == 1 {
+		recipients = "1 recipient"
+	}
+	if name := c.Chain.Name(); name != "" {
+		recipients = fmt.Sprintf("%s · %s", recipients, name)
+	}
+
+	return title, recipients, fmt.Sprintf("%s received", formatUSD(c.TotalReceivedUSD))
+}
+
+// formatUSD formats an amount of dollars compactly, rounding down so that cards never overstate what was received
+func formatUSD(v float64) string {
+	compact := func(v float64) string {
+		return strings.TrimSuffix(strconv.FormatFloat(math.Floor(v*10)/10, 'f', 1, 64), ".0")
+	}
+
+	switch {
+	case v >= 1e9:
+		return "$" + compact(v/1e9) + "B"
+	case v >= 1e6:
+		return "$" + compact(v/1e6) + "M"
+	case v >= 1e3:
+		return "$" + compact(v/1e3) + "K"
+	default:
+		return "$" + strconv.FormatFloat(math.Floor(math.Max(v, 0)), 'f', 0, 64)
+	}
+}
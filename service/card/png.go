@@ -0,0 +1,98 @@
+package card
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	_ "image/gif"
+	_ "image/jpeg"
+	"image/png"
+
+	"golang.org/x/image/draw"
+	"golang.org/x/image/font"
+	"golang.org/x/image/math/fixed"
+	_ "golang.org/x/image/webp"
+
+	"github.com/SplitFi/go-splitfi/service/logger"
+)
+
+func renderPNG(ctx context.Context, c Card, lg *logo, opts Options) ([]byte, error) {
+	l := layouts[opts.Size]
+	p := palettes[opts.Theme]
+	title, recipients, received := c.fitLines(l)
+	titleY, recipientsY, receivedY := l.baselines()
+
+	img := image.NewRGBA(image.Rect(0, 0, l.width, l.height))
+	fill(img, img.Bounds(), p.border)
+	fill(img, img.Bounds().Inset(1), p.background)
+
+	bold, regular := fonts()
+	titleFace := face(bold, l.titleSize)
+	detailFace := face(regular, l.detailSize)
+	defer titleFace.Close()
+	defer detailFace.Close()
+
+	logoRect := image.Rect(l.padding, l.logoY(), l.padding+l.logoSize, l.logoY()+l.logoSize)
+	if !drawLogo(ctx, img, logoRect, c, lg) {
+		fill(img, logoRect, p.placeholder)
+
+		initialFace := face(bold, float64(l.logoSize/2))
+		defer initialFace.Close()
+
+		letter := initial(title)
+		width := font.MeasureString(initialFace, letter).Ceil()
+		metrics := initialFace.Metrics()
+		x := logoRect.Min.X + (l.logoSize-width)/2
+		y := logoRect.Min.Y + (l.logoSize+metrics.Ascent.Ceil()-metrics.Descent.Ceil())/2
+		drawText(img, initialFace, p.detail, x, y, letter)
+	}
+
+	x := l.textX()
+	drawText(img, titleFace, p.title, x, titleY, title)
+	drawText(img, detailFace, p.detail, x, recipientsY, recipients)
+	drawText(img, detailFace, p.detail, x, receivedY, received)
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// drawLogo draws a card's logo into r, cropping it to a square. It returns false if the logo couldn't be drawn.
+func drawLogo(ctx context.Context, dst draw.Image, r image.Rectangle, c Card, lg *logo) bool {
+	if lg == nil {
+		return false
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(lg.data))
+	if err != nil {
+		logger.For(ctx).Warnf("failed to decode logo of split %s for its card: %s", c.SplitID, err)
+		return false
+	}
+
+	b := src.Bounds()
+	side := b.Dx()
+	if b.Dy() < side {
+		side = b.Dy()
+	}
+	crop := image.Rect(0, 0, side, side).Add(b.Min).Add(image.Pt((b.Dx()-side)/2, (b.Dy()-side)/2))
+
+	draw.CatmullRom.Scale(dst, r, src, crop, draw.Over, nil)
+	return true
+}
+
+func fill(dst draw.Image, r image.Rectangle, c color.Color) {
+	draw.Draw(dst, r, image.NewUniform(c), image.Point{}, draw.Src)
+}
+
+func drawText(dst draw.Image, face font.Face, c color.Color, x, y int, s string) {
+	d := font.Drawer{
+		Dst:  dst,
+		Src:  image.NewUniform(c),
+		Face: face,
+		Dot:  fixed.P(x, y),
+	}
+	d.DrawString(s)
+}
@@ -0,0 +1,154 @@
+package card
+
+import (
+	"context"
+	"fmt"
+	"image/color"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/SplitFi/go-splitfi/service/logger"
+)
+
+// maxLogoSize is the most that's read of a logo. Logos are already resized when they're uploaded, so anything
+// larger isn't one of ours.
+const maxLogoSize = 2 << 20
+
+const logoFetchTimeout = 5 * time.Second
+
+// layout is where things are drawn on a card of a given size
+type layout struct {
+	width, height int
+	padding       int
+	logoSize      int
+	titleSize     float64
+	detailSize    float64
+	// stacked cards show the logo above the text rather than beside it
+	stacked bool
+}
+
+var layouts = map[Size]layout{
+	SizeSmall:  {width: 400, height: 100, padding: 16, logoSize: 68, titleSize: 20, detailSize: 13},
+	SizeMedium: {width: 600, height: 150, padding: 24, logoSize: 102, titleSize: 30, detailSize: 18},
+	SizeLarge:  {width: 1200, height: 630, padding: 80, logoSize: 200, titleSize: 72, detailSize: 36, stacked: true},
+}
+
+// textX returns where the card's text starts
+func (l layout) textX() int {
+	if l.stacked {
+		return l.padding
+	}
+	return l.padding*2 + l.logoSize
+}
+
+// baselines returns the baseline of the card's title and its two lines of details
+func (l layout) baselines() (title, recipients, received int) {
+	gap := int(l.detailSize * 0.6)
+	block := int(l.titleSize) + gap + 2*int(l.detailSize*1.4)
+
+	top := (l.height - block) / 2
+	if l.stacked {
+		top = l.padding + l.logoSize + l.padding
+	}
+
+	title = top + int(l.titleSize)
+	recipients = title + gap + int(l.detailSize*1.4)
+	received = recipients + int(l.detailSize*1.4)
+	return title, recipients, received
+}
+
+// logoY returns the top of the card's logo
+func (l layout) logoY() int {
+	if l.stacked {
+		return l.padding
+	}
+	return (l.height - l.logoSize) / 2
+}
+
+type palette struct {
+	background  color.RGBA
+	border      color.RGBA
+	title       color.RGBA
+	detail      color.RGBA
+	placeholder color.RGBA
+}
+
+var palettes = map[Theme]palette{
+	ThemeLight: {
+		background:  rgb(0xFFFFFF),
+		border:      rgb(0xE5E7EB),
+		title:       rgb(0x111827),
+		detail:      rgb(0x6B7280),
+		placeholder: rgb(0xF3F4F6),
+	},
+	ThemeDark: {
+		background:  rgb(0x111827),
+		border:      rgb(0x1F2937),
+		title:       rgb(0xF9FAFB),
+		detail:      rgb(0x9CA3AF),
+		placeholder: rgb(0x1F2937),
+	},
+}
+
+func rgb(v uint32) color.RGBA {
+	return color.RGBA{R: uint8(v >> 16), G: uint8(v >> 8), B: uint8(v), A: 0xFF}
+}
+
+func cssColor(c color.RGBA) string {
+	return fmt.Sprintf("#%02X%02X%02X", c.R, c.G, c.B)
+}
+
+// logo is a card's logo after it has been fetched
+type logo struct {
+	data        []byte
+	contentType string
+}
+
+// Render draws a card. If the card's logo can't be fetched, the card is drawn with a placeholder instead.
+func (c Card) Render(ctx context.Context, httpClient *http.Client, opts Options) ([]byte, error) {
+	var l *logo
+	if c.LogoURL != "" {
+		fetched, err := fetchLogo(ctx, httpClient, c.LogoURL)
+		if err != nil {
+			logger.For(ctx).Warnf("failed to fetch logo of split %s for its card: %s", c.SplitID, err)
+		} else {
+			l = &fetched
+		}
+	}
+
+	if opts.Format == FormatPNG {
+		return renderPNG(ctx, c, l, opts)
+	}
+	return renderSVG(c, l, opts), nil
+}
+
+func fetchLogo(ctx context.Context, httpClient *http.Client, url string) (logo, error) {
+	ctx, cancel := context.WithTimeout(ctx, logoFetchTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return logo{}, err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return logo{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return logo{}, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxLogoSize+1))
+	if err != nil {
+		return logo{}, err
+	}
+	if len(data) > maxLogoSize {
+		return logo{}, fmt.Errorf("logo is larger than %d bytes", maxLogoSize)
+	}
+
+	return logo{data: data, contentType: http.DetectContentType(data)}, nil
+}
@@ -0,0 +1,61 @@
+package card
+
+import (
+	"encoding/base64"
+	"fmt"
+	"html"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/SplitFi/go-splitfi/service/media"
+)
+
+func renderSVG(c Card, lg *logo, opts Options) []byte {
+	l := layouts[opts.Size]
+	p := palettes[opts.Theme]
+	title, recipients, received := c.fitLines(l)
+	titleY, recipientsY, receivedY := l.baselines()
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" role="img" aria-label="%s">`,
+		l.width, l.height, l.width, l.height, html.EscapeString(c.label()))
+	fmt.Fprintf(&b, `<rect x="0.5" y="0.5" width="%d" height="%d" rx="%d" fill="%s" stroke="%s"/>`,
+		l.width-1, l.height-1, l.padding/2, cssColor(p.background), cssColor(p.border))
+
+	x, y, size := l.padding, l.logoY(), l.logoSize
+	if lg != nil && media.ContentTypes[lg.contentType] {
+		fmt.Fprintf(&b, `<defs><clipPath id="logo"><rect x="%d" y="%d" width="%d" height="%d" rx="%d"/></clipPath></defs>`, x, y, size, size, size/8)
+		fmt.Fprintf(&b, `<image x="%d" y="%d" width="%d" height="%d" clip-path="url(#logo)" preserveAspectRatio="xMidYMid slice" href="data:%s;base64,%s"/>`,
+			x, y, size, size, lg.contentType, base64.StdEncoding.EncodeToString(lg.data))
+	} else {
+		fmt.Fprintf(&b, `<rect x="%d" y="%d" width="%d" height="%d" rx="%d" fill="%s"/>`, x, y, size, size, size/8, cssColor(p.placeholder))
+		fmt.Fprintf(&b, `<text x="%d" y="%d" font-family="%s" font-size="%d" font-weight="700" fill="%s" text-anchor="middle" dominant-baseline="central">%s</text>`,
+			x+size/2, y+size/2, fontFamily, size/2, cssColor(p.detail), html.EscapeString(initial(title)))
+	}
+
+	tx := l.textX()
+	fmt.Fprintf(&b, `<text x="%d" y="%d" font-family="%s" font-size="%g" font-weight="700" fill="%s">%s</text>`,
+		tx, titleY, fontFamily, l.titleSize, cssColor(p.title), html.EscapeString(title))
+	fmt.Fprintf(&b, `<text x="%d" y="%d" font-family="%s" font-size="%g" fill="%s">%s</text>`,
+		tx, recipientsY, fontFamily, l.detailSize, cssColor(p.detail), html.EscapeString(recipients))
+	fmt.Fprintf(&b, `<text x="%d" y="%d" font-family="%s" font-size="%g" fill="%s">%s</text>`,
+		tx, receivedY, fontFamily, l.detailSize, cssColor(p.detail), html.EscapeString(received))
+	b.WriteString(`</svg>`)
+
+	return []byte(b.String())
+}
+
+// label returns the card's untruncated text on one line, for screen readers
+func (c Card) label() string {
+	title, recipients, received := c.lines()
+	return fmt.Sprintf("%s: %s, %s", title, recipients, received)
+}
+
+// initial returns the letter shown in place of a missing logo
+func initial(title string) string {
+	r, _ := utf8.DecodeRuneInString(title)
+	if r == utf8.RuneError {
+		return ""
+	}
+	return strings.ToUpper(string(r))
+}
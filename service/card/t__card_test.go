@@ -0,0 +1,119 @@
+package card
+
+import (
+	"bytes"
+	"context"
+	"image/png"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SplitFi/go-splitfi/service/persist"
+)
+
+func testCard() Card {
+	return Card{
+		SplitID:          "split",
+		Name:             `Royalties <b>& "friends"</b>`,
+		Chain:            persist.ChainBase,
+		RecipientCount:   3,
+		TotalReceivedUSD: 12345.67,
+		UpdatedAt:        time.Unix(1700000000, 0),
+	}
+}
+
+func TestParseOptions(t *testing.T) {
+	t.Run("uses defaults for empty options", func(t *testing.T) {
+		opts, err := ParseOptions("", "", "")
+		require.NoError(t, err)
+		assert.Equal(t, Options{Format: FormatSVG, Theme: ThemeLight, Size: SizeMedium}, opts)
+	})
+
+	t.Run("ignores case", func(t *testing.T) {
+		opts, err := ParseOptions("PNG", "Dark", "LARGE")
+		require.NoError(t, err)
+		assert.Equal(t, Options{Format: FormatPNG, Theme: ThemeDark, Size: SizeLarge}, opts)
+	})
+
+	t.Run("rejects unknown options", func(t *testing.T) {
+		_, err := ParseOptions("gif", "", "")
+		assert.ErrorIs(t, err, ErrInvalidOptions)
+	})
+}
+
+func TestFormatUSD(t *testing.T) {
+	assert.Equal(t, "$0", formatUSD(0))
+	assert.Equal(t, "$999", formatUSD(999.99))
+	assert.Equal(t, "$12.3K", formatUSD(12345.67))
+	assert.Equal(t, "$1M", formatUSD(1_000_000))
+	assert.Equal(t, "$1.9M", formatUSD(1_999_999))
+	assert.Equal(t, "$2.5B", formatUSD(2_500_000_000))
+}
+
+func TestETag(t *testing.T) {
+	c := testCard()
+	svg := Options{Format: FormatSVG, Theme: ThemeLight, Size: SizeMedium}
+	dark := Options{Format: FormatSVG, Theme: ThemeDark, Size: SizeMedium}
+
+	assert.Equal(t, c.ETag(svg), testCard().ETag(svg))
+	assert.NotEqual(t, c.ETag(svg), c.ETag(dark))
+
+	c.RecipientCount++
+	assert.NotEqual(t, testCard().ETag(svg), c.ETag(svg))
+}
+
+func TestVersion(t *testing.T) {
+	assert.Equal(t, testCard().Version(), testCard().Version())
+
+	// A card's version changes along with its ETag, including when nothing about the split itself changed
+	for _, change := range []func(*Card){
+		func(c *Card) { c.TotalReceivedUSD += 100 },
+		func(c *Card) { c.RecipientCount++ },
+		func(c *Card) { c.UpdatedAt = c.UpdatedAt.Add(time.Second) },
+	} {
+		c := testCard()
+		change(&c)
+		assert.NotEqual(t, testCard().Version(), c.Version())
+	}
+}
+
+func TestRender(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("escapes the split's name in svgs", func(t *testing.T) {
+		data, err := testCard().Render(ctx, nil, Options{Format: FormatSVG, Theme: ThemeDark, Size: SizeLarge})
+		require.NoError(t, err)
+
+		svg := string(data)
+		assert.True(t, strings.HasPrefix(svg, "<svg"))
+		assert.NotContains(t, svg, "<b>")
+		assert.Contains(t, svg, "Royalties &lt;b&gt;&amp; &#34;friends&#34;&lt;/b&gt;")
+		assert.Contains(t, svg, "3 recipients · Base")
+		assert.Contains(t, svg, "$12.3K received")
+		assert.Contains(t, svg, `width="1200" height="630"`)
+	})
+
+	t.Run("truncates long names", func(t *testing.T) {
+		c := testCard()
+		c.Name = strings.Repeat("A very long split name ", 10)
+
+		data, err := c.Render(ctx, nil, Options{Format: FormatSVG, Theme: ThemeLight, Size: SizeSmall})
+		require.NoError(t, err)
+		assert.Contains(t, string(data), "…</text>")
+	})
+
+	t.Run("renders pngs at every size", func(t *testing.T) {
+		for size, l := range layouts {
+			data, err := testCard().Render(ctx, nil, Options{Format: FormatPNG, Theme: ThemeLight, Size: size})
+			require.NoError(t, err)
+
+			img, err := png.Decode(bytes.NewReader(data))
+			require.NoError(t, err)
+			assert.Equal(t, l.width, img.Bounds().Dx())
+			assert.Equal(t, l.height, img.Bounds().Dy())
+		}
+	})
+}
@@ -0,0 +1,73 @@
+package card
+
+import (
+	"sync"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+// fontFamily is used by SVG cards. Text is measured with the Go fonts that PNG cards are drawn with, which are
+// about as wide as these.
+const fontFamily = "Inter, Helvetica, Arial, sans-serif"
+
+var (
+	fontsOnce sync.Once
+	boldFont  *opentype.Font
+	regFont   *opentype.Font
+)
+
+func fonts() (bold, regular *opentype.Font) {
+	fontsOnce.Do(func() {
+		var err error
+		if boldFont, err = opentype.Parse(gobold.TTF); err != nil {
+			panic(err)
+		}
+		if regFont, err = opentype.Parse(goregular.TTF); err != nil {
+			panic(err)
+		}
+	})
+	return boldFont, regFont
+}
+
+// face returns a face of f at size pixels
+func face(f *opentype.Font, size float64) font.Face {
+	face, err := opentype.NewFace(f, &opentype.FaceOptions{Size: size, DPI: 72, Hinting: font.HintingFull})
+	if err != nil {
+		panic(err)
+	}
+	return face
+}
+
+// truncate shortens s with an ellipsis until it's no wider than maxWidth when drawn with face
+func truncate(face font.Face, s string, maxWidth int) string {
+	limit := fixed.I(maxWidth)
+	if font.MeasureString(face, s) <= limit {
+		return s
+	}
+
+	runes := []rune(s)
+	for len(runes) > 0 {
+		runes = runes[:len(runes)-1]
+		if t := string(runes) + "…"; font.MeasureString(face, t) <= limit {
+			return t
+		}
+	}
+	return "…"
+}
+
+// fitLines returns the card's lines of text, truncated to fit the card
+func (c Card) fitLines(l layout) (title, recipients, received string) {
+	bold, regular := fonts()
+	titleFace := face(bold, l.titleSize)
+	detailFace := face(regular, l.detailSize)
+	defer titleFace.Close()
+	defer detailFace.Close()
+
+	maxWidth := l.width - l.textX() - l.padding
+	title, recipients, received = c.lines()
+	return truncate(titleFace, title, maxWidth), truncate(detailFace, recipients, maxWidth), truncate(detailFace, received, maxWidth)
+}
@@ -0,0 +1,225 @@
+package distribution
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/SplitFi/go-splitfi/db/gen/coredb"
+	"github.com/SplitFi/go-splitfi/service/persist"
+)
+
+// Share is a recipient's ownership of a split, in the same units as the split's total ownership
+type Share struct {
+	Address   persist.Address
+	Ownership int64
+}
+
+// Allocation is the amount of a token owed to a single recipient
+type Allocation struct {
+	Address persist.Address
+	Amount  *big.Int
+}
+
+// Result is the outcome of distributing a single token balance across a split's recipients
+type Result struct {
+	Balance *big.Int
+	// Distributed is the sum of all allocations
+	Distributed *big.Int
+	// Undistributed is the part of the balance that belongs to ownership not assigned to any recipient
+	Undistributed *big.Int
+	Allocations   []Allocation
+}
+
+// Distribute splits balance across shares proportionally to ownership/totalOwnership using integer math only.
+//
+// Every recipient first receives floor(balance * ownership / totalOwnership). The remaining dust, which is always
+// smaller than the number of recipients, is handed out one unit at a time to the recipients with the largest
+// truncated remainders, with ties broken by address. The result is deterministic for a given set of shares,
+// regardless of their order. Allocations are returned in the same order as shares.
+func Distribute(balance *big.Int, shares []Share, totalOwnership int64) Result {
+	result := Result{
+		Balance:       new(big.Int).Set(balance),
+		Distributed:   new(big.Int),
+		Undistributed: new(big.Int).Set(balance),
+		Allocations:   make([]Allocation, len(shares)),
+	}
+
+	for i, s := range shares {
+		result.Allocations[i] = Allocation{Address: s.Address, Amount: new(big.Int)}
+	}
+
+	if totalOwnership <= 0 || balance.Sign() <= 0 {
+		return result
+	}
+
+	var allocated int64
+	for _, s := range shares {
+		if s.Ownership > 0 {
+			allocated += s.Ownership
+		}
+	}
+
+	// Guard against over-allocated splits so that recipients can never be owed more than the balance
+	total := big.NewInt(totalOwnership)
+	if allocated > totalOwnership {
+		total.SetInt64(allocated)
+	}
+
+	remainders := make([]*big.Int, len(shares))
+	for i, s := range shares {
+		if s.Ownership <= 0 {
+			remainders[i] = new(big.Int)
+			continue
+		}
+		owned := big.NewInt(s.Ownership)
+
+		amount, remainder := new(big.Int).QuoRem(new(big.Int).Mul(balance, owned), total, new(big.Int))
+		result.Allocations[i].Amount = amount
+		remainders[i] = remainder
+		result.Distributed.Add(result.Distributed, amount)
+	}
+
+	// The amount attributable to recipients as a whole, which is what the sum of allocations should reach
+	distributable := new(big.Int).Quo(new(big.Int).Mul(balance, big.NewInt(allocated)), total)
+	dust := new(big.Int).Sub(distributable, result.Distributed).Int64()
+
+	if dust > 0 {
+		order := make([]int, 0, len(shares))
+		for i, s := range shares {
+			if s.Ownership > 0 {
+				order = append(order, i)
+			}
+		}
+		sort.SliceStable(order, func(a, b int) bool {
+			if c := remainders[order[a]].Cmp(remainders[order[b]]); c != 0 {
+				return c > 0
+			}
+			return shares[order[a]].Address < shares[order[b]].Address
+		})
+
+		for j := int64(0); j < dust && len(order) > 0; j++ {
+			i := order[j%int64(len(order))]
+			result.Allocations[i].Amount.Add(result.Allocations[i].Amount, big.NewInt(1))
+			result.Distributed.Add(result.Distributed, big.NewInt(1))
+		}
+	}
+
+	result.Undistributed.Sub(balance, result.Distributed)
+	return result
+}
+
+// TokenDistribution is the Result of distributing a single token held by a split
+type TokenDistribution struct {
+	Chain        persist.Chain
+	TokenAddress persist.Address
+	Result
+}
+
+// SharesFromRecipients converts a split's recipients into shares
+func SharesFromRecipients(recipients []coredb.Recipient) []Share {
+	shares := make([]Share, len(recipients))
+	for i, r := range recipients {
+		shares[i] = Share{Address: r.Address, Ownership: int64(r.Ownership)}
+	}
+	return shares
+}
+
+// DistributeTokens distributes every token balance held by a split across its recipients
+func DistributeTokens(tokens []coredb.Token, recipients []coredb.Recipient, totalOwnership int32) []TokenDistribution {
+	shares := SharesFromRecipients(recipients)
+	distributions := make([]TokenDistribution, len(tokens))
+	for i, t := range tokens {
+		distributions[i] = TokenDistribution{
+			Chain:        t.Chain,
+			TokenAddress: t.TokenAddress,
+			Result:       Distribute(t.Balance.BigInt(), shares, int64(totalOwnership)),
+		}
+	}
+	return distributions
+}
+
+// Claim is the amount of a single token a recipient is owed
+type Claim struct {
+	Chain        persist.Chain
+	TokenAddress persist.Address
+	Amount       *big.Int
+}
+
+// ClaimsFor returns what the recipients with the given addresses are owed in total across distributions
+func ClaimsFor(distributions []TokenDistribution, addresses ...persist.Address) []Claim {
+	claims := make([]Claim, 0, len(distributions))
+	for _, d := range distributions {
+		amount := new(big.Int)
+		for _, a := range d.Allocations {
+			for _, address := range addresses {
+				if a.Address == address {
+					amount.Add(amount, a.Amount)
+				}
+			}
+		}
+		claims = append(claims, Claim{Chain: d.Chain, TokenAddress: d.TokenAddress, Amount: amount})
+	}
+	return claims
+}
+
+// RecipientChange is what a single recipient receives of an amount before and after its split's shares change
+type RecipientChange struct {
+	Address persist.Address
+	Before  *big.Int
+	After   *big.Int
+}
+
+// Comparison is the outcome of distributing the same amount of a token with two different sets of shares
+type Comparison struct {
+	Chain        persist.Chain
+	TokenAddress persist.Address
+	Amount       *big.Int
+	Before       Result
+	After        Result
+	// Recipients lists every recipient in either set of shares, those in before first. A recipient missing from
+	// one set receives zero from it.
+	Recipients []RecipientChange
+}
+
+// Compare distributes amount with both before and after, which belong to a split with totalOwnership
+func Compare(chain persist.Chain, tokenAddress persist.Address, amount *big.Int, before, after []Share, totalOwnership int64) Comparison {
+	return compareResults(chain, tokenAddress, amount, Distribute(amount, before, totalOwnership), Distribute(amount, after, totalOwnership))
+}
+
+// CompareGraphs distributes amount through both before and after, which are graphs of the same split, so that
+// the comparison is between what each end recipient receives
+func CompareGraphs(chain persist.Chain, tokenAddress persist.Address, amount *big.Int, before, after Graph) Comparison {
+	return compareResults(chain, tokenAddress, amount, before.Distribute(amount), after.Distribute(amount))
+}
+
+func compareResults(chain persist.Chain, tokenAddress persist.Address, amount *big.Int, before, after Result) Comparison {
+	c := Comparison{
+		Chain:        chain,
+		TokenAddress: tokenAddress,
+		Amount:       new(big.Int).Set(amount),
+		Before:       before,
+		After:        after,
+	}
+
+	index := make(map[persist.Address]int, len(before.Allocations)+len(after.Allocations))
+	recipient := func(address persist.Address) *RecipientChange {
+		i, ok := index[address]
+		if !ok {
+			i = len(c.Recipients)
+			index[address] = i
+			c.Recipients = append(c.Recipients, RecipientChange{Address: address, Before: new(big.Int), After: new(big.Int)})
+		}
+		return &c.Recipients[i]
+	}
+
+	for _, a := range c.Before.Allocations {
+		r := recipient(a.Address)
+		r.Before.Add(r.Before, a.Amount)
+	}
+	for _, a := range c.After.Allocations {
+		r := recipient(a.Address)
+		r.After.Add(r.After, a.Amount)
+	}
+
+	return c
+}
@@ -0,0 +1,263 @@
+package distribution
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/SplitFi/go-splitfi/db/gen/coredb"
+	"github.com/SplitFi/go-splitfi/service/persist"
+)
+
+// maxGraphSplits bounds how many nested splits are loaded for a single split
+const maxGraphSplits = 64
+
+// GraphSplit is a single split within a Graph
+type GraphSplit struct {
+	TotalOwnership int64
+	Shares         []Share
+	// Children maps the addresses of shares that are themselves splits to those splits' IDs
+	Children map[persist.Address]persist.DBID
+}
+
+// Graph is a split together with every split reachable through its recipients
+type Graph struct {
+	Root   persist.DBID
+	Splits map[persist.DBID]GraphSplit
+}
+
+// EffectiveShare is the fraction of the root split's funds that an end recipient ultimately receives
+type EffectiveShare struct {
+	Address  persist.Address
+	Fraction *big.Rat
+}
+
+// LoadGraph loads every split reachable from root through recipients that are themselves splits on the
+// same chain. It returns persist.ErrSplitCycle if funds sent to root could flow back into a split they
+// already passed through.
+func LoadGraph(ctx context.Context, queries *coredb.Queries, root coredb.Split) (Graph, error) {
+	return LoadGraphWithRecipients(ctx, queries, root, nil)
+}
+
+// LoadGraphWithRecipients loads the graph as LoadGraph does, except that the splits in recipients use the given
+// recipients instead of the ones saved in the database. It's used to check changes to shares before they're saved.
+func LoadGraphWithRecipients(ctx context.Context, queries *coredb.Queries, root coredb.Split, recipients map[persist.DBID][]coredb.Recipient) (Graph, error) {
+	g := Graph{Root: root.ID, Splits: make(map[persist.DBID]GraphSplit)}
+
+	queue := []coredb.Split{root}
+	for len(queue) > 0 {
+		split := queue[0]
+		queue = queue[1:]
+
+		if _, ok := g.Splits[split.ID]; ok {
+			continue
+		}
+
+		if len(g.Splits) >= maxGraphSplits {
+			return Graph{}, fmt.Errorf("split %s has more than %d nested splits", root.ID, maxGraphSplits)
+		}
+
+		splitRecipients, ok := recipients[split.ID]
+		if !ok {
+			var err error
+			splitRecipients, err = queries.GetRecipientsBySplitID(ctx, split.ID)
+			if err != nil {
+				return Graph{}, err
+			}
+		}
+
+		node := GraphSplit{
+			TotalOwnership: int64(split.TotalOwnership),
+			Shares:         SharesFromRecipients(splitRecipients),
+			Children:       make(map[persist.Address]persist.DBID),
+		}
+
+		for _, r := range splitRecipients {
+			address := persist.Address(split.Chain.NormalizeAddress(r.Address))
+			if address == "" {
+				continue
+			}
+
+			child, err := queries.GetSplitByChainAddress(ctx, coredb.GetSplitByChainAddressParams{
+				Address: address,
+				Chain:   split.Chain,
+			})
+			if errors.Is(err, pgx.ErrNoRows) {
+				continue
+			}
+			if err != nil {
+				return Graph{}, err
+			}
+
+			node.Children[r.Address] = child.ID
+			queue = append(queue, child)
+		}
+
+		g.Splits[split.ID] = node
+	}
+
+	return g, g.checkAcyclic()
+}
+
+// checkAcyclic returns persist.ErrSplitCycle describing the first cycle found, if any
+func (g Graph) checkAcyclic() error {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := make(map[persist.DBID]int, len(g.Splits))
+	var path []persist.DBID
+
+	var visit func(id persist.DBID) error
+	visit = func(id persist.DBID) error {
+		switch state[id] {
+		case visiting:
+			for i, p := range path {
+				if p == id {
+					cycle := append([]persist.DBID{}, path[i:]...)
+					return persist.ErrSplitCycle{SplitIDs: append(cycle, id)}
+				}
+			}
+		case visited:
+			return nil
+		}
+
+		state[id] = visiting
+		path = append(path, id)
+
+		// Visit children in share order so that the reported cycle is deterministic
+		node := g.Splits[id]
+		for _, s := range node.Shares {
+			if child, ok := node.Children[s.Address]; ok {
+				if err := visit(child); err != nil {
+					return err
+				}
+			}
+		}
+
+		path = path[:len(path)-1]
+		state[id] = visited
+		return nil
+	}
+
+	return visit(g.Root)
+}
+
+// EffectiveOwnership flattens the graph into the fraction of the root split's funds each end recipient
+// receives, ordered by fraction descending and then by address. The graph must be acyclic.
+func (g Graph) EffectiveOwnership() []EffectiveShare {
+	fractions := make(map[persist.Address]*big.Rat)
+
+	var walk func(id persist.DBID, weight *big.Rat)
+	walk = func(id persist.DBID, weight *big.Rat) {
+		node := g.Splits[id]
+		if node.TotalOwnership <= 0 {
+			return
+		}
+
+		// Match Distribute's handling of over-allocated splits
+		total := node.TotalOwnership
+		var allocated int64
+		for _, s := range node.Shares {
+			if s.Ownership > 0 {
+				allocated += s.Ownership
+			}
+		}
+		if allocated > total {
+			total = allocated
+		}
+
+		for _, s := range node.Shares {
+			if s.Ownership <= 0 {
+				continue
+			}
+
+			w := new(big.Rat).Mul(weight, big.NewRat(s.Ownership, total))
+			if child, ok := node.Children[s.Address]; ok {
+				walk(child, w)
+				continue
+			}
+
+			if f, ok := fractions[s.Address]; ok {
+				f.Add(f, w)
+			} else {
+				fractions[s.Address] = w
+			}
+		}
+	}
+
+	walk(g.Root, big.NewRat(1, 1))
+
+	shares := make([]EffectiveShare, 0, len(fractions))
+	for address, f := range fractions {
+		shares = append(shares, EffectiveShare{Address: address, Fraction: f})
+	}
+
+	sort.Slice(shares, func(i, j int) bool {
+		if c := shares[i].Fraction.Cmp(shares[j].Fraction); c != 0 {
+			return c > 0
+		}
+		return shares[i].Address < shares[j].Address
+	})
+
+	return shares
+}
+
+// Distribute distributes balance from the root split down through every nested split, so that allocations
+// only go to end recipients. Each split rounds its own distribution exactly as Distribute does, and anything
+// left undistributed by a nested split counts towards the result's Undistributed. The graph must be acyclic.
+func (g Graph) Distribute(balance *big.Int) Result {
+	result := Result{
+		Balance:       new(big.Int).Set(balance),
+		Distributed:   new(big.Int),
+		Undistributed: new(big.Int),
+		Allocations:   make([]Allocation, 0),
+	}
+
+	index := make(map[persist.Address]int)
+
+	var walk func(id persist.DBID, amount *big.Int)
+	walk = func(id persist.DBID, amount *big.Int) {
+		node := g.Splits[id]
+		r := Distribute(amount, node.Shares, node.TotalOwnership)
+
+		for _, a := range r.Allocations {
+			if child, ok := node.Children[a.Address]; ok {
+				walk(child, a.Amount)
+				continue
+			}
+
+			if i, ok := index[a.Address]; ok {
+				result.Allocations[i].Amount.Add(result.Allocations[i].Amount, a.Amount)
+			} else {
+				index[a.Address] = len(result.Allocations)
+				result.Allocations = append(result.Allocations, Allocation{Address: a.Address, Amount: new(big.Int).Set(a.Amount)})
+			}
+			result.Distributed.Add(result.Distributed, a.Amount)
+		}
+	}
+
+	walk(g.Root, balance)
+
+	result.Undistributed.Sub(balance, result.Distributed)
+	return result
+}
+
+// DistributeTokens distributes every token balance held by the root split through the graph
+func (g Graph) DistributeTokens(tokens []coredb.Token) []TokenDistribution {
+	distributions := make([]TokenDistribution, len(tokens))
+	for i, t := range tokens {
+		distributions[i] = TokenDistribution{
+			Chain:        t.Chain,
+			TokenAddress: t.TokenAddress,
+			Result:       g.Distribute(t.Balance.BigInt()),
+		}
+	}
+	return distributions
+}
@@ -0,0 +1,143 @@
+package distribution
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/SplitFi/go-splitfi/service/persist"
+	"github.com/stretchr/testify/assert"
+)
+
+func amounts(r Result) []int64 {
+	out := make([]int64, len(r.Allocations))
+	for i, a := range r.Allocations {
+		out[i] = a.Amount.Int64()
+	}
+	return out
+}
+
+func TestDistribute(t *testing.T) {
+	t.Run("splits evenly divisible balances exactly", func(t *testing.T) {
+		r := Distribute(big.NewInt(1000), []Share{{"0xa", 60}, {"0xb", 40}}, 100)
+		assert.Equal(t, []int64{600, 400}, amounts(r))
+		assert.Equal(t, int64(0), r.Undistributed.Int64())
+	})
+
+	t.Run("hands out dust by largest remainder", func(t *testing.T) {
+		r := Distribute(big.NewInt(10), []Share{{"0xa", 1}, {"0xb", 1}, {"0xc", 1}}, 3)
+		assert.Equal(t, []int64{4, 3, 3}, amounts(r))
+		assert.Equal(t, int64(10), r.Distributed.Int64())
+	})
+
+	t.Run("dust assignment does not depend on share order", func(t *testing.T) {
+		r := Distribute(big.NewInt(10), []Share{{"0xc", 1}, {"0xb", 1}, {"0xa", 1}}, 3)
+		assert.Equal(t, []int64{3, 3, 4}, amounts(r))
+	})
+
+	t.Run("leaves unallocated ownership undistributed", func(t *testing.T) {
+		r := Distribute(big.NewInt(103), []Share{{"0xa", 25}, {"0xb", 25}}, 100)
+		assert.Equal(t, []int64{26, 25}, amounts(r))
+		assert.Equal(t, int64(52), r.Undistributed.Int64())
+	})
+
+	t.Run("never allocates more than the balance", func(t *testing.T) {
+		r := Distribute(big.NewInt(7), []Share{{"0xa", 80}, {"0xb", 80}}, 100)
+		assert.Equal(t, int64(7), r.Distributed.Int64())
+		assert.Equal(t, int64(0), r.Undistributed.Int64())
+	})
+
+	t.Run("handles balances larger than 64 bits", func(t *testing.T) {
+		balance, _ := new(big.Int).SetString("1000000000000000000000000000001", 10)
+		r := Distribute(balance, []Share{{persist.Address("0xa"), 1}, {persist.Address("0xb"), 1}}, 2)
+		assert.Equal(t, "500000000000000000000000000001", r.Allocations[0].Amount.String())
+		assert.Equal(t, "500000000000000000000000000000", r.Allocations[1].Amount.String())
+	})
+}
+
+func TestCompare(t *testing.T) {
+	t.Run("lists recipients from both sets of shares", func(t *testing.T) {
+		c := Compare(persist.ChainETH, "0xtoken", big.NewInt(1000), []Share{{"0xa", 50}, {"0xb", 50}}, []Share{{"0xb", 30}, {"0xc", 70}}, 100)
+
+		addresses := make([]persist.Address, len(c.Recipients))
+		for i, r := range c.Recipients {
+			addresses[i] = r.Address
+		}
+		assert.Equal(t, []persist.Address{"0xa", "0xb", "0xc"}, addresses)
+
+		assert.Equal(t, []int64{500, 0}, []int64{c.Recipients[0].Before.Int64(), c.Recipients[0].After.Int64()})
+		assert.Equal(t, []int64{500, 300}, []int64{c.Recipients[1].Before.Int64(), c.Recipients[1].After.Int64()})
+		assert.Equal(t, []int64{0, 700}, []int64{c.Recipients[2].Before.Int64(), c.Recipients[2].After.Int64()})
+	})
+
+	t.Run("distributes the amount with each set of shares", func(t *testing.T) {
+		c := Compare(persist.ChainETH, "0xtoken", big.NewInt(10), []Share{{"0xa", 50}}, []Share{{"0xa", 100}}, 100)
+		assert.Equal(t, int64(5), c.Before.Undistributed.Int64())
+		assert.Equal(t, int64(0), c.After.Undistributed.Int64())
+	})
+}
+
+func TestGraph(t *testing.T) {
+	// root pays 50% to 0xa and 50% to the band split, which pays 0xb and 0xc equally
+	nested := Graph{
+		Root: "root",
+		Splits: map[persist.DBID]GraphSplit{
+			"root": {
+				TotalOwnership: 100,
+				Shares:         []Share{{"0xa", 50}, {"0xband", 50}},
+				Children:       map[persist.Address]persist.DBID{"0xband": "band"},
+			},
+			"band": {
+				TotalOwnership: 2,
+				Shares:         []Share{{"0xb", 1}, {"0xc", 1}},
+				Children:       map[persist.Address]persist.DBID{},
+			},
+		},
+	}
+
+	t.Run("flattens nested splits to end recipients", func(t *testing.T) {
+		shares := nested.EffectiveOwnership()
+		assert.Len(t, shares, 3)
+		assert.Equal(t, persist.Address("0xa"), shares[0].Address)
+		assert.Equal(t, "1/2", shares[0].Fraction.String())
+		assert.Equal(t, persist.Address("0xb"), shares[1].Address)
+		assert.Equal(t, "1/4", shares[1].Fraction.String())
+		assert.Equal(t, "1/4", shares[2].Fraction.String())
+	})
+
+	t.Run("distributes through nested splits", func(t *testing.T) {
+		r := nested.Distribute(big.NewInt(101))
+		assert.Equal(t, []int64{51, 25, 25}, amounts(r))
+		assert.Equal(t, int64(101), r.Distributed.Int64())
+		assert.Equal(t, int64(0), r.Undistributed.Int64())
+	})
+
+	t.Run("compares end recipients of two graphs", func(t *testing.T) {
+		flat := Graph{
+			Root: "root",
+			Splits: map[persist.DBID]GraphSplit{
+				"root": {TotalOwnership: 100, Shares: []Share{{"0xa", 100}}, Children: map[persist.Address]persist.DBID{}},
+			},
+		}
+
+		c := CompareGraphs(persist.ChainBase, "0xtoken", big.NewInt(100), flat, nested)
+		assert.Len(t, c.Recipients, 3)
+		assert.Equal(t, persist.Address("0xa"), c.Recipients[0].Address)
+		assert.Equal(t, int64(100), c.Recipients[0].Before.Int64())
+		assert.Equal(t, int64(50), c.Recipients[0].After.Int64())
+		assert.Equal(t, int64(0), c.Recipients[1].Before.Int64())
+		assert.Equal(t, int64(25), c.Recipients[1].After.Int64())
+	})
+
+	t.Run("detects cycles", func(t *testing.T) {
+		cyclic := Graph{
+			Root: "a",
+			Splits: map[persist.DBID]GraphSplit{
+				"a": {TotalOwnership: 1, Shares: []Share{{"0xb", 1}}, Children: map[persist.Address]persist.DBID{"0xb": "b"}},
+				"b": {TotalOwnership: 1, Shares: []Share{{"0xa", 1}}, Children: map[persist.Address]persist.DBID{"0xa": "a"}},
+			},
+		}
+		err := cyclic.checkAcyclic()
+		assert.Equal(t, persist.ErrSplitCycle{SplitIDs: []persist.DBID{"a", "b", "a"}}, err)
+		assert.NoError(t, nested.checkAcyclic())
+	})
+}
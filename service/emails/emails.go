@@ -9,6 +9,7 @@ import (
 
 	"github.com/SplitFi/go-splitfi/env"
 	"github.com/SplitFi/go-splitfi/graphql/model"
+	"github.com/SplitFi/go-splitfi/service/auth/basicauth"
 	"github.com/SplitFi/go-splitfi/service/persist"
 	"github.com/SplitFi/go-splitfi/util"
 )
@@ -69,6 +70,10 @@ type VerificationEmailInput struct {
 	UserID persist.DBID `json:"user_id" binding:"required"`
 }
 
+type RecipientInviteEmailInput struct {
+	InviteID persist.DBID `json:"invite_id" binding:"required"`
+}
+
 func VerifyEmail(ctx context.Context, token string) (VerifyEmailOutput, error) {
 	input := VerifyEmailInput{
 		JWT: token,
@@ -252,3 +257,34 @@ func RequestVerificationEmail(ctx context.Context, userID persist.DBID) error {
 
 	return nil
 }
+
+func SendRecipientInviteEmail(ctx context.Context, inviteID persist.DBID) error {
+	input := RecipientInviteEmailInput{
+		InviteID: inviteID,
+	}
+	body, err := json.Marshal(input)
+	if err != nil {
+		return err
+	}
+
+	buf := bytes.NewBuffer(body)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/send/recipient-invite", env.GetString("EMAILS_HOST")), buf)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", basicauth.MakeHeader(nil, env.GetString("EMAILS_TASK_SECRET")))
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return util.GetErrFromResp(resp)
+	}
+
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/SplitFi/go-splitfi/contracts"
 	"github.com/SplitFi/go-splitfi/service/persist"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -19,6 +20,12 @@ import (
 
 const ensContractAddress = "0xFaC7BEA255a6990f749363002136aF6556b31e04"
 
+// ensRegistryAddress is the address of the ENS registry on mainnet
+const ensRegistryAddress = "0x00000000000C2E074eC69A0dFb2997BA6C7d2e1e"
+
+// ensResolverABI is the subset of the ENS public resolver needed to resolve a name to an address
+const ensResolverABI = `[{"inputs":[{"internalType":"bytes32","name":"node","type":"bytes32"}],"name":"addr","outputs":[{"internalType":"address payable","name":"","type":"address"}],"stateMutability":"view","type":"function"}]`
+
 var (
 	// ErrAddressSignatureMismatch is returned when the address signature does not match the address cryptographically
 	ErrAddressSignatureMismatch = errors.New("address does not match signature")
@@ -48,6 +55,43 @@ func ResolvesENS(pCtx context.Context, ens string, userAddr persist.Address, eth
 
 }
 
+// ResolveENSAddress resolves an ENS name to the address it points to. It returns an empty address if the name
+// has no resolver or does not resolve to an address.
+func ResolveENSAddress(pCtx context.Context, ens string, ethcl *ethclient.Client) (persist.Address, error) {
+	registry, err := contracts.NewIENSCaller(common.HexToAddress(ensRegistryAddress), ethcl)
+	if err != nil {
+		return "", err
+	}
+
+	node := namehash(strings.ToLower(ens))
+
+	resolverAddress, err := registry.Resolver(&bind.CallOpts{Context: pCtx}, node)
+	if err != nil {
+		return "", err
+	}
+	if resolverAddress == (common.Address{}) {
+		return "", nil
+	}
+
+	parsed, err := abi.JSON(strings.NewReader(ensResolverABI))
+	if err != nil {
+		return "", err
+	}
+
+	var out []interface{}
+	resolver := bind.NewBoundContract(resolverAddress, parsed, ethcl, nil, nil)
+	if err := resolver.Call(&bind.CallOpts{Context: pCtx}, &out, "addr", node); err != nil {
+		return "", err
+	}
+
+	addr := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	if addr == (common.Address{}) {
+		return "", nil
+	}
+
+	return persist.Address(strings.ToLower(addr.String())), nil
+}
+
 // function that computes the namehash for a given ENS domain
 func namehash(name string) common.Hash {
 	node := common.Hash{}
@@ -0,0 +1,312 @@
+package export
+
+import (
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strconv"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/jackc/pgtype"
+
+	db "github.com/SplitFi/go-splitfi/db/gen/coredb"
+	"github.com/SplitFi/go-splitfi/env"
+	"github.com/SplitFi/go-splitfi/service/logger"
+	"github.com/SplitFi/go-splitfi/service/persist"
+)
+
+// RowTypeInflow is a transfer into a split. Ledger entries use their persist.LedgerEntryType as their row type.
+const RowTypeInflow = "inflow"
+
+// csvHeader is the header row of a CSV export, in the same order as Row's fields
+var csvHeader = []string{"type", "split_id", "chain", "token_address", "counterparty_address", "amount", "decimals", "tx_hash", "block_number", "block_time", "usd_value"}
+
+// Row is a single movement of funds in a ledger export
+type Row struct {
+	Type    string       `json:"type"`
+	SplitID persist.DBID `json:"split_id"`
+	Chain   string       `json:"chain"`
+	// TokenAddress is empty for a chain's native token
+	TokenAddress persist.Address `json:"token_address"`
+	// CounterpartyAddress is the payer of an inflow, or the recipient of a distribution or withdrawal
+	CounterpartyAddress persist.Address `json:"counterparty_address"`
+	// Amount is in the token's base units
+	Amount      string    `json:"amount"`
+	Decimals    *int32    `json:"decimals"`
+	TxHash      string    `json:"tx_hash"`
+	BlockNumber int64     `json:"block_number"`
+	BlockTime   time.Time `json:"block_time"`
+	USDValue    *float64  `json:"usd_value"`
+}
+
+// Bucket returns the storage bucket that ledger exports are uploaded to
+func Bucket() string {
+	return env.GetString("GCLOUD_LEDGER_EXPORTS_BUCKET")
+}
+
+// ObjectName returns the name of the object an export is uploaded as
+func ObjectName(e db.LedgerExport) string {
+	return fmt.Sprintf("ledger-exports/%s.%s", e.ID, e.Format)
+}
+
+// Run generates an export, uploads it and marks it as complete. If the export can't be generated it is marked
+// as failed, and the returned export records why.
+func Run(ctx context.Context, queries *db.Queries, stg *storage.Client, ethClient *ethclient.Client, e db.LedgerExport) (db.LedgerExport, error) {
+	rows, err := loadRows(ctx, queries, ethClient, e)
+	if err == nil {
+		err = upload(ctx, stg, e, rows)
+	}
+
+	if err != nil {
+		logger.For(ctx).Errorf("failed to generate ledger export %s: %s", e.ID, err)
+		return queries.FailLedgerExport(ctx, db.FailLedgerExportParams{
+			Error: sql.NullString{String: err.Error(), Valid: true},
+			ID:    e.ID,
+		})
+	}
+
+	return queries.CompleteLedgerExport(ctx, db.CompleteLedgerExportParams{
+		ObjectName: sql.NullString{String: ObjectName(e), Valid: true},
+		RowCount:   int32(len(rows)),
+		ID:         e.ID,
+	})
+}
+
+func upload(ctx context.Context, stg *storage.Client, e db.LedgerExport, rows []Row) error {
+	w := stg.Bucket(Bucket()).Object(ObjectName(e)).NewWriter(ctx)
+	w.ContentType = "text/csv"
+	if persist.LedgerExportFormat(e.Format) == persist.LedgerExportFormatJSON {
+		w.ContentType = "application/json"
+	}
+	w.ContentDisposition = fmt.Sprintf(`attachment; filename="ledger-%s.%s"`, e.ID, e.Format)
+
+	if err := Write(w, persist.LedgerExportFormat(e.Format), rows); err != nil {
+		w.Close()
+		return err
+	}
+
+	return w.Close()
+}
+
+// Write writes rows to w in the given format
+func Write(w io.Writer, format persist.LedgerExportFormat, rows []Row) error {
+	switch format {
+	case persist.LedgerExportFormatJSON:
+		return json.NewEncoder(w).Encode(rows)
+	case persist.LedgerExportFormatCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(csvHeader); err != nil {
+			return err
+		}
+		for _, r := range rows {
+			decimals, usdValue := "", ""
+			if r.Decimals != nil {
+				decimals = strconv.Itoa(int(*r.Decimals))
+			}
+			if r.USDValue != nil {
+				usdValue = strconv.FormatFloat(*r.USDValue, 'f', -1, 64)
+			}
+			record := []string{
+				r.Type,
+				r.SplitID.String(),
+				r.Chain,
+				r.TokenAddress.String(),
+				r.CounterpartyAddress.String(),
+				r.Amount,
+				decimals,
+				r.TxHash,
+				strconv.FormatInt(r.BlockNumber, 10),
+				r.BlockTime.UTC().Format(time.RFC3339),
+				usdValue,
+			}
+			if err := cw.Write(record); err != nil {
+				return err
+			}
+		}
+		cw.Flush()
+		return cw.Error()
+	default:
+		return fmt.Errorf("unknown ledger export format: %s", format)
+	}
+}
+
+// loadRows returns every row of an export ordered by block. Inflows carry the USD value they were recorded with,
+// while distributions and withdrawals are valued at the token's current price. Both are left empty for tokens
+// without a price.
+func loadRows(ctx context.Context, queries *db.Queries, ethClient *ethclient.Client, e db.LedgerExport) ([]Row, error) {
+	prices, err := queries.GetTokenPrices(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	type priceKey struct {
+		chain   persist.Chain
+		address persist.Address
+	}
+
+	priceByToken := make(map[priceKey]db.TokenPrice, len(prices))
+	for _, p := range prices {
+		priceByToken[priceKey{p.Chain, p.TokenAddress}] = p
+	}
+
+	blockTimes := newBlockTimes(ethClient)
+	rows := make([]Row, 0)
+
+	addEntries := func(entries []db.SplitLedgerEntry) {
+		for _, l := range entries {
+			row := Row{
+				Type:                string(l.EntryType),
+				SplitID:             l.SplitID,
+				Chain:               l.Chain.Name(),
+				TokenAddress:        l.TokenAddress,
+				CounterpartyAddress: l.RecipientAddress,
+				Amount:              l.Amount.BigInt().String(),
+				TxHash:              l.TxHash,
+				BlockNumber:         l.BlockNumber,
+				BlockTime:           blockTimes.get(ctx, l.Chain, l.BlockNumber, l.CreatedAt),
+			}
+			if p, ok := priceByToken[priceKey{l.Chain, l.TokenAddress}]; ok {
+				row.Decimals = &p.Decimals
+				row.USDValue = usdValue(l.Amount.BigInt(), p)
+			}
+			rows = append(rows, row)
+		}
+	}
+
+	if e.SplitID != "" {
+		params := db.GetSplitInflowsForExportParams{SplitID: e.SplitID, FromTime: e.FromTime, ToTime: e.ToTime}
+
+		inflows, err := queries.GetSplitInflowsForExport(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, i := range inflows {
+			row := Row{
+				Type:                RowTypeInflow,
+				SplitID:             i.SplitID,
+				Chain:               i.Chain.Name(),
+				TokenAddress:        i.TokenAddress,
+				CounterpartyAddress: i.PayerAddress,
+				Amount:              numericString(i.Amount),
+				TxHash:              i.TxHash,
+				BlockNumber:         i.BlockNumber,
+				BlockTime:           blockTimes.get(ctx, i.Chain, i.BlockNumber, i.CreatedAt),
+				USDValue:            numericFloat(i.UsdValue),
+			}
+			if p, ok := priceByToken[priceKey{i.Chain, i.TokenAddress}]; ok {
+				row.Decimals = &p.Decimals
+			}
+			rows = append(rows, row)
+		}
+
+		entries, err := queries.GetSplitLedgerEntriesForExport(ctx, db.GetSplitLedgerEntriesForExportParams(params))
+		if err != nil {
+			return nil, err
+		}
+		addEntries(entries)
+	} else {
+		entries, err := queries.GetRecipientLedgerEntriesForExport(ctx, db.GetRecipientLedgerEntriesForExportParams{
+			RecipientAddress: e.RecipientAddress,
+			FromTime:         e.FromTime,
+			ToTime:           e.ToTime,
+		})
+		if err != nil {
+			return nil, err
+		}
+		addEntries(entries)
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		if rows[i].BlockTime.Equal(rows[j].BlockTime) {
+			return rows[i].BlockNumber < rows[j].BlockNumber
+		}
+		return rows[i].BlockTime.Before(rows[j].BlockTime)
+	})
+
+	return rows, nil
+}
+
+func usdValue(amount *big.Int, price db.TokenPrice) *float64 {
+	usdPrice := numericRat(price.UsdPrice)
+	if usdPrice == nil {
+		return nil
+	}
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(price.Decimals)), nil)
+	v, _ := new(big.Rat).Mul(new(big.Rat).SetFrac(amount, scale), usdPrice).Float64()
+	return &v
+}
+
+func numericRat(n pgtype.Numeric) *big.Rat {
+	if n.Status != pgtype.Present || n.NaN || n.Int == nil {
+		return nil
+	}
+	r := new(big.Rat).SetInt(n.Int)
+	exp := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(n.Exp))), nil)
+	if n.Exp < 0 {
+		return r.Quo(r, new(big.Rat).SetInt(exp))
+	}
+	return r.Mul(r, new(big.Rat).SetInt(exp))
+}
+
+func numericFloat(n pgtype.Numeric) *float64 {
+	r := numericRat(n)
+	if r == nil {
+		return nil
+	}
+	f, _ := r.Float64()
+	return &f
+}
+
+func numericString(n pgtype.Numeric) string {
+	r := numericRat(n)
+	if r == nil {
+		return "0"
+	}
+	return r.FloatString(0)
+}
+
+func abs(i int32) int32 {
+	if i < 0 {
+		return -i
+	}
+	return i
+}
+
+// blockTimes looks up the timestamps of Ethereum blocks, remembering blocks it has already seen. Blocks on other
+// chains, or blocks that can't be fetched, fall back to the time the transfer was recorded.
+type blockTimes struct {
+	ethClient *ethclient.Client
+	seen      map[int64]time.Time
+}
+
+func newBlockTimes(ethClient *ethclient.Client) *blockTimes {
+	return &blockTimes{ethClient: ethClient, seen: make(map[int64]time.Time)}
+}
+
+func (b *blockTimes) get(ctx context.Context, chain persist.Chain, blockNumber int64, recordedAt time.Time) time.Time {
+	if chain != persist.ChainETH || b.ethClient == nil || blockNumber <= 0 {
+		return recordedAt
+	}
+
+	if t, ok := b.seen[blockNumber]; ok {
+		return t
+	}
+
+	header, err := b.ethClient.HeaderByNumber(ctx, big.NewInt(blockNumber))
+	if err != nil {
+		logger.For(ctx).Warnf("failed to get time of block %d: %s", blockNumber, err)
+		return recordedAt
+	}
+
+	t := time.Unix(int64(header.Time), 0).UTC()
+	b.seen[blockNumber] = t
+	return t
+}
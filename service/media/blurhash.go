@@ -0,0 +1,130 @@
+package media
+
+import (
+	"image"
+	"math"
+	"strings"
+)
+
+const base83Chars = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz#$%*+,-.:;=?@[]^_{|}~"
+
+// maxBlurhashComponents is the most components a blurhash can have along either axis
+const maxBlurhashComponents = 4
+
+// Blurhash encodes img as a blurhash (https://blurha.sh), a short string that clients can decode into a blurred
+// placeholder while the image loads. The longer side of the image gets more components.
+func Blurhash(img image.Image) string {
+	bounds := img.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+	if w == 0 || h == 0 {
+		return ""
+	}
+
+	xComponents, yComponents := maxBlurhashComponents, maxBlurhashComponents
+	if w > h {
+		yComponents = clampInt(int(math.Round(float64(maxBlurhashComponents*h)/float64(w))), 1, maxBlurhashComponents)
+	} else if h > w {
+		xComponents = clampInt(int(math.Round(float64(maxBlurhashComponents*w)/float64(h))), 1, maxBlurhashComponents)
+	}
+
+	// Convert every pixel to linear RGB once, rather than once per component
+	linear := make([][3]float64, w*h)
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			r, g, b, _ := img.At(bounds.Min.X+x, bounds.Min.Y+y).RGBA()
+			linear[y*w+x] = [3]float64{srgbToLinear(int(r >> 8)), srgbToLinear(int(g >> 8)), srgbToLinear(int(b >> 8))}
+		}
+	}
+
+	factors := make([][3]float64, 0, xComponents*yComponents)
+	for j := 0; j < yComponents; j++ {
+		for i := 0; i < xComponents; i++ {
+			normalisation := 2.0
+			if i == 0 && j == 0 {
+				normalisation = 1
+			}
+
+			var f [3]float64
+			for y := 0; y < h; y++ {
+				for x := 0; x < w; x++ {
+					basis := normalisation * math.Cos(math.Pi*float64(i*x)/float64(w)) * math.Cos(math.Pi*float64(j*y)/float64(h))
+					p := linear[y*w+x]
+					f[0] += basis * p[0]
+					f[1] += basis * p[1]
+					f[2] += basis * p[2]
+				}
+			}
+
+			scale := 1 / float64(w*h)
+			factors = append(factors, [3]float64{f[0] * scale, f[1] * scale, f[2] * scale})
+		}
+	}
+
+	var hash strings.Builder
+	hash.WriteString(encodeBase83((xComponents-1)+(yComponents-1)*9, 1))
+
+	dc, ac := factors[0], factors[1:]
+
+	maximumValue := 1.0
+	if len(ac) > 0 {
+		var actualMaximum float64
+		for _, f := range ac {
+			actualMaximum = math.Max(actualMaximum, math.Max(math.Abs(f[0]), math.Max(math.Abs(f[1]), math.Abs(f[2]))))
+		}
+		quantisedMaximum := clampInt(int(math.Floor(actualMaximum*166-0.5)), 0, 82)
+		maximumValue = float64(quantisedMaximum+1) / 166
+		hash.WriteString(encodeBase83(quantisedMaximum, 1))
+	} else {
+		hash.WriteString(encodeBase83(0, 1))
+	}
+
+	hash.WriteString(encodeBase83(linearToSRGB(dc[0])<<16+linearToSRGB(dc[1])<<8+linearToSRGB(dc[2]), 4))
+
+	for _, f := range ac {
+		quantise := func(v float64) int {
+			return clampInt(int(math.Floor(signPow(v/maximumValue, 0.5)*9+9.5)), 0, 18)
+		}
+		hash.WriteString(encodeBase83(quantise(f[0])*19*19+quantise(f[1])*19+quantise(f[2]), 2))
+	}
+
+	return hash.String()
+}
+
+func encodeBase83(value, length int) string {
+	b := make([]byte, length)
+	for i := 1; i <= length; i++ {
+		digit := (value / int(math.Pow(83, float64(length-i)))) % 83
+		b[i-1] = base83Chars[digit]
+	}
+	return string(b)
+}
+
+func srgbToLinear(v int) float64 {
+	c := float64(v) / 255
+	if c <= 0.04045 {
+		return c / 12.92
+	}
+	return math.Pow((c+0.055)/1.055, 2.4)
+}
+
+func linearToSRGB(v float64) int {
+	c := math.Max(0, math.Min(1, v))
+	if c <= 0.0031308 {
+		return int(c*12.92*255 + 0.5)
+	}
+	return int((1.055*math.Pow(c, 1/2.4)-0.055)*255 + 0.5)
+}
+
+func signPow(v, exp float64) float64 {
+	return math.Copysign(math.Pow(math.Abs(v), exp), v)
+}
+
+func clampInt(v, min, max int) int {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
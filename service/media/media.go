@@ -0,0 +1,129 @@
+package media
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+
+	"cloud.google.com/go/storage"
+
+	db "github.com/SplitFi/go-splitfi/db/gen/coredb"
+	"github.com/SplitFi/go-splitfi/env"
+	"github.com/SplitFi/go-splitfi/service/logger"
+)
+
+// Bucket returns the storage bucket that split images are uploaded to and served from
+func Bucket() string {
+	return env.GetString("GCLOUD_SPLIT_MEDIA_BUCKET")
+}
+
+// UploadObjectName returns the name of the object a client uploads an image to before it is processed
+func UploadObjectName(m db.SplitMedia) string {
+	return fmt.Sprintf("uploads/%s", m.ID)
+}
+
+// VariantObjectName returns the name of the object a processed size of an image is stored as
+func VariantObjectName(m db.SplitMedia, v Variant) string {
+	return fmt.Sprintf("splits/%s/%s/%s/%s.%s", m.SplitID, m.Kind, m.ID, v.Size.Name, v.Extension)
+}
+
+// PublicURL returns the URL an object in Bucket is served from
+func PublicURL(objectName string) string {
+	return fmt.Sprintf("https://storage.googleapis.com/%s/%s", Bucket(), objectName)
+}
+
+// Process renders an uploaded image, stores its sizes and marks it as complete. If the upload isn't a valid image,
+// it is marked as failed and the returned error wraps ErrInvalidImage. The uploaded original is deleted either way.
+func Process(ctx context.Context, queries *db.Queries, stg *storage.Client, m db.SplitMedia) (db.SplitMedia, error) {
+	bucket := stg.Bucket(Bucket())
+	upload := bucket.Object(UploadObjectName(m))
+
+	rendered, err := render(ctx, upload, m)
+	if err != nil {
+		if !errors.Is(err, ErrInvalidImage) {
+			return db.SplitMedia{}, err
+		}
+
+		if _, failErr := queries.FailSplitMedia(ctx, db.FailSplitMediaParams{
+			Error: sql.NullString{String: err.Error(), Valid: true},
+			ID:    m.ID,
+		}); failErr != nil {
+			return db.SplitMedia{}, failErr
+		}
+		deleteUpload(ctx, upload)
+		return db.SplitMedia{}, err
+	}
+
+	urls := make(map[string]sql.NullString, len(rendered.Variants))
+	for _, v := range rendered.Variants {
+		name := VariantObjectName(m, v)
+
+		w := bucket.Object(name).NewWriter(ctx)
+		w.ContentType = v.ContentType
+		// Every upload is stored under its own ID, so a variant never changes once written
+		w.CacheControl = "public, max-age=31536000, immutable"
+
+		if _, err := w.Write(v.Data); err != nil {
+			w.Close()
+			return db.SplitMedia{}, err
+		}
+		if err := w.Close(); err != nil {
+			return db.SplitMedia{}, err
+		}
+
+		urls[v.Size.Name] = sql.NullString{String: PublicURL(name), Valid: true}
+	}
+
+	m, err = queries.CompleteSplitMedia(ctx, db.CompleteSplitMediaParams{
+		Width:       sql.NullInt32{Int32: int32(rendered.Width), Valid: true},
+		Height:      sql.NullInt32{Int32: int32(rendered.Height), Valid: true},
+		Blurhash:    sql.NullString{String: rendered.Blurhash, Valid: rendered.Blurhash != ""},
+		AspectRatio: sql.NullFloat64{Float64: rendered.AspectRatio, Valid: true},
+		SmallUrl:    urls["small"],
+		MediumUrl:   urls["medium"],
+		LargeUrl:    urls["large"],
+		ID:          m.ID,
+	})
+	if err != nil {
+		return db.SplitMedia{}, err
+	}
+
+	deleteUpload(ctx, upload)
+	return m, nil
+}
+
+// render reads an upload and renders it, checking its size before reading it all
+func render(ctx context.Context, upload *storage.ObjectHandle, m db.SplitMedia) (Rendered, error) {
+	attrs, err := upload.Attrs(ctx)
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		return Rendered{}, fmt.Errorf("%w: nothing was uploaded", ErrInvalidImage)
+	}
+	if err != nil {
+		return Rendered{}, err
+	}
+
+	if attrs.Size > MaxUploadSize || attrs.Size != m.ContentLength {
+		return Rendered{}, fmt.Errorf("%w: expected %d bytes but got %d", ErrInvalidImage, m.ContentLength, attrs.Size)
+	}
+
+	r, err := upload.NewReader(ctx)
+	if err != nil {
+		return Rendered{}, err
+	}
+	defer r.Close()
+
+	data, err := io.ReadAll(io.LimitReader(r, MaxUploadSize+1))
+	if err != nil {
+		return Rendered{}, err
+	}
+
+	return Render(data, m.ContentType)
+}
+
+func deleteUpload(ctx context.Context, upload *storage.ObjectHandle) {
+	if err := upload.Delete(ctx); err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+		logger.For(ctx).Warnf("failed to delete uploaded image %s: %s", upload.ObjectName(), err)
+	}
+}
@@ -0,0 +1,157 @@
+package media
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+
+	"github.com/SplitFi/go-splitfi/service/mediamapper"
+)
+
+// MaxUploadSize is the largest image, in bytes, that can be uploaded
+const MaxUploadSize = 10 << 20
+
+// maxPixels bounds the size of a decoded image so that a small, highly compressed upload can't exhaust memory
+const maxPixels = 50_000_000
+
+const jpegQuality = 85
+
+// blurhashWidth is the width images are scaled down to before computing their blurhash
+const blurhashWidth = 64
+
+// ContentTypes are the image types that can be uploaded
+var ContentTypes = map[string]bool{
+	"image/png":  true,
+	"image/jpeg": true,
+	"image/gif":  true,
+	"image/webp": true,
+}
+
+// ErrInvalidImage is returned when an upload isn't an image that can be processed
+var ErrInvalidImage = errors.New("invalid image")
+
+// Size is one of the sizes MediaMapper serves, which every upload is rendered at
+type Size struct {
+	Name  string
+	Width int
+}
+
+var Sizes = []Size{
+	{Name: "small", Width: mediamapper.SmallWidth},
+	{Name: "medium", Width: mediamapper.MediumWidth},
+	{Name: "large", Width: mediamapper.LargeWidth},
+}
+
+// Variant is an image rendered at one of Sizes
+type Variant struct {
+	Size        Size
+	ContentType string
+	Extension   string
+	Data        []byte
+}
+
+// Rendered is an uploaded image after processing. Its variants are re-encoded from the decoded pixels, so none of the
+// upload's metadata, such as EXIF, carries over.
+type Rendered struct {
+	// Width and Height are of the upload after applying its EXIF orientation
+	Width       int
+	Height      int
+	AspectRatio float64
+	Blurhash    string
+	Variants    []Variant
+}
+
+// Render checks that data is an image of contentType and renders it at every size in Sizes. Images are never
+// scaled up, so variants of a small image may be narrower than their size.
+func Render(data []byte, contentType string) (Rendered, error) {
+	if len(data) > MaxUploadSize {
+		return Rendered{}, fmt.Errorf("%w: larger than %d bytes", ErrInvalidImage, MaxUploadSize)
+	}
+
+	if detected := http.DetectContentType(data); detected != contentType || !ContentTypes[detected] {
+		return Rendered{}, fmt.Errorf("%w: expected %s but got %s", ErrInvalidImage, contentType, detected)
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return Rendered{}, fmt.Errorf("%w: %s", ErrInvalidImage, err)
+	}
+	if config.Width <= 0 || config.Height <= 0 || config.Width*config.Height > maxPixels {
+		return Rendered{}, fmt.Errorf("%w: %dx%d is too large", ErrInvalidImage, config.Width, config.Height)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return Rendered{}, fmt.Errorf("%w: %s", ErrInvalidImage, err)
+	}
+
+	if contentType == "image/jpeg" {
+		img = orient(img, jpegOrientation(data))
+	}
+
+	bounds := img.Bounds()
+	r := Rendered{
+		Width:       bounds.Dx(),
+		Height:      bounds.Dy(),
+		AspectRatio: float64(bounds.Dx()) / float64(bounds.Dy()),
+		Blurhash:    Blurhash(scale(img, blurhashWidth)),
+	}
+
+	// Keep transparency where the upload has it, and use JPEG for everything else
+	encode := func(img image.Image) ([]byte, error) {
+		var buf bytes.Buffer
+		err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality})
+		return buf.Bytes(), err
+	}
+	variantType, extension := "image/jpeg", "jpg"
+	if !isOpaque(img) {
+		encode = func(img image.Image) ([]byte, error) {
+			var buf bytes.Buffer
+			err := png.Encode(&buf, img)
+			return buf.Bytes(), err
+		}
+		variantType, extension = "image/png", "png"
+	}
+
+	for _, size := range Sizes {
+		encoded, err := encode(scale(img, size.Width))
+		if err != nil {
+			return Rendered{}, err
+		}
+		r.Variants = append(r.Variants, Variant{Size: size, ContentType: variantType, Extension: extension, Data: encoded})
+	}
+
+	return r, nil
+}
+
+// scale resizes img to width, keeping its aspect ratio. Images narrower than width are copied at their own size.
+func scale(img image.Image, width int) image.Image {
+	bounds := img.Bounds()
+	if bounds.Dx() < width {
+		width = bounds.Dx()
+	}
+
+	height := bounds.Dy() * width / bounds.Dx()
+	if height < 1 {
+		height = 1
+	}
+
+	dst := image.NewNRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, bounds, draw.Src, nil)
+	return dst
+}
+
+func isOpaque(img image.Image) bool {
+	if o, ok := img.(interface{ Opaque() bool }); ok {
+		return o.Opaque()
+	}
+	return false
+}
@@ -0,0 +1,133 @@
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func solid(w, h int, c color.Color) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, c)
+		}
+	}
+	return img
+}
+
+func encodeJPEG(t *testing.T, img image.Image) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, img, nil))
+	return buf.Bytes()
+}
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+// withExifOrientation inserts an APP1 segment with a big-endian EXIF orientation tag after a JPEG's SOI marker
+func withExifOrientation(data []byte, orientation uint16) []byte {
+	tiff := []byte{'M', 'M', 0, 42, 0, 0, 0, 8, 0, 1}
+	entry := make([]byte, 12)
+	binary.BigEndian.PutUint16(entry[0:], exifOrientationTag)
+	binary.BigEndian.PutUint16(entry[2:], 3)
+	binary.BigEndian.PutUint32(entry[4:], 1)
+	binary.BigEndian.PutUint16(entry[8:], orientation)
+	tiff = append(tiff, entry...)
+	tiff = append(tiff, 0, 0, 0, 0)
+
+	payload := append([]byte("Exif\x00\x00"), tiff...)
+	segment := []byte{0xFF, 0xE1, 0, 0}
+	binary.BigEndian.PutUint16(segment[2:], uint16(len(payload)+2))
+	segment = append(segment, payload...)
+
+	out := append([]byte{}, data[:2]...)
+	out = append(out, segment...)
+	return append(out, data[2:]...)
+}
+
+func TestRender(t *testing.T) {
+	t.Run("renders every size without scaling up", func(t *testing.T) {
+		r, err := Render(encodeJPEG(t, solid(400, 200, color.White)), "image/jpeg")
+		require.NoError(t, err)
+
+		assert.Equal(t, 400, r.Width)
+		assert.Equal(t, 200, r.Height)
+		assert.Equal(t, 2.0, r.AspectRatio)
+		require.Len(t, r.Variants, len(Sizes))
+
+		widths := make([]int, len(r.Variants))
+		for i, v := range r.Variants {
+			img, err := jpeg.Decode(bytes.NewReader(v.Data))
+			require.NoError(t, err)
+			widths[i] = img.Bounds().Dx()
+			assert.Equal(t, "image/jpeg", v.ContentType)
+		}
+		assert.Equal(t, []int{Sizes[0].Width, Sizes[1].Width, 400}, widths)
+	})
+
+	t.Run("strips exif and applies its orientation", func(t *testing.T) {
+		data := withExifOrientation(encodeJPEG(t, solid(40, 20, color.Black)), 6)
+		require.Equal(t, 6, jpegOrientation(data))
+
+		r, err := Render(data, "image/jpeg")
+		require.NoError(t, err)
+
+		assert.Equal(t, 20, r.Width)
+		assert.Equal(t, 40, r.Height)
+		for _, v := range r.Variants {
+			assert.False(t, bytes.Contains(v.Data, []byte("Exif")))
+		}
+	})
+
+	t.Run("keeps transparent images as png", func(t *testing.T) {
+		r, err := Render(encodePNG(t, solid(10, 10, color.NRGBA{R: 255, A: 128})), "image/png")
+		require.NoError(t, err)
+		assert.Equal(t, "image/png", r.Variants[0].ContentType)
+		assert.Equal(t, "png", r.Variants[0].Extension)
+	})
+
+	t.Run("rejects images that aren't the declared type", func(t *testing.T) {
+		_, err := Render(encodePNG(t, solid(10, 10, color.White)), "image/jpeg")
+		assert.ErrorIs(t, err, ErrInvalidImage)
+	})
+
+	t.Run("rejects data that isn't an image", func(t *testing.T) {
+		_, err := Render([]byte("<svg></svg>"), "image/png")
+		assert.ErrorIs(t, err, ErrInvalidImage)
+	})
+}
+
+func TestBlurhash(t *testing.T) {
+	t.Run("encodes a solid square with its color as the average", func(t *testing.T) {
+		hash := Blurhash(solid(16, 16, color.NRGBA{R: 255, A: 255}))
+
+		// 4x4 components, the AC maximum, the DC color, then 15 AC components
+		assert.Len(t, hash, 1+1+4+15*2)
+		assert.Equal(t, "U", hash[:1])
+		assert.Equal(t, encodeBase83(0xFF0000, 4), hash[2:6])
+	})
+
+	t.Run("encodes base 83 digits most significant first", func(t *testing.T) {
+		assert.Equal(t, "00", encodeBase83(0, 2))
+		assert.Equal(t, "~~", encodeBase83(83*83-1, 2))
+		assert.Equal(t, "10", encodeBase83(83, 2))
+	})
+
+	t.Run("uses fewer components along the shorter side", func(t *testing.T) {
+		hash := Blurhash(solid(40, 10, color.White))
+		// 4x1 components
+		assert.Equal(t, string(base83Chars[3]), hash[:1])
+		assert.Len(t, hash, 1+1+4+3*2)
+	})
+}
@@ -23,11 +23,12 @@ const contextKey = "mediamapper.instance"
 
 const assetDomain = "assets.splitfi.com"
 
+// The widths of the image sizes that MediaMapper serves
 const (
-	thumbnailWidth = 64
-	smallWidth     = 204
-	mediumWidth    = 340
-	largeWidth     = 1024
+	ThumbnailWidth = 64
+	SmallWidth     = 204
+	MediumWidth    = 340
+	LargeWidth     = 1024
 )
 
 type MediaMapper struct {
@@ -69,11 +70,11 @@ func NewMediaMapper() *MediaMapper {
 
 	urlBuilder := imgix.NewURLBuilder(assetDomain, imgix.WithToken(token), imgix.WithLibParam(false))
 
-	thumbnailUrlParams := buildParams(getDefaultParams(), newWidthParam(thumbnailWidth))
-	smallUrlParams := buildParams(getDefaultParams(), newWidthParam(smallWidth))
-	mediumUrlParams := buildParams(getDefaultParams(), newWidthParam(mediumWidth))
-	largeUrlParams := buildParams(getDefaultParams(), newWidthParam(largeWidth))
-	srcSetParams := buildParams(getDefaultParams(), newWidthParam(largeWidth))
+	thumbnailUrlParams := buildParams(getDefaultParams(), newWidthParam(ThumbnailWidth))
+	smallUrlParams := buildParams(getDefaultParams(), newWidthParam(SmallWidth))
+	mediumUrlParams := buildParams(getDefaultParams(), newWidthParam(MediumWidth))
+	largeUrlParams := buildParams(getDefaultParams(), newWidthParam(LargeWidth))
+	srcSetParams := buildParams(getDefaultParams(), newWidthParam(LargeWidth))
 
 	return &MediaMapper{
 		urlBuilder:         urlBuilder,
@@ -161,19 +162,19 @@ func WithQuality(q int) Option {
 }
 
 func (u *MediaMapper) GetThumbnailImageUrl(sourceUrl string, options ...Option) string {
-	return u.buildPreviewImageUrl(sourceUrl, thumbnailWidth, u.thumbnailUrlParams, options...)
+	return u.buildPreviewImageUrl(sourceUrl, ThumbnailWidth, u.thumbnailUrlParams, options...)
 }
 
 func (u *MediaMapper) GetSmallImageUrl(sourceUrl string, options ...Option) string {
-	return u.buildPreviewImageUrl(sourceUrl, smallWidth, u.smallUrlParams, options...)
+	return u.buildPreviewImageUrl(sourceUrl, SmallWidth, u.smallUrlParams, options...)
 }
 
 func (u *MediaMapper) GetMediumImageUrl(sourceUrl string, options ...Option) string {
-	return u.buildPreviewImageUrl(sourceUrl, mediumWidth, u.mediumUrlParams, options...)
+	return u.buildPreviewImageUrl(sourceUrl, MediumWidth, u.mediumUrlParams, options...)
 }
 
 func (u *MediaMapper) GetLargeImageUrl(sourceUrl string, options ...Option) string {
-	return u.buildPreviewImageUrl(sourceUrl, largeWidth, u.largeUrlParams, options...)
+	return u.buildPreviewImageUrl(sourceUrl, LargeWidth, u.largeUrlParams, options...)
 }
 
 func (u *MediaMapper) GetSrcSet(sourceUrl string, options ...Option) string {
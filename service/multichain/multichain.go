@@ -169,6 +169,7 @@ func chainMetadatasToUpsertableMetadatas(chain persist.Chain, metadatas []common
 			ContractAddress: normalizedAddress,
 			Logo:            util.ToNullStringEmptyNull(m.LogoURL),
 			Thumbnail:       util.ToNullStringEmptyNull(m.ThumbnailURL),
+			IsSpam:          m.IsSpam != nil && *m.IsSpam,
 		})
 	}
 
@@ -181,5 +182,6 @@ func mergeTokenMetadatas(a db.TokenMetadata, b db.TokenMetadata) db.TokenMetadat
 	a.Logo = util.ToNullString(util.FirstNonEmptyString(a.Logo.String, b.Logo.String), true)
 	a.Thumbnail = util.ToNullString(util.FirstNonEmptyString(a.Thumbnail.String, b.Thumbnail.String), true)
 	a.ContractAddress = persist.Address(util.FirstNonEmptyString(a.ContractAddress.String(), b.ContractAddress.String()))
+	a.IsSpam = a.IsSpam || b.IsSpam
 	return a
 }
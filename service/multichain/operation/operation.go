@@ -36,6 +36,7 @@ func InsertTokenMetadatas(ctx context.Context, q *db.Queries, tokens []db.TokenM
 		p.Thumbnail = append(p.Thumbnail, t.Thumbnail.String)
 		p.Logo = append(p.Logo, t.Logo.String)
 		p.ContractAddress = append(p.ContractAddress, t.ContractAddress)
+		p.IsSpam = append(p.IsSpam, t.IsSpam)
 
 		if len(errors) > 0 {
 			return nil, nil, errors[0]
@@ -46,6 +46,7 @@ type NotificationHandlers struct {
 type pushLimiter struct {
 	tokens *limiters.KeyRateLimiter
 	users  *limiters.KeyRateLimiter
+	splits *limiters.KeyRateLimiter
 }
 
 func newPushLimiter() *pushLimiter {
@@ -54,6 +55,7 @@ func newPushLimiter() *pushLimiter {
 	return &pushLimiter{
 		tokens: limiters.NewKeyRateLimiter(ctx, cache, "tokens", 1, time.Minute*10),
 		users:  limiters.NewKeyRateLimiter(ctx, cache, "users", 5, time.Minute),
+		splits: limiters.NewKeyRateLimiter(ctx, cache, "splits", 1, time.Hour),
 	}
 }
 
@@ -81,6 +83,18 @@ func (p *pushLimiter) tryUsers(ctx context.Context, sendingUserID persist.DBID)
 	}
 }
 
+func (p *pushLimiter) trySplits(ctx context.Context, receivingUserID persist.DBID, splitID persist.DBID) error {
+	key := fmt.Sprintf("%s:%s", receivingUserID, splitID)
+	if p.isActionAllowed(ctx, p.splits, key) {
+		return nil
+	}
+
+	return errRateLimited{
+		limiterName: p.splits.Name(),
+		receiverID:  receivingUserID,
+	}
+}
+
 func (p *pushLimiter) isActionAllowed(ctx context.Context, limiter *limiters.KeyRateLimiter, key string) bool {
 	canContinue, _, err := limiter.ForKey(ctx, key)
 	if err != nil {
@@ -96,9 +110,10 @@ func New(queries *db.Queries, pub *pubsub.Client, taskClient *task.Client, lock
 	notifDispatcher := notificationDispatcher{handlers: map[persist.Action]notificationHandler{}, lock: lock}
 	limiter := newPushLimiter()
 
-	//singleHandler := singleNotificationHandler{queries: queries, pubSub: pub, taskClient: taskClient, limiter: limiter}
+	singleHandler := singleNotificationHandler{queries: queries, pubSub: pub, taskClient: taskClient, limiter: limiter}
 	//ownerGroupedHandler := ownerGroupedNotificationHandler{queries: queries, pubSub: pub, taskClient: taskClient, limiter: limiter}
 	//tokenGroupedHandler := tokenIDGroupedNotificationHandler{queries: queries, pubSub: pub, taskClient: taskClient, limiter: limiter}
+	splitGroupedHandler := splitGroupedNotificationHandler{queries: queries, pubSub: pub, taskClient: taskClient, limiter: limiter}
 	viewHandler := viewedNotificationHandler{queries: queries, pubSub: pub, taskClient: taskClient, limiter: limiter}
 	//topActivityHandler := topActivityHandler{queries: queries, pubSub: pub, taskClient: taskClient, limiter: limiter}
 	announcementHandler := announcementNotificationHandler{queries: queries, pubSub: pub, taskClient: taskClient, limiter: limiter}
@@ -116,6 +131,8 @@ func New(queries *db.Queries, pub *pubsub.Client, taskClient *task.Client, lock
 	//notifDispatcher.AddHandler(persist.ActionMentionUser, singleHandler)
 	//notifDispatcher.AddHandler(persist.ActionMentionCommunity, singleHandler)
 	//notifDispatcher.AddHandler(persist.ActionUserPostedYourWork, singleHandler)
+	notifDispatcher.AddHandler(persist.ActionSplitDeletionRequested, singleHandler)
+	notifDispatcher.AddHandler(persist.ActionSplitDeleted, singleHandler)
 
 	// notification specifically for ensuring that top users don't get re-notified and users recently notified arent notified again
 	//notifDispatcher.AddHandler(persist.ActionTopActivityBadgeReceived, topActivityHandler)
@@ -124,6 +141,9 @@ func New(queries *db.Queries, pub *pubsub.Client, taskClient *task.Client, lock
 	// notifDispatcher.AddHandler(persist.ActionNewTokensReceived, tokenGroupedHandler)
 	// notifDispatcher.AddHandler(persist.ActionAdmiredToken, tokenGroupedHandler)
 
+	// notification actions that are grouped by split for the day
+	notifDispatcher.AddHandler(persist.ActionSplitReceivedFunds, splitGroupedHandler)
+
 	// viewed notifications are handled separately
 	notifDispatcher.AddHandler(persist.ActionViewedSplit, viewHandler)
 
@@ -256,6 +276,30 @@ func (h ownerGroupedNotificationHandler) Handle(ctx context.Context, notif db.No
 	return insertAndPublishNotif(ctx, notif, h.queries, h.pubSub, h.taskClient, h.limiter)
 }
 
+type splitGroupedNotificationHandler struct {
+	queries    *db.Queries
+	pubSub     *pubsub.Client
+	taskClient *task.Client
+	limiter    *pushLimiter
+}
+
+// Handle groups notifications for the same split into a single notification per day, so that a busy split
+// doesn't flood its watchers
+func (h splitGroupedNotificationHandler) Handle(ctx context.Context, notif db.Notification) error {
+	curNotif, _ := h.queries.GetMostRecentNotificationByOwnerIDSplitIDForAction(ctx, db.GetMostRecentNotificationByOwnerIDSplitIDForActionParams{
+		OwnerID: notif.OwnerID,
+		SplitID: notif.SplitID,
+		Action:  notif.Action,
+	})
+
+	if !curNotif.CreatedAt.Before(beginningOfDay(time.Now())) {
+		logger.For(ctx).Infof("grouping notification %s: %s-%s-%s", curNotif.ID, notif.Action, notif.OwnerID, notif.SplitID)
+		return updateAndPublishNotif(ctx, notif, curNotif, h.queries, h.pubSub, h.taskClient, h.limiter)
+	}
+	logger.For(ctx).Infof("not grouping notification: %s-%s-%s", notif.Action, notif.OwnerID, notif.SplitID)
+	return insertAndPublishNotif(ctx, notif, h.queries, h.pubSub, h.taskClient, h.limiter)
+}
+
 type viewedNotificationHandler struct {
 	queries    *db.Queries
 	pubSub     *pubsub.Client
@@ -278,6 +322,19 @@ func beginningOfWeek(t time.Time) time.Time {
 	return time.Date(y, m, newD, 0, 0, 0, 0, pst)
 }
 
+// will return the beginning of the day in PST
+func beginningOfDay(t time.Time) time.Time {
+
+	pst, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		panic(err)
+	}
+
+	y, m, d := t.In(pst).Date()
+
+	return time.Date(y, m, d, 0, 0, 0, 0, pst)
+}
+
 // Handle will still group notifications in the usual window, but it will also ensure that each viewer does
 // does not show up mutliple times in a week
 func (h viewedNotificationHandler) Handle(ctx context.Context, notif db.Notification) error {
@@ -486,6 +543,14 @@ func createPushMessage(ctx context.Context, notif db.Notification, queries *db.Q
 		return message, nil
 	}
 
+	if notif.Action == persist.ActionSplitReceivedFunds {
+		if err := limiter.trySplits(ctx, notif.OwnerID, notif.SplitID); err != nil {
+			return task.PushNotificationMessage{}, err
+		}
+
+		return message, nil
+	}
+
 	return task.PushNotificationMessage{}, fmt.Errorf("unsupported notification action: %s", notif.Action)
 }
 
@@ -559,6 +624,28 @@ func NotificationToUserFacingData(ctx context.Context, queries *db.Queries, n db
 
 			return data, nil
 		*/
+	case persist.ActionSplitDeletionRequested:
+		return UserFacingNotificationData{
+			Actor:          "A recipient",
+			Action:         "requested to delete",
+			CollectionName: n.Data.SplitName,
+		}, nil
+	case persist.ActionSplitDeleted:
+		return UserFacingNotificationData{
+			Actor:  n.Data.SplitName,
+			Action: "was deleted",
+		}, nil
+	case persist.ActionSplitReceivedFunds:
+		if n.Amount > 1 {
+			return UserFacingNotificationData{
+				Actor:  n.Data.SplitName,
+				Action: fmt.Sprintf("received %d transfers", n.Amount),
+			}, nil
+		}
+		return UserFacingNotificationData{
+			Actor:  n.Data.SplitName,
+			Action: "received a transfer",
+		}, nil
 	case persist.ActionTopActivityBadgeReceived:
 		return UserFacingNotificationData{
 			Actor:  "You",
@@ -584,6 +671,10 @@ func actionSupportsPushNotifications(action persist.Action) bool {
 		return false // TODO -activity
 	case persist.ActionAnnouncement:
 		return true
+	case persist.ActionSplitDeletionRequested, persist.ActionSplitDeleted:
+		return true
+	case persist.ActionSplitReceivedFunds:
+		return true
 	default:
 		return false
 	}
@@ -755,6 +846,25 @@ func addNotification(ctx context.Context, notif db.Notification, queries *db.Que
 			EventIds: notif.EventIds,
 			SplitID:  notif.SplitID,
 		})
+	case persist.ActionSplitDeletionRequested, persist.ActionSplitDeleted:
+		return queries.CreateSplitNotification(ctx, db.CreateSplitNotificationParams{
+			ID:       id,
+			OwnerID:  notif.OwnerID,
+			Action:   notif.Action,
+			Data:     notif.Data,
+			EventIds: notif.EventIds,
+			SplitID:  notif.SplitID,
+		})
+	case persist.ActionSplitReceivedFunds:
+		return queries.CreateSplitAmountNotification(ctx, db.CreateSplitAmountNotificationParams{
+			ID:       id,
+			OwnerID:  notif.OwnerID,
+			Action:   notif.Action,
+			Data:     notif.Data,
+			EventIds: notif.EventIds,
+			SplitID:  notif.SplitID,
+			Amount:   notif.Amount,
+		})
 		/*	case persist.ActionNewTokensReceived:
 			amount := notif.Data.NewTokenQuantity.BigInt().Int64()
 			return queries.CreateTokenNotification(ctx, db.CreateTokenNotificationParams{
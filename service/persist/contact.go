@@ -0,0 +1,12 @@
+package persist
+
+import "fmt"
+
+// ErrContactNotFound is returned when a contact is not found in the viewer's address book
+type ErrContactNotFound struct {
+	ID DBID
+}
+
+func (e ErrContactNotFound) Error() string {
+	return fmt.Sprintf("contact not found with ID: %s", e.ID)
+}
@@ -23,6 +23,12 @@ const (
 	ActionViewedToken              Action = "ViewedToken"
 	ActionSplitUpdated             Action = "SplitUpdated"
 	ActionSplitInfoUpdated         Action = "SplitInfoUpdated"
+	ActionSplitDeletionRequested   Action = "SplitDeletionRequested"
+	ActionSplitDeleted             Action = "SplitDeleted"
+	ActionSplitReceivedFunds       Action = "SplitReceivedFunds"
+	ActionSplitDistributed         Action = "SplitDistributed"
+	ActionSplitRecipientsUpdated   Action = "SplitRecipientsUpdated"
+	ActionSplitControllersUpdated  Action = "SplitControllersUpdated"
 	ActionNewTokensReceived        Action = "NewTokensReceived"
 	ActionTopActivityBadgeReceived Action = "ActivityBadgeReceived"
 	ActionAnnouncement             Action = "Announcement"
@@ -39,6 +45,8 @@ type EventData struct {
 	SplitName              *string              `json:"split_name"`
 	SplitDescription       *string              `json:"split_description"`
 	SplitNewTokenIDs       map[DBID]DBIDList    `json:"split_new_token_ids"`
+	SplitInflowCount       int                  `json:"split_inflow_count"`
+	SplitTxHash            string               `json:"split_tx_hash"`
 	ActivityBadgeThreshold int                  `json:"activity_badge_threshold"`
 	NewTopActiveUser       bool                 `json:"new_top_active_user"`
 	AnnouncementDetails    *AnnouncementDetails `json:"announcement_details"`
@@ -0,0 +1,24 @@
+package persist
+
+import "fmt"
+
+// RecipientInviteStatus is where an email invite to claim a recipient's share is at
+type RecipientInviteStatus string
+
+const (
+	// RecipientInviteStatusPending is an invite that has been sent but not yet accepted
+	RecipientInviteStatusPending RecipientInviteStatus = "pending"
+	// RecipientInviteStatusAccepted is an invite whose recipient has been claimed by the invitee
+	RecipientInviteStatusAccepted RecipientInviteStatus = "accepted"
+	// RecipientInviteStatusRevoked is an invite that was withdrawn before it was accepted
+	RecipientInviteStatusRevoked RecipientInviteStatus = "revoked"
+)
+
+// ErrRecipientInviteNotFound is returned when an invite does not exist or can no longer be acted on
+type ErrRecipientInviteNotFound struct {
+	ID DBID
+}
+
+func (e ErrRecipientInviteNotFound) Error() string {
+	return fmt.Sprintf("recipient invite not found: %s", e.ID)
+}
@@ -0,0 +1,42 @@
+package persist
+
+import "fmt"
+
+// LedgerEntryType is the kind of movement of funds out of a split recorded in the ledger
+type LedgerEntryType string
+
+const (
+	// LedgerEntryTypeDistribution is a recipient's share of funds that left a split for anyone other than its recipients
+	LedgerEntryTypeDistribution LedgerEntryType = "distribution"
+	// LedgerEntryTypeWithdrawal is a transfer from a split directly to one of its recipients
+	LedgerEntryTypeWithdrawal LedgerEntryType = "withdrawal"
+)
+
+// LedgerExportFormat is the file format of a ledger export
+type LedgerExportFormat string
+
+const (
+	LedgerExportFormatCSV  LedgerExportFormat = "csv"
+	LedgerExportFormatJSON LedgerExportFormat = "json"
+)
+
+// LedgerExportStatus is how far along a ledger export is
+type LedgerExportStatus string
+
+const (
+	// LedgerExportStatusPending is an export that is waiting to be generated
+	LedgerExportStatusPending LedgerExportStatus = "pending"
+	// LedgerExportStatusComplete is an export that has been uploaded and can be downloaded
+	LedgerExportStatusComplete LedgerExportStatus = "complete"
+	// LedgerExportStatusFailed is an export that could not be generated
+	LedgerExportStatusFailed LedgerExportStatus = "failed"
+)
+
+// ErrLedgerExportNotFound is returned when a ledger export does not exist or belongs to another user
+type ErrLedgerExportNotFound struct {
+	ID DBID
+}
+
+func (e ErrLedgerExportNotFound) Error() string {
+	return fmt.Sprintf("ledger export not found: %s", e.ID)
+}
@@ -81,6 +81,7 @@ type NotificationData struct {
 	ActivityBadgeThreshold int                  `json:"activity_badge_threshold,omitempty"`
 	NewTopActiveUser       bool                 `json:"new_top_active_user,omitempty"`
 	AnnouncementDetails    *AnnouncementDetails `json:"announcement_details,omitempty"`
+	SplitName              string               `json:"split_name,omitempty"`
 }
 
 func (n NotificationData) Validate() NotificationData {
@@ -92,6 +93,7 @@ func (n NotificationData) Validate() NotificationData {
 	result.ActivityBadgeThreshold = n.ActivityBadgeThreshold
 	result.NewTopActiveUser = n.NewTopActiveUser
 	result.AnnouncementDetails = n.AnnouncementDetails
+	result.SplitName = n.SplitName
 
 	return result
 }
@@ -111,6 +113,7 @@ func (n NotificationData) Concat(other NotificationData) NotificationData {
 	} else {
 		result.AnnouncementDetails = n.AnnouncementDetails
 	}
+	result.SplitName = util.FirstNonEmptyString(other.SplitName, n.SplitName)
 
 	return result.Validate()
 }
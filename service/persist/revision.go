@@ -0,0 +1,76 @@
+package persist
+
+import "fmt"
+
+// RevisionSource is where a change recorded in a split revision originated
+type RevisionSource string
+
+const (
+	// RevisionSourceUI is a change made by a user through the app
+	RevisionSourceUI RevisionSource = "ui"
+	// RevisionSourceWebhook is a change observed on-chain and delivered by a webhook
+	RevisionSourceWebhook RevisionSource = "webhook"
+	// RevisionSourceAdmin is a change made by an admin
+	RevisionSourceAdmin RevisionSource = "admin"
+)
+
+// RevisionRecipient is a recipient's ownership as it was at the time of a revision
+type RevisionRecipient struct {
+	Address   Address `json:"address"`
+	Ownership int32   `json:"ownership"`
+}
+
+// RevisionRecipients is the full set of a split's recipients at the time of a revision
+type RevisionRecipients []RevisionRecipient
+
+// OwnershipByAddress returns the recipients' ownership keyed by address
+func (r RevisionRecipients) OwnershipByAddress() map[Address]int32 {
+	ownership := make(map[Address]int32, len(r))
+	for _, recipient := range r {
+		ownership[recipient.Address] += recipient.Ownership
+	}
+	return ownership
+}
+
+// RevisionFieldChange is a field of a split that differs between two revisions
+type RevisionFieldChange struct {
+	Field string
+	From  string
+	To    string
+}
+
+// RecipientOwnershipChange is a recipient whose ownership differs between two revisions.
+// A nil ownership means the recipient is missing from that revision.
+type RecipientOwnershipChange struct {
+	Address Address
+	From    *int32
+	To      *int32
+}
+
+// SplitRevisionDiff describes everything that changed between two revisions of a split
+type SplitRevisionDiff struct {
+	FromRevision int32
+	ToRevision   int32
+	Fields       []RevisionFieldChange
+	Recipients   []RecipientOwnershipChange
+}
+
+// DiffRevisionRecipients compares two sets of recipients and returns every recipient whose
+// ownership differs, ordered by address
+func DiffRevisionRecipients(from, to RevisionRecipients) []RecipientOwnershipChange {
+	changes := make([]RecipientOwnershipChange, 0)
+	for _, d := range DiffRecipientOwnership(from.OwnershipByAddress(), to.OwnershipByAddress()) {
+		changes = append(changes, RecipientOwnershipChange{Address: d.Address, From: d.Ownership, To: d.OnchainOwnership})
+	}
+	return changes
+}
+
+// ErrSplitRevisionNotFound is returned when a revision of a split does not exist
+type ErrSplitRevisionNotFound struct {
+	SplitID  DBID
+	Revision int32
+}
+
+func (e ErrSplitRevisionNotFound) Error() string {
+	return fmt.Sprintf("revision %d not found for split: %s", e.Revision, e.SplitID)
+}
@@ -4,10 +4,16 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"sort"
 	"time"
 )
 
-type Ownership = float64
+// Ownership is a share of a split in parts per million, the same precision that split contracts use.
+// A split's recipients' ownership must add up to exactly its total ownership.
+type Ownership int32
+
+// OwnershipScale is the ownership of an entire split, i.e. 100%
+const OwnershipScale Ownership = 1_000_000
 
 type Recipient struct {
 	Version      NullInt32 `json:"version"` // schema version for this model
@@ -80,6 +86,60 @@ type SplitTokenUpdateInput struct {
 	Assets []DBID `json:"assets"`
 }
 
+// RecipientDrift describes a recipient whose ownership in the database differs from the
+// allocation enforced by the split's contract. A nil ownership means the recipient is
+// missing on that side.
+type RecipientDrift struct {
+	Address          Address
+	Ownership        *int32
+	OnchainOwnership *int32
+}
+
+// SplitOnchainStatus is the result of comparing a split's recipients in the database
+// against the configuration of its deployed contract
+type SplitOnchainStatus struct {
+	CheckedAt             time.Time
+	TotalOwnership        int32
+	OnchainTotalOwnership int32
+	Drift                 []RecipientDrift
+}
+
+// InSync returns true if the database matches the deployed contract
+func (s SplitOnchainStatus) InSync() bool {
+	return len(s.Drift) == 0 && s.TotalOwnership == s.OnchainTotalOwnership
+}
+
+// DiffRecipientOwnership compares ownership keyed by recipient address and returns every
+// recipient that differs, ordered by address
+func DiffRecipientOwnership(ownership, onchainOwnership map[Address]int32) []RecipientDrift {
+	drift := make([]RecipientDrift, 0)
+
+	for address, o := range ownership {
+		o := o
+		onchain, ok := onchainOwnership[address]
+		if !ok {
+			drift = append(drift, RecipientDrift{Address: address, Ownership: &o})
+			continue
+		}
+		if onchain != o {
+			drift = append(drift, RecipientDrift{Address: address, Ownership: &o, OnchainOwnership: &onchain})
+		}
+	}
+
+	for address, onchain := range onchainOwnership {
+		onchain := onchain
+		if _, ok := ownership[address]; !ok {
+			drift = append(drift, RecipientDrift{Address: address, OnchainOwnership: &onchain})
+		}
+	}
+
+	sort.Slice(drift, func(i, j int) bool {
+		return drift[i].Address < drift[j].Address
+	})
+
+	return drift
+}
+
 // ErrSplitNotFound is returned when a split is not found by its ID
 type ErrSplitNotFound struct {
 	ID      DBID
@@ -99,3 +159,32 @@ type ErrSplitNotFoundByAddress struct {
 func (e ErrSplitNotFoundByAddress) Error() string {
 	return fmt.Sprintf("split not found with address: %v-%v", e.Address, e.Chain)
 }
+
+// ErrSplitDraftNotFound is returned when there is no unexpired edit session for a split
+type ErrSplitDraftNotFound struct {
+	SplitID DBID
+	EditID  string
+}
+
+func (e ErrSplitDraftNotFound) Error() string {
+	return fmt.Sprintf("no draft found for split: %s edit ID: %s", e.SplitID, e.EditID)
+}
+
+// ErrSplitTemplateNotFound is returned when a split template is not found by its ID
+type ErrSplitTemplateNotFound struct {
+	ID DBID
+}
+
+func (e ErrSplitTemplateNotFound) Error() string {
+	return fmt.Sprintf("split template not found with ID: %s", e.ID)
+}
+
+// ErrSplitCycle is returned when a split's recipients lead back to the split itself, so funds would
+// circulate forever. SplitIDs lists the splits in the cycle, starting and ending with the same split.
+type ErrSplitCycle struct {
+	SplitIDs []DBID
+}
+
+func (e ErrSplitCycle) Error() string {
+	return fmt.Sprintf("split recipients form a cycle: %v", e.SplitIDs)
+}
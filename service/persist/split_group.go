@@ -0,0 +1,13 @@
+package persist
+
+import "fmt"
+
+// ErrSplitGroupNotFound is returned when a split group does not exist
+type ErrSplitGroupNotFound struct {
+	ID      DBID
+	SplitID DBID
+}
+
+func (e ErrSplitGroupNotFound) Error() string {
+	return fmt.Sprintf("split group not found with ID: %v SplitID: %v", e.ID, e.SplitID)
+}
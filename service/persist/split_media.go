@@ -0,0 +1,33 @@
+package persist
+
+import "fmt"
+
+// SplitMediaKind is which of a split's images an upload replaces
+type SplitMediaKind string
+
+const (
+	SplitMediaKindLogo   SplitMediaKind = "logo"
+	SplitMediaKindBanner SplitMediaKind = "banner"
+	SplitMediaKindBadge  SplitMediaKind = "badge"
+)
+
+// SplitMediaStatus is how far an uploaded image has been processed
+type SplitMediaStatus string
+
+const (
+	// SplitMediaStatusPending is an upload that hasn't been processed yet, which may still be waiting on the client
+	SplitMediaStatusPending SplitMediaStatus = "pending"
+	// SplitMediaStatusComplete is an upload whose sizes have been generated and is now the split's image
+	SplitMediaStatusComplete SplitMediaStatus = "complete"
+	// SplitMediaStatusFailed is an upload that couldn't be processed
+	SplitMediaStatusFailed SplitMediaStatus = "failed"
+)
+
+// ErrSplitMediaNotFound is returned when an image upload does not exist
+type ErrSplitMediaNotFound struct {
+	ID DBID
+}
+
+func (e ErrSplitMediaNotFound) Error() string {
+	return fmt.Sprintf("split media not found with ID: %s", e.ID)
+}
@@ -0,0 +1,43 @@
+package persist
+
+import "fmt"
+
+// SplitRole is a user's level of access to a split. Each role can do everything the roles below it can.
+type SplitRole string
+
+const (
+	// SplitRoleViewer can see a split's private analytics. Recipients are viewers of their splits.
+	SplitRoleViewer SplitRole = "viewer"
+	// SplitRoleEditor can also edit a split's metadata, but not its shares
+	SplitRoleEditor SplitRole = "editor"
+	// SplitRoleController can also manage a split's recipients and members. Whoever controls a split
+	// on-chain is its controller.
+	SplitRoleController SplitRole = "controller"
+)
+
+var splitRoleRanks = map[SplitRole]int{
+	SplitRoleViewer:     1,
+	SplitRoleEditor:     2,
+	SplitRoleController: 3,
+}
+
+// IsValid reports whether r is a known role
+func (r SplitRole) IsValid() bool {
+	_, ok := splitRoleRanks[r]
+	return ok
+}
+
+// AtLeast reports whether r grants at least the access of min. The empty role grants no access.
+func (r SplitRole) AtLeast(min SplitRole) bool {
+	return r.IsValid() && splitRoleRanks[r] >= splitRoleRanks[min]
+}
+
+// ErrSplitRoleRequired is returned when a user doesn't have a high enough role on a split
+type ErrSplitRoleRequired struct {
+	SplitID DBID
+	Role    SplitRole
+}
+
+func (e ErrSplitRoleRequired) Error() string {
+	return fmt.Sprintf("%s role required for split: %s", e.Role, e.SplitID)
+}
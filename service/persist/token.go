@@ -599,18 +599,26 @@ func (c *Chain) UnmarshalGQL(v interface{}) error {
 
 // MarshalGQL implements the graphql.Marshaler interface
 func (c Chain) MarshalGQL(w io.Writer) {
+	if name := c.Name(); name != "" {
+		w.Write([]byte(strconv.Quote(name)))
+	}
+}
+
+// Name returns the chain's name as it appears in the GraphQL schema, or an empty string for an unknown chain
+func (c Chain) Name() string {
 	switch c {
 	case ChainETH:
-		w.Write([]byte(`"Ethereum"`))
+		return "Ethereum"
 	case ChainArbitrum:
-		w.Write([]byte(`"Arbitrum"`))
+		return "Arbitrum"
 	case ChainPolygon:
-		w.Write([]byte(`"Polygon"`))
+		return "Polygon"
 	case ChainOptimism:
-		w.Write([]byte(`"Optimism"`))
+		return "Optimism"
 	case ChainBase:
-		w.Write([]byte(`"Base"`))
+		return "Base"
 	}
+	return ""
 }
 
 func (c Chain) L1Chain() L1Chain {
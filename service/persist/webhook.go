@@ -0,0 +1,44 @@
+package persist
+
+import "fmt"
+
+// WebhookEventType is the kind of event a webhook is sent
+type WebhookEventType string
+
+const (
+	WebhookEventTypeInflow             WebhookEventType = "split.inflow"
+	WebhookEventTypeDistribution       WebhookEventType = "split.distribution"
+	WebhookEventTypeRecipientsChanged  WebhookEventType = "split.recipients_changed"
+	WebhookEventTypeControllersChanged WebhookEventType = "split.controllers_changed"
+	// WebhookEventTypePing is only sent when a user tests a webhook, and can't be subscribed to
+	WebhookEventTypePing WebhookEventType = "ping"
+)
+
+// WebhookEventTypes are the event types that webhooks can subscribe to, keyed by the action that causes them
+var WebhookEventTypes = map[Action]WebhookEventType{
+	ActionSplitReceivedFunds:      WebhookEventTypeInflow,
+	ActionSplitDistributed:        WebhookEventTypeDistribution,
+	ActionSplitRecipientsUpdated:  WebhookEventTypeRecipientsChanged,
+	ActionSplitControllersUpdated: WebhookEventTypeControllersChanged,
+}
+
+// WebhookDeliveryStatus is how far along sending an event to a webhook is
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryStatusPending is a delivery that hasn't been attempted yet, or that failed and will be retried
+	WebhookDeliveryStatusPending WebhookDeliveryStatus = "pending"
+	// WebhookDeliveryStatusSucceeded is a delivery that the webhook responded to with a 2xx status
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "succeeded"
+	// WebhookDeliveryStatusFailed is a delivery that won't be retried
+	WebhookDeliveryStatusFailed WebhookDeliveryStatus = "failed"
+)
+
+// ErrWebhookNotFound is returned when a webhook does not exist or belongs to another user
+type ErrWebhookNotFound struct {
+	ID DBID
+}
+
+func (e ErrWebhookNotFound) Error() string {
+	return fmt.Sprintf("webhook not found: %s", e.ID)
+}
@@ -110,6 +110,18 @@ type TokenContractMetadata struct {
 	Symbol string
 }
 
+// SplitContractRecipient is a recipient and its allocation as enforced by a deployed split contract
+type SplitContractRecipient struct {
+	Address    persist.Address
+	Allocation uint32
+}
+
+// SplitContractConfig represents the recipient configuration of a deployed split contract
+type SplitContractConfig struct {
+	Recipients      []SplitContractRecipient
+	TotalAllocation uint32
+}
+
 // NewEthClient returns an ethclient.Client
 func NewEthClient() *ethclient.Client {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -324,6 +336,80 @@ func GetTokenContractMetadata(ctx context.Context, address common.Address, ethCl
 	return &TokenContractMetadata{Name: name, Symbol: symbol}, nil
 }
 
+// GetSplitContractConfig returns the recipients and allocations of a deployed split contract
+func GetSplitContractConfig(ctx context.Context, address common.Address, ethClient *ethclient.Client) (*SplitContractConfig, error) {
+	instance, err := contracts.NewISplitCaller(address, ethClient)
+	if err != nil {
+		return nil, err
+	}
+
+	recipients, err := instance.GetRecipients(&bind.CallOpts{
+		Context: ctx,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(recipients.Accounts) != len(recipients.Allocations) {
+		return nil, fmt.Errorf("split contract %s returned %d accounts but %d allocations", address, len(recipients.Accounts), len(recipients.Allocations))
+	}
+
+	total, err := instance.TotalAllocation(&bind.CallOpts{
+		Context: ctx,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Allocations are stored as int32 parts per million, so anything larger than a whole split can't be a valid
+	// config and would wrap if converted
+	if total > uint32(persist.OwnershipScale) {
+		return nil, fmt.Errorf("split contract %s returned total allocation %d, which is more than %d", address, total, persist.OwnershipScale)
+	}
+
+	var sum uint64
+	for _, allocation := range recipients.Allocations {
+		sum += uint64(allocation)
+	}
+	if sum > uint64(persist.OwnershipScale) {
+		return nil, fmt.Errorf("split contract %s returned allocations adding up to %d, which is more than %d", address, sum, persist.OwnershipScale)
+	}
+
+	config := &SplitContractConfig{
+		Recipients:      make([]SplitContractRecipient, len(recipients.Accounts)),
+		TotalAllocation: total,
+	}
+	for i, account := range recipients.Accounts {
+		config.Recipients[i] = SplitContractRecipient{
+			Address:    persist.Address(strings.ToLower(account.Hex())),
+			Allocation: recipients.Allocations[i],
+		}
+	}
+
+	return config, nil
+}
+
+// GetSplitController returns the address allowed to change a deployed split contract's recipients, or an
+// empty address if the split is immutable
+func GetSplitController(ctx context.Context, address common.Address, ethClient *ethclient.Client) (persist.Address, error) {
+	instance, err := contracts.NewISplitCaller(address, ethClient)
+	if err != nil {
+		return "", err
+	}
+
+	controller, err := instance.Controller(&bind.CallOpts{
+		Context: ctx,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if controller == (common.Address{}) {
+		return "", nil
+	}
+
+	return persist.Address(strings.ToLower(controller.Hex())), nil
+}
+
 // GetMetadataFromURI parses and returns the NFT metadata for a given token URI
 func GetMetadataFromURI(ctx context.Context, turi persist.TokenURI, ipfsClient *shell.Shell, arweaveClient *goar.Client) (persist.TokenMetadata, error) {
 
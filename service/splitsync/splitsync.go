@@ -0,0 +1,84 @@
+package splitsync
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	db "github.com/SplitFi/go-splitfi/db/gen/coredb"
+	"github.com/SplitFi/go-splitfi/service/persist"
+	"github.com/SplitFi/go-splitfi/service/persist/postgres"
+	"github.com/SplitFi/go-splitfi/service/rpc"
+)
+
+// ResyncFromChain replaces a deployed split's recipients with the recipients and allocations enforced by its
+// contract, and records the change as a revision from source
+func ResyncFromChain(ctx context.Context, repos *postgres.Repositories, queries *db.Queries, ethClient *ethclient.Client, split db.Split, source persist.RevisionSource) error {
+	config, err := rpc.GetSplitContractConfig(ctx, common.HexToAddress(split.Address.String()), ethClient)
+	if err != nil {
+		return err
+	}
+
+	// The contract may list the same account more than once, so merge allocations per address
+	ownership := make(map[persist.Address]int32, len(config.Recipients))
+	addresses := make([]string, 0, len(config.Recipients))
+	for _, r := range config.Recipients {
+		address := persist.Address(split.Chain.NormalizeAddress(r.Address))
+		if _, ok := ownership[address]; !ok {
+			addresses = append(addresses, address.String())
+		}
+		ownership[address] += int32(r.Allocation)
+	}
+
+	ids := make([]string, len(addresses))
+	ownerships := make([]int32, len(addresses))
+	for i, address := range addresses {
+		ids[i] = persist.GenerateID().String()
+		ownerships[i] = ownership[persist.Address(address)]
+	}
+
+	tx, err := repos.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	queries = queries.WithTx(tx)
+	defer tx.Rollback(ctx)
+
+	err = queries.DeleteSplitRecipientsExcept(ctx, db.DeleteSplitRecipientsExceptParams{
+		SplitID:   split.ID,
+		Addresses: addresses,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = queries.UpsertSplitRecipients(ctx, db.UpsertSplitRecipientsParams{
+		Ids:        ids,
+		SplitID:    split.ID.String(),
+		Addresses:  addresses,
+		Ownerships: ownerships,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = queries.UpdateSplitTotalOwnership(ctx, db.UpdateSplitTotalOwnershipParams{
+		TotalOwnership: int32(config.TotalAllocation),
+		ID:             split.ID,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = queries.CreateSplitRevision(ctx, db.CreateSplitRevisionParams{
+		SplitID: split.ID,
+		ID:      persist.GenerateID().String(),
+		Source:  string(source),
+	})
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
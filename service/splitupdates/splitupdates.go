@@ -0,0 +1,245 @@
+package splitupdates
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"github.com/gin-gonic/gin"
+	"github.com/googleapis/gax-go/v2/apierror"
+	"google.golang.org/grpc/codes"
+
+	"github.com/SplitFi/go-splitfi/env"
+	"github.com/SplitFi/go-splitfi/service/logger"
+	"github.com/SplitFi/go-splitfi/service/persist"
+	"github.com/SplitFi/go-splitfi/util"
+)
+
+const HandlersContextKey = "splitupdates.handlers"
+
+// subscriberBufferSize is how many updates a subscriber can fall behind by before updates to it are dropped
+const subscriberBufferSize = 32
+
+type UpdateType string
+
+const (
+	UpdateTypeActivity UpdateType = "activity"
+	UpdateTypeBalances UpdateType = "balances"
+)
+
+type ActivityType string
+
+const (
+	ActivityTypeInflow       ActivityType = "inflow"
+	ActivityTypeDistribution ActivityType = "distribution"
+	ActivityTypeWithdrawal   ActivityType = "withdrawal"
+)
+
+// Update is published by tokenprocessing when it records activity on a split or updates the split's balances
+type Update struct {
+	SplitID   persist.DBID `json:"split_id"`
+	Type      UpdateType   `json:"type"`
+	CreatedAt time.Time    `json:"created_at"`
+	Activity  []Activity   `json:"activity,omitempty"`
+	// Balances are every token the split holds after the update
+	Balances []Balance `json:"balances,omitempty"`
+}
+
+// Activity is a transfer into or out of a split
+type Activity struct {
+	SplitID      persist.DBID    `json:"split_id"`
+	Type         ActivityType    `json:"type"`
+	Chain        persist.Chain   `json:"chain"`
+	TokenAddress persist.Address `json:"token_address"`
+	// CounterpartyAddress is who paid an inflow, or who received a distribution or withdrawal
+	CounterpartyAddress persist.Address `json:"counterparty_address"`
+	// Amount is a decimal string in the token's base units
+	Amount      string `json:"amount"`
+	TxHash      string `json:"tx_hash"`
+	BlockNumber int64  `json:"block_number"`
+}
+
+type Balance struct {
+	Chain        persist.Chain   `json:"chain"`
+	TokenAddress persist.Address `json:"token_address"`
+	// Amount is a decimal string in the token's base units
+	Amount string `json:"amount"`
+}
+
+// Handlers fans updates received from pubsub out to the subscriptions open on this instance
+type Handlers struct {
+	pubSub      *pubsub.Client
+	mu          sync.RWMutex
+	subscribers map[persist.DBID]map[*Subscription]bool
+}
+
+// Subscription receives the updates to a split until it's unsubscribed
+type Subscription struct {
+	SplitID  persist.DBID
+	updates  chan Update
+	handlers *Handlers
+	once     sync.Once
+}
+
+// Updates returns the channel updates are sent on, which is closed when the subscription is unsubscribed
+func (s *Subscription) Updates() <-chan Update {
+	return s.updates
+}
+
+// Unsubscribe stops updates from being sent to the subscription. It's safe to call more than once.
+func (s *Subscription) Unsubscribe() {
+	s.once.Do(func() {
+		s.handlers.mu.Lock()
+		defer s.handlers.mu.Unlock()
+
+		subs := s.handlers.subscribers[s.SplitID]
+		delete(subs, s)
+		if len(subs) == 0 {
+			delete(s.handlers.subscribers, s.SplitID)
+		}
+
+		close(s.updates)
+	})
+}
+
+// New creates the handlers for split updates. If listen is set, updates published to pubsub are received and sent
+// to subscribers, which only the graphql server needs to do.
+func New(pub *pubsub.Client, listen bool) *Handlers {
+	h := &Handlers{pubSub: pub, subscribers: map[persist.DBID]map[*Subscription]bool{}}
+
+	if pub != nil && listen {
+		go h.receiveUpdatesFromPubSub()
+	} else if listen {
+		logger.For(nil).Warn("pubsub not configured, split updates will not be received")
+	}
+
+	return h
+}
+
+func AddTo(ctx *gin.Context, handlers *Handlers) {
+	ctx.Set(HandlersContextKey, handlers)
+}
+
+func For(ctx context.Context) *Handlers {
+	gc := util.MustGetGinContext(ctx)
+	return gc.Value(HandlersContextKey).(*Handlers)
+}
+
+// Subscribe returns a subscription to a split's updates. The subscription must be unsubscribed once it's no longer
+// read from.
+func (h *Handlers) Subscribe(splitID persist.DBID) *Subscription {
+	sub := &Subscription{SplitID: splitID, updates: make(chan Update, subscriberBufferSize), handlers: h}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.subscribers[splitID] == nil {
+		h.subscribers[splitID] = map[*Subscription]bool{}
+	}
+	h.subscribers[splitID][sub] = true
+
+	logger.For(nil).Infof("subscribed to updates for split: %s", splitID)
+
+	return sub
+}
+
+// dispatch sends an update to every subscription to its split. Subscriptions that have fallen behind miss the
+// update rather than holding up the others.
+func (h *Handlers) dispatch(update Update) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for sub := range h.subscribers[update.SplitID] {
+		select {
+		case sub.updates <- update:
+		default:
+			logger.For(nil).Warnf("split update subscription full, dropping %s update for split: %s", update.Type, update.SplitID)
+		}
+	}
+}
+
+// Publish sends an update to every instance listening for split updates
+func Publish(ctx context.Context, ps *pubsub.Client, update Update) error {
+	if ps == nil {
+		logger.For(ctx).Warnf("pubsub not configured, not publishing %s update for split: %s", update.Type, update.SplitID)
+		return nil
+	}
+
+	marshalled, err := json.Marshal(update)
+	if err != nil {
+		return err
+	}
+
+	t := ps.Topic(env.GetString("PUBSUB_TOPIC_SPLIT_UPDATES"))
+	result := t.Publish(ctx, &pubsub.Message{
+		Data: marshalled,
+	})
+
+	_, err = result.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to publish split update: %w", err)
+	}
+
+	return nil
+}
+
+func (h *Handlers) receiveUpdatesFromPubSub() {
+	sub, err := h.subscribe(context.Background(), env.GetString("PUBSUB_TOPIC_SPLIT_UPDATES"), fmt.Sprintf("split-updates-%s", persist.GenerateID()))
+	if err != nil {
+		logger.For(nil).Errorf("error creating split updates subscription: %s", err)
+		panic(err)
+	}
+
+	logger.For(nil).Info("subscribing to split updates pubsub topic")
+
+	err = sub.Receive(context.Background(), func(ctx context.Context, msg *pubsub.Message) {
+		defer msg.Ack()
+
+		var update Update
+		if err := json.Unmarshal(msg.Data, &update); err != nil {
+			logger.For(ctx).Warnf("failed to unmarshal pubsub message: %s", err)
+			return
+		}
+
+		h.dispatch(update)
+	})
+	if err != nil {
+		logger.For(nil).Errorf("error receiving split updates from pubsub: %s", err)
+		panic(err)
+	}
+}
+
+// subscribe returns a subscription to the given topic, creating the topic if it doesn't exist yet
+func (h *Handlers) subscribe(ctx context.Context, topic, name string) (*pubsub.Subscription, error) {
+	sub, err := createSubscription(ctx, h.pubSub, topic, name)
+	if err == nil {
+		return sub, nil
+	}
+
+	if errTopicMissing(err) {
+		if _, err := h.pubSub.CreateTopic(ctx, topic); err != nil {
+			return nil, err
+		}
+	}
+
+	return createSubscription(ctx, h.pubSub, topic, name)
+}
+
+func createSubscription(ctx context.Context, client *pubsub.Client, topic, name string) (*pubsub.Subscription, error) {
+	return client.CreateSubscription(ctx, name, pubsub.SubscriptionConfig{
+		Topic:            client.Topic(topic),
+		ExpirationPolicy: time.Hour * 24,
+	})
+}
+
+func errTopicMissing(err error) bool {
+	var aErr *apierror.APIError
+	if ok := errors.As(err, &aErr); ok && aErr.GRPCStatus().Code() == codes.NotFound {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,54 @@
+package splitupdates
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SplitFi/go-splitfi/service/persist"
+)
+
+func TestDispatch(t *testing.T) {
+	t.Run("sends updates to every subscription to the split", func(t *testing.T) {
+		h := New(nil, false)
+		a, b := h.Subscribe("split"), h.Subscribe("split")
+		other := h.Subscribe("other")
+		defer a.Unsubscribe()
+		defer b.Unsubscribe()
+		defer other.Unsubscribe()
+
+		h.dispatch(Update{SplitID: "split", Type: UpdateTypeBalances})
+
+		for _, sub := range []*Subscription{a, b} {
+			require.Len(t, sub.Updates(), 1)
+			assert.Equal(t, persist.DBID("split"), (<-sub.Updates()).SplitID)
+		}
+		assert.Len(t, other.Updates(), 0)
+	})
+
+	t.Run("drops updates to subscriptions that are full", func(t *testing.T) {
+		h := New(nil, false)
+		sub := h.Subscribe("split")
+		defer sub.Unsubscribe()
+
+		for i := 0; i < subscriberBufferSize+1; i++ {
+			h.dispatch(Update{SplitID: "split", Type: UpdateTypeActivity})
+		}
+
+		assert.Len(t, sub.Updates(), subscriberBufferSize)
+	})
+
+	t.Run("stops sending updates once unsubscribed", func(t *testing.T) {
+		h := New(nil, false)
+		sub := h.Subscribe("split")
+
+		sub.Unsubscribe()
+		sub.Unsubscribe()
+		h.dispatch(Update{SplitID: "split", Type: UpdateTypeActivity})
+
+		_, open := <-sub.Updates()
+		assert.False(t, open)
+		assert.Empty(t, h.subscribers)
+	})
+}
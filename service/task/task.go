@@ -42,6 +42,11 @@ type TokenTransfer struct {
 	ToAddress   persist.Address           `json:"to_address"`
 	Token       persist.TokenChainAddress `json:"token"`
 	Amount      persist.HexString         `json:"amount"`
+	TxHash      string                    `json:"tx_hash"`
+	BlockNumber persist.BlockNumber       `json:"block_number"`
+	// LogIndex tells apart transfers within the same transaction. It's the index of the transfer's log, or its
+	// position among the transaction's transfers that don't emit a log, such as native transfers.
+	LogIndex int `json:"log_index"`
 }
 
 type TokenProcessingWalletRemovalMessage struct {
@@ -53,6 +58,20 @@ type ValidateNFTsMessage struct {
 	OwnerAddress persist.EthereumAddress `json:"wallet"`
 }
 
+type LedgerExportMessage struct {
+	ExportID persist.DBID `json:"export_id" binding:"required"`
+}
+
+type WebhookDeliveryMessage struct {
+	DeliveryID persist.DBID `json:"delivery_id" binding:"required"`
+}
+
+// SplitResyncMessage names deployed splits whose recipients may have changed on-chain
+type SplitResyncMessage struct {
+	Chain     persist.Chain     `json:"chain"`
+	Addresses []persist.Address `json:"addresses" binding:"required"`
+}
+
 type PushNotificationMessage struct {
 	PushTokenID persist.DBID   `json:"pushTokenID"`
 	Title       string         `json:"title"`
@@ -81,6 +100,15 @@ func (c *Client) CreateTaskForTokenTransferProcessing(ctx context.Context, messa
 	return c.submitTask(ctx, queue, url, withJSON(message), withTrace(span))
 }
 
+func (c *Client) CreateTaskForSplitResync(ctx context.Context, message SplitResyncMessage) error {
+	span, ctx := tracing.StartSpan(ctx, "cloudtask.create", "createTaskForSplitResync")
+	defer tracing.FinishSpan(span)
+	tracing.AddEventDataToSpan(span, map[string]any{"Chain": message.Chain, "Addresses": message.Addresses})
+	queue := env.GetString("TOKEN_PROCESSING_QUEUE")
+	url := fmt.Sprintf("%s/split/resync", env.GetString("TOKEN_PROCESSING_URL"))
+	return c.submitTask(ctx, queue, url, withJSON(message), withTrace(span))
+}
+
 func (c *Client) CreateTaskForWalletRemoval(ctx context.Context, message TokenProcessingWalletRemovalMessage) error {
 	span, ctx := tracing.StartSpan(ctx, "cloudtask.create", "createTaskForWalletRemoval")
 	defer tracing.FinishSpan(span)
@@ -100,6 +128,28 @@ func (c *Client) CreateTaskForAddingEmailToMailingList(ctx context.Context, mess
 	return c.submitTask(ctx, queue, url, withJSON(message), withTrace(span), withBasicAuth(secret))
 }
 
+func (c *Client) CreateTaskForLedgerExport(ctx context.Context, message LedgerExportMessage) error {
+	span, ctx := tracing.StartSpan(ctx, "cloudtask.create", "createTaskForLedgerExport")
+	defer tracing.FinishSpan(span)
+	tracing.AddEventDataToSpan(span, map[string]any{"Export ID": message.ExportID})
+	queue := env.GetString("LEDGER_EXPORTS_QUEUE")
+	url := fmt.Sprintf("%s/tasks/export-ledger", env.GetString("BACKEND_URL"))
+	secret := env.GetString("LEDGER_EXPORTS_TASK_SECRET")
+	return c.submitTask(ctx, queue, url, withJSON(message), withTrace(span), withBasicAuth(secret), withDeadline(30*time.Minute))
+}
+
+// CreateTaskForWebhookDelivery sends a webhook delivery after delay. Retries are scheduled by the delivery itself, so
+// that each attempt is recorded.
+func (c *Client) CreateTaskForWebhookDelivery(ctx context.Context, message WebhookDeliveryMessage, delay time.Duration) error {
+	span, ctx := tracing.StartSpan(ctx, "cloudtask.create", "createTaskForWebhookDelivery")
+	defer tracing.FinishSpan(span)
+	tracing.AddEventDataToSpan(span, map[string]any{"Delivery ID": message.DeliveryID})
+	queue := env.GetString("WEBHOOKS_QUEUE")
+	url := fmt.Sprintf("%s/tasks/deliver-webhook", env.GetString("BACKEND_URL"))
+	secret := env.GetString("WEBHOOKS_TASK_SECRET")
+	return c.submitTask(ctx, queue, url, withJSON(message), withTrace(span), withBasicAuth(secret), WithDelay(delay))
+}
+
 // NewClient returns a new task client with tracing enabled.
 func NewClient(ctx context.Context) *Client {
 	skipQueues := make(map[string]bool)
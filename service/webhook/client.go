@@ -0,0 +1,47 @@
+package webhook
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+// NewHTTPClient returns a client for sending deliveries. Webhook URLs are chosen by users, so the client won't
+// connect to loopback, private or link-local addresses unless allowPrivate is set, and doesn't follow redirects,
+// which are treated as unsuccessful responses.
+func NewHTTPClient(allowPrivate bool) *http.Client {
+	dialer := &net.Dialer{Timeout: requestTimeout, KeepAlive: 30 * time.Second}
+	if !allowPrivate {
+		dialer.Control = rejectPrivateAddresses
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   requestTimeout,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// rejectPrivateAddresses checks the address being connected to after it has been resolved, so that hostnames
+// that resolve to private addresses are rejected too
+func rejectPrivateAddresses(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || !ip.IsGlobalUnicast() || ip.IsPrivate() {
+		return fmt.Errorf("webhook address %s is not public", host)
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package webhook
+
+import (
+	"context"
+
+	db "github.com/SplitFi/go-splitfi/db/gen/coredb"
+	"github.com/SplitFi/go-splitfi/service/persist"
+)
+
+// SplitData describes the split an event happened to
+type SplitData struct {
+	ID      persist.DBID    `json:"id"`
+	Chain   persist.Chain   `json:"chain"`
+	Address persist.Address `json:"address"`
+	Name    string          `json:"name"`
+}
+
+type InflowData struct {
+	Split SplitData `json:"split"`
+	// InflowCount is how many transfers into the split were received
+	InflowCount int `json:"inflow_count"`
+}
+
+type DistributionData struct {
+	Split   SplitData               `json:"split"`
+	TxHash  string                  `json:"tx_hash"`
+	Entries []DistributionEntryData `json:"entries"`
+}
+
+type DistributionEntryData struct {
+	TokenAddress     persist.Address `json:"token_address"`
+	RecipientAddress persist.Address `json:"recipient_address"`
+	// Amount is a decimal string in the token's base units
+	Amount      string `json:"amount"`
+	BlockNumber int64  `json:"block_number"`
+}
+
+type RecipientsChangedData struct {
+	Split          SplitData       `json:"split"`
+	TotalOwnership int32           `json:"total_ownership"`
+	Recipients     []RecipientData `json:"recipients"`
+}
+
+type RecipientData struct {
+	Address   persist.Address `json:"address"`
+	Ownership int32           `json:"ownership"`
+}
+
+type ControllersChangedData struct {
+	Split SplitData `json:"split"`
+	// ControllerUserIDs are the users who are controllers of the split after the change
+	ControllerUserIDs []persist.DBID `json:"controller_user_ids"`
+}
+
+// eventData returns the data of the payload sent for an event
+func eventData(ctx context.Context, queries *db.Queries, eventType persist.WebhookEventType, evt db.Event) (any, error) {
+	split, err := queries.GetSplitById(ctx, evt.SplitID)
+	if err != nil {
+		return nil, err
+	}
+
+	s := SplitData{ID: split.ID, Chain: split.Chain, Address: split.Address, Name: split.Name}
+
+	switch eventType {
+	case persist.WebhookEventTypeInflow:
+		return InflowData{Split: s, InflowCount: evt.Data.SplitInflowCount}, nil
+
+	case persist.WebhookEventTypeDistribution:
+		entries, err := queries.GetSplitDistributionEntriesByTxHash(ctx, db.GetSplitDistributionEntriesByTxHashParams{
+			SplitID: evt.SplitID,
+			TxHash:  evt.Data.SplitTxHash,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		data := DistributionData{Split: s, TxHash: evt.Data.SplitTxHash, Entries: make([]DistributionEntryData, len(entries))}
+		for i, e := range entries {
+			data.Entries[i] = DistributionEntryData{
+				TokenAddress:     e.TokenAddress,
+				RecipientAddress: e.RecipientAddress,
+				Amount:           e.Amount.BigInt().String(),
+				BlockNumber:      e.BlockNumber,
+			}
+		}
+		return data, nil
+
+	case persist.WebhookEventTypeRecipientsChanged:
+		recipients, err := queries.GetRecipientsBySplitID(ctx, evt.SplitID)
+		if err != nil {
+			return nil, err
+		}
+
+		data := RecipientsChangedData{Split: s, TotalOwnership: split.TotalOwnership, Recipients: make([]RecipientData, len(recipients))}
+		for i, r := range recipients {
+			data.Recipients[i] = RecipientData{Address: r.Address, Ownership: r.Ownership}
+		}
+		return data, nil
+
+	case persist.WebhookEventTypeControllersChanged:
+		members, err := queries.GetSplitMembersBySplitID(ctx, evt.SplitID)
+		if err != nil {
+			return nil, err
+		}
+
+		data := ControllersChangedData{Split: s, ControllerUserIDs: []persist.DBID{}}
+		for _, m := range members {
+			if persist.SplitRole(m.Role) == persist.SplitRoleController {
+				data.ControllerUserIDs = append(data.ControllerUserIDs, m.UserID)
+			}
+		}
+		return data, nil
+	}
+
+	return s, nil
+}
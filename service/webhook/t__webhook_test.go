@@ -0,0 +1,52 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSign(t *testing.T) {
+	body := []byte(`{"id":"event","type":"ping"}`)
+	sentAt := time.Unix(1700000000, 0)
+
+	t.Run("signs the timestamp and body", func(t *testing.T) {
+		sig := Sign("whsec_test", sentAt, body)
+		require.True(t, strings.HasPrefix(sig, "t=1700000000,v1="))
+
+		mac := hmac.New(sha256.New, []byte("whsec_test"))
+		mac.Write([]byte("1700000000." + string(body)))
+		assert.Equal(t, "t=1700000000,v1="+hex.EncodeToString(mac.Sum(nil)), sig)
+	})
+
+	t.Run("depends on the secret and timestamp", func(t *testing.T) {
+		sig := Sign("whsec_test", sentAt, body)
+		assert.NotEqual(t, sig, Sign("whsec_other", sentAt, body))
+		assert.NotEqual(t, sig, Sign("whsec_test", sentAt.Add(time.Second), body))
+	})
+}
+
+func TestGenerateSecret(t *testing.T) {
+	a, err := GenerateSecret()
+	require.NoError(t, err)
+	b, err := GenerateSecret()
+	require.NoError(t, err)
+
+	assert.True(t, strings.HasPrefix(a, "whsec_"))
+	assert.Len(t, a, len("whsec_")+64)
+	assert.NotEqual(t, a, b)
+}
+
+func TestRetryDelay(t *testing.T) {
+	assert.Equal(t, 30*time.Second, RetryDelay(1))
+	assert.Equal(t, time.Minute, RetryDelay(2))
+	assert.Equal(t, 2*time.Minute, RetryDelay(3))
+	assert.Equal(t, 32*time.Minute, RetryDelay(7))
+	assert.Equal(t, 6*time.Hour, RetryDelay(20))
+}
@@ -0,0 +1,261 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	db "github.com/SplitFi/go-splitfi/db/gen/coredb"
+	"github.com/SplitFi/go-splitfi/service/logger"
+	"github.com/SplitFi/go-splitfi/service/persist"
+	"github.com/SplitFi/go-splitfi/service/task"
+)
+
+const (
+	// SignatureHeader is "t=<unix timestamp>,v1=<signature>", where the signature is the hex-encoded HMAC-SHA256 of
+	// "<unix timestamp>.<body>" keyed with the webhook's secret
+	SignatureHeader = "X-SplitFi-Signature"
+	EventHeader     = "X-SplitFi-Event"
+	DeliveryHeader  = "X-SplitFi-Delivery"
+)
+
+const (
+	// MaxAttempts is how many times a delivery is sent before it fails
+	MaxAttempts = 8
+
+	baseRetryDelay = 30 * time.Second
+	maxRetryDelay  = 6 * time.Hour
+
+	requestTimeout = 10 * time.Second
+
+	// maxErrorBodySize is how much of an unsuccessful response is kept in the delivery log
+	maxErrorBodySize = 512
+)
+
+// Payload is the body of every delivery
+type Payload struct {
+	// ID is the ID of the event, which is the same across deliveries of an event to different webhooks
+	ID        persist.DBID             `json:"id"`
+	Type      persist.WebhookEventType `json:"type"`
+	CreatedAt time.Time                `json:"created_at"`
+	Data      any                      `json:"data"`
+}
+
+// GenerateSecret returns a new secret for signing a webhook's deliveries
+func GenerateSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
+
+// Sign returns the value of SignatureHeader for a delivery of body sent at t
+func Sign(secret string, t time.Time, body []byte) string {
+	timestamp := strconv.FormatInt(t.Unix(), 10)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+
+	return fmt.Sprintf("t=%s,v1=%s", timestamp, hex.EncodeToString(mac.Sum(nil)))
+}
+
+// RetryDelay returns how long to wait before sending a delivery again after it has been attempted attempts times.
+// The delay doubles with each attempt.
+func RetryDelay(attempts int) time.Duration {
+	delay := baseRetryDelay
+	for i := 1; i < attempts && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxRetryDelay {
+		delay = maxRetryDelay
+	}
+	return delay
+}
+
+// DispatchEvent sends an event to every webhook subscribed to it. Events that webhooks can't subscribe to are ignored.
+func DispatchEvent(ctx context.Context, queries *db.Queries, taskClient *task.Client, evt db.Event) error {
+	eventType, ok := persist.WebhookEventTypes[evt.Action]
+	if !ok {
+		return nil
+	}
+
+	webhooks, err := SubscribedWebhooks(ctx, queries, eventType, evt.SplitID)
+	if err != nil || len(webhooks) == 0 {
+		return err
+	}
+
+	data, err := eventData(ctx, queries, eventType, evt)
+	if err != nil {
+		return err
+	}
+
+	payload := Payload{ID: evt.ID, Type: eventType, CreatedAt: evt.CreatedAt, Data: data}
+	for _, w := range webhooks {
+		if _, err := Send(ctx, queries, taskClient, w, evt.ID, payload); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SubscribedWebhooks returns the webhooks subscribed to an event type on a split. Webhooks are only sent events
+// from splits their owner can still view, so revoking a user's role stops their webhooks too. Followers aren't
+// included, since anyone can follow a split.
+func SubscribedWebhooks(ctx context.Context, queries *db.Queries, eventType persist.WebhookEventType, splitID persist.DBID) ([]db.Webhook, error) {
+	viewers, err := queries.GetSplitViewerUserIDs(ctx, splitID)
+	if err != nil {
+		return nil, err
+	}
+
+	viewerIDs := make([]string, len(viewers))
+	for i, v := range viewers {
+		viewerIDs[i] = v.String()
+	}
+
+	return queries.GetWebhooksForSplitEvent(ctx, db.GetWebhooksForSplitEventParams{
+		EventType: string(eventType),
+		ViewerIds: viewerIDs,
+		SplitID:   splitID,
+	})
+}
+
+// Ping sends a test delivery to a webhook
+func Ping(ctx context.Context, queries *db.Queries, taskClient *task.Client, w db.Webhook) (db.WebhookDelivery, error) {
+	id := persist.GenerateID()
+	payload := Payload{ID: id, Type: persist.WebhookEventTypePing, CreatedAt: time.Now(), Data: map[string]any{
+		"webhook_id": w.ID,
+		"split_id":   nullableID(w.SplitID),
+	}}
+	return send(ctx, queries, taskClient, id, w, "", payload)
+}
+
+// Send records a delivery of payload to a webhook and submits it. If the webhook was already sent the event,
+// nothing is sent and the returned delivery is empty.
+func Send(ctx context.Context, queries *db.Queries, taskClient *task.Client, w db.Webhook, eventID persist.DBID, payload Payload) (db.WebhookDelivery, error) {
+	return send(ctx, queries, taskClient, persist.GenerateID(), w, eventID, payload)
+}
+
+func send(ctx context.Context, queries *db.Queries, taskClient *task.Client, id persist.DBID, w db.Webhook, eventID persist.DBID, payload Payload) (db.WebhookDelivery, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return db.WebhookDelivery{}, err
+	}
+
+	d, err := queries.InsertWebhookDelivery(ctx, db.InsertWebhookDeliveryParams{
+		ID:        id,
+		WebhookID: w.ID,
+		EventID:   eventID.String(),
+		EventType: payload.Type,
+		Payload:   body,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return db.WebhookDelivery{}, nil
+	}
+	if err != nil {
+		return db.WebhookDelivery{}, err
+	}
+
+	return d, taskClient.CreateTaskForWebhookDelivery(ctx, task.WebhookDeliveryMessage{DeliveryID: d.ID}, 0)
+}
+
+// Deliver sends a delivery to its webhook and records the attempt. Unsuccessful attempts are retried after
+// RetryDelay until the delivery has been attempted MaxAttempts times. Deliveries to webhooks that have since been
+// deleted or disabled fail without being sent.
+func Deliver(ctx context.Context, queries *db.Queries, httpClient *http.Client, taskClient *task.Client, d db.WebhookDelivery) (db.WebhookDelivery, error) {
+	w, err := queries.GetWebhookByID(ctx, d.WebhookID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return record(ctx, queries, d, persist.WebhookDeliveryStatusFailed, 0, "webhook was deleted", 0)
+	}
+	if err != nil {
+		return db.WebhookDelivery{}, err
+	}
+
+	// Pings are sent even if the webhook is disabled, so that it can be tested before it's enabled
+	if !w.Enabled && d.EventType != persist.WebhookEventTypePing {
+		return record(ctx, queries, d, persist.WebhookDeliveryStatusFailed, 0, "webhook is disabled", 0)
+	}
+
+	status, sendErr := post(ctx, httpClient, w, d)
+	if sendErr == nil {
+		return record(ctx, queries, d, persist.WebhookDeliveryStatusSucceeded, status, "", 0)
+	}
+
+	attempts := int(d.Attempts) + 1
+	if attempts >= MaxAttempts {
+		return record(ctx, queries, d, persist.WebhookDeliveryStatusFailed, status, sendErr.Error(), 0)
+	}
+
+	delay := RetryDelay(attempts)
+	d, err = record(ctx, queries, d, persist.WebhookDeliveryStatusPending, status, sendErr.Error(), delay)
+	if err != nil {
+		return db.WebhookDelivery{}, err
+	}
+
+	return d, taskClient.CreateTaskForWebhookDelivery(ctx, task.WebhookDeliveryMessage{DeliveryID: d.ID}, delay)
+}
+
+// post sends a delivery and returns the status it was responded to with, which is zero if there was no response
+func post(ctx context.Context, httpClient *http.Client, w db.Webhook, d db.WebhookDelivery) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.Url, bytes.NewReader(d.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "SplitFi-Webhooks/1.0")
+	req.Header.Set(SignatureHeader, Sign(w.Secret, time.Now(), d.Payload))
+	req.Header.Set(EventHeader, string(d.EventType))
+	req.Header.Set(DeliveryHeader, d.ID.String())
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp.StatusCode, nil
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+	return resp.StatusCode, fmt.Errorf("unexpected status %s: %s", resp.Status, body)
+}
+
+func record(ctx context.Context, queries *db.Queries, d db.WebhookDelivery, status persist.WebhookDeliveryStatus, responseStatus int, errMsg string, retryIn time.Duration) (db.WebhookDelivery, error) {
+	if errMsg != "" {
+		logger.For(ctx).Warnf("failed to deliver %s to webhook %s: %s", d.ID, d.WebhookID, errMsg)
+	}
+
+	return queries.RecordWebhookDeliveryAttempt(ctx, db.RecordWebhookDeliveryAttemptParams{
+		Status:         string(status),
+		ResponseStatus: sql.NullInt32{Int32: int32(responseStatus), Valid: responseStatus != 0},
+		Error:          sql.NullString{String: errMsg, Valid: errMsg != ""},
+		NextAttemptAt:  sql.NullTime{Time: time.Now().Add(retryIn), Valid: retryIn > 0},
+		ID:             d.ID,
+	})
+}
+
+func nullableID(id persist.DBID) *persist.DBID {
+	if id == "" {
+		return nil
+	}
+	return &id
+}
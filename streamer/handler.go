@@ -30,9 +30,9 @@ func handlersInitServer(ctx context.Context, router *gin.Engine, s *streamer, mc
 	poolGroup.POST("/activate", processPoolActivate(taskClient))
 
 	poolRecipientGroup := poolGroup.Group("/recipient")
-	poolRecipientGroup.POST("/create", processPoolRecipientCreate(taskClient))
-	poolRecipientGroup.POST("/update", processPoolRecipientUpdate(taskClient))
-	poolRecipientGroup.POST("/delete", processPoolRecipientDelete(taskClient))
+	poolRecipientGroup.POST("/create", processPoolRecipientChange(taskClient))
+	poolRecipientGroup.POST("/update", processPoolRecipientChange(taskClient))
+	poolRecipientGroup.POST("/delete", processPoolRecipientChange(taskClient))
 
 	poolOwnerGroup := poolGroup.Group("/owner")
 	poolOwnerGroup.POST("/update", processPoolOwnerUpdate(taskClient))
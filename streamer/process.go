@@ -20,12 +20,26 @@ func processTokenTransfer(taskClient *task.Client) gin.HandlerFunc {
 			return
 		}
 
+		// Transfers without a log are told apart by their position within the transaction
+		unlogged := make(map[persist.HexString]int)
+
 		transfers, _ := util.Map(input.Event.Activity, func(event persist.AlchemyAddressActivityEventItem) (task.TokenTransfer, error) {
+			var logIndex int
+			if len(event.Logs) > 0 {
+				logIndex = int(event.Logs[0].Index)
+			} else {
+				logIndex = unlogged[event.Hash]
+				unlogged[event.Hash]++
+			}
+
 			return task.TokenTransfer{
 				FromAddress: event.FromAddress,
 				ToAddress:   event.ToAddress,
 				Token:       persist.NewTokenChainAddress(event.RawContract.Address, input.Event.Network),
 				Amount:      event.Value,
+				TxHash:      string(event.Hash),
+				BlockNumber: event.BlockNumber,
+				LogIndex:    logIndex,
 			}, nil
 		})
 
@@ -60,20 +74,44 @@ func processPoolActivate(taskClient *task.Client) gin.HandlerFunc {
 	}
 }
 
-func processPoolRecipientCreate(taskClient *task.Client) gin.HandlerFunc {
+// processPoolRecipientChange resyncs the recipients of every split that emitted one of the webhook's logs
+func processPoolRecipientChange(taskClient *task.Client) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
-		ctx.JSON(http.StatusOK, util.SuccessResponse{Success: true})
-	}
-}
+		var input persist.AlchemyWebhookInput[persist.AlchemyAddressActivityEvent]
 
-func processPoolRecipientUpdate(taskClient *task.Client) gin.HandlerFunc {
-	return func(ctx *gin.Context) {
-		ctx.JSON(http.StatusOK, util.SuccessResponse{Success: true})
-	}
-}
+		if err := ctx.ShouldBindJSON(&input); err != nil {
+			util.ErrResponse(ctx, http.StatusOK, err)
+			return
+		}
+
+		chain := input.Event.Network
+		seen := make(map[persist.Address]bool)
+		var addresses []persist.Address
+
+		for _, activity := range input.Event.Activity {
+			for _, l := range activity.Logs {
+				address := persist.Address(chain.NormalizeAddress(persist.Address(l.Address.Hex())))
+				if !seen[address] {
+					seen[address] = true
+					addresses = append(addresses, address)
+				}
+			}
+		}
+
+		if len(addresses) == 0 {
+			ctx.JSON(http.StatusOK, util.SuccessResponse{Success: true})
+			return
+		}
+
+		go func() {
+			err := taskClient.CreateTaskForSplitResync(ctx, task.SplitResyncMessage{Chain: chain, Addresses: addresses})
+			if err != nil {
+				err = fmt.Errorf("error creating task for split resync: %w", err)
+				logger.For(ctx).Error(err)
+				sentryutil.ReportError(ctx, err)
+			}
+		}()
 
-func processPoolRecipientDelete(taskClient *task.Client) gin.HandlerFunc {
-	return func(ctx *gin.Context) {
 		ctx.JSON(http.StatusOK, util.SuccessResponse{Success: true})
 	}
 }
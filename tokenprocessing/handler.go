@@ -2,6 +2,9 @@ package tokenprocessing
 
 import (
 	"context"
+
+	"cloud.google.com/go/pubsub"
+	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/gin-gonic/gin"
 
 	"github.com/SplitFi/go-splitfi/service/multichain"
@@ -10,11 +13,14 @@ import (
 	"github.com/SplitFi/go-splitfi/service/throttle"
 )
 
-func handlersInitServer(ctx context.Context, router *gin.Engine, tp *tokenProcessor, mc *multichain.Provider, repos *postgres.Repositories, throttler *throttle.Locker, taskClient *task.Client) *gin.Engine {
+func handlersInitServer(ctx context.Context, router *gin.Engine, tp *tokenProcessor, mc *multichain.Provider, repos *postgres.Repositories, ethClient *ethclient.Client, throttler *throttle.Locker, taskClient *task.Client, pub *pubsub.Client) *gin.Engine {
 	// Handles retries and token state
 
 	tokenGroup := router.Group("/token")
-	tokenGroup.POST("/transfer", processTokenTransfers(mc, mc.Queries))
+	tokenGroup.POST("/transfer", processTokenTransfers(mc, mc.Queries, pub))
+
+	splitGroup := router.Group("/split")
+	splitGroup.POST("/resync", processSplitResync(repos, mc.Queries, ethClient))
 
 	ownersGroup := router.Group("/owner")
 	ownersGroup.POST("/wallet-removal", processWalletRemoval())
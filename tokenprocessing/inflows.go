@@ -0,0 +1,109 @@
+package tokenprocessing
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/jackc/pgx/v4"
+
+	db "github.com/SplitFi/go-splitfi/db/gen/coredb"
+	"github.com/SplitFi/go-splitfi/event"
+	"github.com/SplitFi/go-splitfi/service/logger"
+	"github.com/SplitFi/go-splitfi/service/persist"
+	"github.com/SplitFi/go-splitfi/service/splitupdates"
+	"github.com/SplitFi/go-splitfi/service/task"
+)
+
+// recordInflows records every transfer that moves funds into a split and adds it to the split's daily
+// inflow rollups, which split analytics are served from. Transfers that were already recorded are ignored.
+// Every split that received new transfers dispatches an event so that its followers and members are notified,
+// and the transfers into those splits are returned as split activity.
+func recordInflows(ctx context.Context, queries *db.Queries, transfers []task.TokenTransfer) ([]splitupdates.Activity, error) {
+	var params db.InsertSplitInflowsParams
+	// activity[i] describes the inflow inserted with params.Ids[i]
+	var activity []splitupdates.Activity
+
+	for _, transfer := range transfers {
+		if transfer.TxHash == "" || transfer.Amount.BigInt().Sign() <= 0 {
+			continue
+		}
+
+		chain := transfer.Token.Chain
+
+		split, err := queries.GetSplitByChainAddress(ctx, db.GetSplitByChainAddressParams{
+			Address: persist.Address(chain.NormalizeAddress(transfer.ToAddress)),
+			Chain:   chain,
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		params.Ids = append(params.Ids, persist.GenerateID().String())
+		params.SplitIds = append(params.SplitIds, split.ID.String())
+		params.Chains = append(params.Chains, int32(chain))
+		params.TokenAddresses = append(params.TokenAddresses, chain.NormalizeAddress(transfer.Token.Address))
+		params.PayerAddresses = append(params.PayerAddresses, chain.NormalizeAddress(transfer.FromAddress))
+		params.Amounts = append(params.Amounts, transfer.Amount.BigInt().String())
+		params.TxHashes = append(params.TxHashes, strings.ToLower(transfer.TxHash))
+		params.BlockNumbers = append(params.BlockNumbers, int64(transfer.BlockNumber))
+		params.LogIndexes = append(params.LogIndexes, int32(transfer.LogIndex))
+
+		activity = append(activity, splitupdates.Activity{
+			SplitID:             split.ID,
+			Type:                splitupdates.ActivityTypeInflow,
+			Chain:               chain,
+			TokenAddress:        persist.Address(chain.NormalizeAddress(transfer.Token.Address)),
+			CounterpartyAddress: persist.Address(chain.NormalizeAddress(transfer.FromAddress)),
+			Amount:              transfer.Amount.BigInt().String(),
+			TxHash:              strings.ToLower(transfer.TxHash),
+			BlockNumber:         int64(transfer.BlockNumber),
+		})
+	}
+
+	if len(params.Ids) == 0 {
+		return nil, nil
+	}
+
+	received, err := queries.InsertSplitInflows(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var splitIDs []persist.DBID
+	inflowCounts := make(map[persist.DBID]int)
+	inserted := make(map[persist.DBID]bool, len(received))
+	for _, r := range received {
+		if inflowCounts[r.SplitID] == 0 {
+			splitIDs = append(splitIDs, r.SplitID)
+		}
+		inflowCounts[r.SplitID]++
+		inserted[r.ID] = true
+	}
+
+	for _, splitID := range splitIDs {
+		err := event.Dispatch(ctx, db.Event{
+			ResourceTypeID: persist.ResourceTypeSplit,
+			SplitID:        splitID,
+			SubjectID:      splitID,
+			Action:         persist.ActionSplitReceivedFunds,
+			Data:           persist.EventData{SplitInflowCount: inflowCounts[splitID]},
+		})
+		if err != nil {
+			logger.For(ctx).Errorf("error dispatching event: %s", err)
+		}
+	}
+
+	// Only the transfers that were inserted are new. Other transfers into the same split may have been recorded before.
+	newActivity := make([]splitupdates.Activity, 0, len(received))
+	for i, a := range activity {
+		if inserted[persist.DBID(params.Ids[i])] {
+			newActivity = append(newActivity, a)
+		}
+	}
+
+	return newActivity, nil
+}
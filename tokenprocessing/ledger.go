@@ -0,0 +1,164 @@
+package tokenprocessing
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/jackc/pgx/v4"
+
+	db "github.com/SplitFi/go-splitfi/db/gen/coredb"
+	"github.com/SplitFi/go-splitfi/event"
+	"github.com/SplitFi/go-splitfi/service/distribution"
+	"github.com/SplitFi/go-splitfi/service/logger"
+	"github.com/SplitFi/go-splitfi/service/persist"
+	"github.com/SplitFi/go-splitfi/service/splitupdates"
+	"github.com/SplitFi/go-splitfi/service/task"
+)
+
+// recordLedgerEntries records every transfer that moves funds out of a split. A transfer to one of the
+// split's recipients is a withdrawal by that recipient. Any other outgoing transfer is a distribution,
+// which is recorded as one entry per recipient for their share of the transferred amount.
+//
+// It must run before balances are updated so that entries capture the split's balance prior to the transfer.
+// The entries that were new are returned as split activity.
+func recordLedgerEntries(ctx context.Context, queries *db.Queries, transfers []task.TokenTransfer) ([]splitupdates.Activity, error) {
+	var params db.InsertSplitLedgerEntriesParams
+	// activity[i] describes the entry inserted with params.Ids[i]
+	var activity []splitupdates.Activity
+
+	add := func(split db.Split, entryType persist.LedgerEntryType, transfer task.TokenTransfer, recipient persist.Address, amount string, balance persist.HexString) {
+		params.Ids = append(params.Ids, persist.GenerateID().String())
+		params.SplitIds = append(params.SplitIds, split.ID.String())
+		params.EntryTypes = append(params.EntryTypes, string(entryType))
+		params.Chains = append(params.Chains, int32(split.Chain))
+		params.TokenAddresses = append(params.TokenAddresses, split.Chain.NormalizeAddress(transfer.Token.Address))
+		params.RecipientAddresses = append(params.RecipientAddresses, split.Chain.NormalizeAddress(recipient))
+		params.Amounts = append(params.Amounts, amount)
+		params.SplitBalances = append(params.SplitBalances, balance.String())
+		params.TxHashes = append(params.TxHashes, strings.ToLower(transfer.TxHash))
+		params.BlockNumbers = append(params.BlockNumbers, int64(transfer.BlockNumber))
+		params.LogIndexes = append(params.LogIndexes, int32(transfer.LogIndex))
+
+		activityType := splitupdates.ActivityTypeDistribution
+		if entryType == persist.LedgerEntryTypeWithdrawal {
+			activityType = splitupdates.ActivityTypeWithdrawal
+		}
+		activity = append(activity, splitupdates.Activity{
+			SplitID:             split.ID,
+			Type:                activityType,
+			Chain:               split.Chain,
+			TokenAddress:        persist.Address(split.Chain.NormalizeAddress(transfer.Token.Address)),
+			CounterpartyAddress: persist.Address(split.Chain.NormalizeAddress(recipient)),
+			Amount:              persist.HexString(amount).BigInt().String(),
+			TxHash:              strings.ToLower(transfer.TxHash),
+			BlockNumber:         int64(transfer.BlockNumber),
+		})
+	}
+
+	for _, transfer := range transfers {
+		if transfer.TxHash == "" || transfer.Amount.BigInt().Sign() <= 0 {
+			continue
+		}
+
+		chain := transfer.Token.Chain
+
+		split, err := queries.GetSplitByChainAddress(ctx, db.GetSplitByChainAddressParams{
+			Address: persist.Address(chain.NormalizeAddress(transfer.FromAddress)),
+			Chain:   chain,
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		recipients, err := queries.GetRecipientsBySplitID(ctx, split.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		balance := persist.HexString("0")
+		token, err := queries.GetTokenByOwnerAndTokenChainAddress(ctx, db.GetTokenByOwnerAndTokenChainAddressParams{
+			OwnerAddress: split.Address,
+			TokenAddress: persist.Address(chain.NormalizeAddress(transfer.Token.Address)),
+			Chain:        chain,
+		})
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+		if err == nil {
+			balance = token.Balance
+		}
+
+		to := chain.NormalizeAddress(transfer.ToAddress)
+		isWithdrawal := false
+		for _, r := range recipients {
+			if chain.NormalizeAddress(r.Address) == to {
+				isWithdrawal = true
+				break
+			}
+		}
+
+		if isWithdrawal {
+			add(split, persist.LedgerEntryTypeWithdrawal, transfer, transfer.ToAddress, transfer.Amount.String(), balance)
+			continue
+		}
+
+		result := distribution.Distribute(transfer.Amount.BigInt(), distribution.SharesFromRecipients(recipients), int64(split.TotalOwnership))
+		for _, a := range result.Allocations {
+			if a.Amount.Sign() <= 0 {
+				continue
+			}
+			add(split, persist.LedgerEntryTypeDistribution, transfer, a.Address, a.Amount.Text(16), balance)
+		}
+	}
+
+	if len(params.Ids) == 0 {
+		return nil, nil
+	}
+
+	inserted, err := queries.InsertSplitLedgerEntries(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// A distribution is recorded as several entries, but it's only dispatched once
+	type distributionKey struct {
+		splitID persist.DBID
+		txHash  string
+	}
+	dispatched := make(map[distributionKey]bool)
+	recorded := make(map[persist.DBID]bool, len(inserted))
+
+	for _, e := range inserted {
+		recorded[e.ID] = true
+
+		key := distributionKey{splitID: e.SplitID, txHash: e.TxHash}
+		if e.EntryType != persist.LedgerEntryTypeDistribution || dispatched[key] {
+			continue
+		}
+		dispatched[key] = true
+
+		err := event.Dispatch(ctx, db.Event{
+			ResourceTypeID: persist.ResourceTypeSplit,
+			SplitID:        e.SplitID,
+			SubjectID:      e.SplitID,
+			Action:         persist.ActionSplitDistributed,
+			Data:           persist.EventData{SplitTxHash: e.TxHash},
+		})
+		if err != nil {
+			logger.For(ctx).Errorf("error dispatching event: %s", err)
+		}
+	}
+
+	newActivity := make([]splitupdates.Activity, 0, len(inserted))
+	for i, a := range activity {
+		if recorded[persist.DBID(params.Ids[i])] {
+			newActivity = append(newActivity, a)
+		}
+	}
+
+	return newActivity, nil
+}
@@ -1,6 +1,7 @@
 package tokenprocessing
 
 import (
+	"cloud.google.com/go/pubsub"
 	"errors"
 	"github.com/gin-gonic/gin"
 	"github.com/jackc/pgx/v4"
@@ -14,11 +15,14 @@ import (
 	"github.com/SplitFi/go-splitfi/service/logger"
 	"github.com/SplitFi/go-splitfi/service/multichain"
 	"github.com/SplitFi/go-splitfi/service/persist"
+	"github.com/SplitFi/go-splitfi/service/persist/postgres"
+	"github.com/SplitFi/go-splitfi/service/splitsync"
 	"github.com/SplitFi/go-splitfi/service/task"
 	"github.com/SplitFi/go-splitfi/util"
+	"github.com/ethereum/go-ethereum/ethclient"
 )
 
-func processTokenTransfers(mc *multichain.Provider, queries *db.Queries) gin.HandlerFunc {
+func processTokenTransfers(mc *multichain.Provider, queries *db.Queries, ps *pubsub.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var input task.TokenTransferProcessingMessage
 
@@ -27,6 +31,20 @@ func processTokenTransfers(mc *multichain.Provider, queries *db.Queries) gin.Han
 			return
 		}
 
+		outflows, err := recordLedgerEntries(c, queries, input.Transfers)
+		if err != nil {
+			logger.For(c).Errorf("error recording ledger entries: %s", err)
+			util.ErrResponse(c, http.StatusInternalServerError, err)
+			return
+		}
+
+		inflows, err := recordInflows(c, queries, input.Transfers)
+		if err != nil {
+			logger.For(c).Errorf("error recording split inflows: %s", err)
+			util.ErrResponse(c, http.StatusInternalServerError, err)
+			return
+		}
+
 		var pPoolAddresses []persist.Address
 		var pTokenAddresses []persist.Address
 		var pChains []persist.Chain
@@ -141,6 +159,43 @@ func processTokenTransfers(mc *multichain.Provider, queries *db.Queries) gin.Han
 
 		wp.Wait()
 
+		publishSplitUpdates(c, queries, ps, append(outflows, inflows...))
+
+		c.JSON(http.StatusOK, util.SuccessResponse{Success: true})
+	}
+}
+
+// processSplitResync replaces the recipients of splits whose recipients changed on-chain, and records the change as
+// a revision from a webhook
+func processSplitResync(repos *postgres.Repositories, queries *db.Queries, ethClient *ethclient.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var input task.SplitResyncMessage
+		if err := c.ShouldBindJSON(&input); err != nil {
+			util.ErrResponse(c, http.StatusOK, err)
+			return
+		}
+
+		for _, address := range input.Addresses {
+			split, err := queries.GetSplitByChainAddress(c, db.GetSplitByChainAddressParams{
+				Address: persist.Address(input.Chain.NormalizeAddress(address)),
+				Chain:   input.Chain,
+			})
+			if errors.Is(err, pgx.ErrNoRows) {
+				continue
+			}
+			if err != nil {
+				util.ErrResponse(c, http.StatusInternalServerError, err)
+				return
+			}
+
+			err = splitsync.ResyncFromChain(c, repos, queries, ethClient, split, persist.RevisionSourceWebhook)
+			if err != nil {
+				logger.For(c).Errorf("error resyncing split %s: %s", split.ID, err)
+				util.ErrResponse(c, http.StatusInternalServerError, err)
+				return
+			}
+		}
+
 		c.JSON(http.StatusOK, util.SuccessResponse{Success: true})
 	}
 }
@@ -79,7 +79,7 @@ func CoreInitServer(ctx context.Context, clients *server.Clients, mc *multichain
 
 	tp := NewTokenProcessor(clients.Queries, http.DefaultClient, clients.IPFSClient, clients.ArweaveClient, clients.StorageClient, env.GetString("GCLOUD_TOKEN_CONTENT_BUCKET"))
 
-	return handlersInitServer(ctx, router, tp, mc, clients.Repos, t, clients.TaskClient)
+	return handlersInitServer(ctx, router, tp, mc, clients.Repos, clients.EthClient, t, clients.TaskClient, clients.PubSubClient)
 }
 
 type tokenProcessor struct {
@@ -135,6 +135,7 @@ func setDefaults() {
 	viper.SetDefault("PUBSUB_EMULATOR_HOST", "")
 	viper.SetDefault("PUBSUB_TOPIC_NEW_NOTIFICATIONS", "dev-new-notifications")
 	viper.SetDefault("PUBSUB_TOPIC_UPDATED_NOTIFICATIONS", "dev-updated-notifications")
+	viper.SetDefault("PUBSUB_TOPIC_SPLIT_UPDATES", "dev-split-updates")
 	viper.SetDefault("PUBSUB_SUB_NEW_NOTIFICATIONS", "dev-new-notifications-sub")
 	viper.SetDefault("PUBSUB_SUB_UPDATED_NOTIFICATIONS", "dev-updated-notifications-sub")
 	viper.SetDefault("RASTERIZER_URL", "http://localhost:3000")
@@ -144,6 +145,9 @@ func setDefaults() {
 	viper.SetDefault("OPENSEA_WEBHOOK_SECRET", "")
 	viper.SetDefault("MINT_PROCESSING_QUEUE", "projects/gallery-local/locations/here/queues/mint-processing")
 	viper.SetDefault("SIMPLEHASH_API_KEY", "")
+	viper.SetDefault("BACKEND_URL", "http://localhost:4000")
+	viper.SetDefault("WEBHOOKS_QUEUE", "projects/gallery-local/locations/here/queues/webhooks")
+	viper.SetDefault("WEBHOOKS_TASK_SECRET", "webhooks-task-secret")
 
 	viper.AutomaticEnv()
 
@@ -0,0 +1,80 @@
+package tokenprocessing
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+
+	db "github.com/SplitFi/go-splitfi/db/gen/coredb"
+	"github.com/SplitFi/go-splitfi/service/logger"
+	"github.com/SplitFi/go-splitfi/service/persist"
+	"github.com/SplitFi/go-splitfi/service/splitupdates"
+)
+
+// publishSplitUpdates publishes the new activity on each split, followed by the split's balances. It must run after
+// balances are updated so that subscribers are sent the balances the activity resulted in.
+func publishSplitUpdates(ctx context.Context, queries *db.Queries, ps *pubsub.Client, activity []splitupdates.Activity) {
+	var splitIDs []persist.DBID
+	bySplit := make(map[persist.DBID][]splitupdates.Activity)
+	for _, a := range activity {
+		if _, ok := bySplit[a.SplitID]; !ok {
+			splitIDs = append(splitIDs, a.SplitID)
+		}
+		bySplit[a.SplitID] = append(bySplit[a.SplitID], a)
+	}
+
+	for _, splitID := range splitIDs {
+		err := splitupdates.Publish(ctx, ps, splitupdates.Update{
+			SplitID:   splitID,
+			Type:      splitupdates.UpdateTypeActivity,
+			CreatedAt: time.Now(),
+			Activity:  bySplit[splitID],
+		})
+		if err != nil {
+			logger.For(ctx).Errorf("error publishing activity for split %s: %s", splitID, err)
+		}
+
+		balances, err := splitBalances(ctx, queries, splitID)
+		if err != nil {
+			logger.For(ctx).Errorf("error getting balances of split %s: %s", splitID, err)
+			continue
+		}
+
+		err = splitupdates.Publish(ctx, ps, splitupdates.Update{
+			SplitID:   splitID,
+			Type:      splitupdates.UpdateTypeBalances,
+			CreatedAt: time.Now(),
+			Balances:  balances,
+		})
+		if err != nil {
+			logger.For(ctx).Errorf("error publishing balances for split %s: %s", splitID, err)
+		}
+	}
+}
+
+func splitBalances(ctx context.Context, queries *db.Queries, splitID persist.DBID) ([]splitupdates.Balance, error) {
+	split, err := queries.GetSplitById(ctx, splitID)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := queries.GetTokensByOwnerAddressAndChain(ctx, db.GetTokensByOwnerAddressAndChainParams{
+		OwnerAddress: split.Address,
+		Chain:        split.Chain,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	balances := make([]splitupdates.Balance, len(tokens))
+	for i, t := range tokens {
+		balances[i] = splitupdates.Balance{
+			Chain:        t.Chain,
+			TokenAddress: t.TokenAddress,
+			Amount:       t.Balance.BigInt().String(),
+		}
+	}
+
+	return balances, nil
+}
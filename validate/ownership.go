@@ -0,0 +1,74 @@
+package validate
+
+import (
+	"fmt"
+
+	"github.com/SplitFi/go-splitfi/service/persist"
+)
+
+// OwnershipShare is a single recipient's ownership of a split, in parts per million
+type OwnershipShare struct {
+	Address   persist.Address
+	Ownership int
+}
+
+// ValidateShares checks that every share is positive, no larger than persist.OwnershipScale, and that no
+// address appears more than once. Each problem is reported against the offending recipient, as
+// parameter[address].
+func ValidateShares(parameter string, shares []OwnershipShare) error {
+	validationErr := ErrInvalidInput{}
+	seen := make(map[persist.Address]bool, len(shares))
+
+	for _, s := range shares {
+		p := fmt.Sprintf("%s[%s]", parameter, s.Address)
+
+		if s.Address == "" {
+			validationErr.Append(parameter, "recipient address is required")
+			continue
+		}
+		if seen[s.Address] {
+			validationErr.Append(p, "recipient appears more than once")
+		}
+		seen[s.Address] = true
+
+		if s.Ownership <= 0 {
+			validationErr.Append(p, "ownership must be greater than zero")
+		} else if s.Ownership > int(persist.OwnershipScale) {
+			validationErr.Append(p, fmt.Sprintf("ownership must be at most %d", persist.OwnershipScale))
+		}
+	}
+
+	if len(validationErr.Parameters) > 0 {
+		return validationErr
+	}
+
+	return nil
+}
+
+// ValidateOwnership checks shares as ValidateShares does, and additionally that totalOwnership is between 1 and
+// persist.OwnershipScale and that the shares add up to exactly totalOwnership.
+func ValidateOwnership(parameter string, shares []OwnershipShare, totalOwnership int) error {
+	validationErr := ErrInvalidInput{}
+
+	if err := ValidateShares(parameter, shares); err != nil {
+		validationErr = err.(ErrInvalidInput)
+	}
+
+	if totalOwnership <= 0 || totalOwnership > int(persist.OwnershipScale) {
+		validationErr.Append("totalOwnership", fmt.Sprintf("total ownership must be between 1 and %d", persist.OwnershipScale))
+	} else {
+		var allocated int
+		for _, s := range shares {
+			allocated += s.Ownership
+		}
+		if allocated != totalOwnership {
+			validationErr.Append(parameter, fmt.Sprintf("ownership adds up to %d but must be exactly %d", allocated, totalOwnership))
+		}
+	}
+
+	if len(validationErr.Parameters) > 0 {
+		return validationErr
+	}
+
+	return nil
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/go-playground/validator/v10"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/SplitFi/go-splitfi/service/persist"
 )
 
 type testValue struct {
@@ -47,6 +49,41 @@ func TestValidate_usernameValidator(pTest *testing.T) {
 	testValidatorWithTestValues(pTest, UsernameValidator, testUsernames)
 }
 
+func TestValidate_ownership(pTest *testing.T) {
+	share := func(address string, ownership int) OwnershipShare {
+		return OwnershipShare{Address: persist.Address(address), Ownership: ownership}
+	}
+
+	var testOwnerships = []struct {
+		shares               []OwnershipShare
+		totalOwnership       int
+		description          string
+		shouldPassValidation bool
+		invalidParameters    []string
+	}{
+		{[]OwnershipShare{share("0xa", 600000), share("0xb", 400000)}, 1000000, "Shares add up to total", true, nil},
+		{[]OwnershipShare{share("0xa", 50), share("0xb", 50)}, 100, "Shares add up to a smaller total", true, nil},
+		{[]OwnershipShare{share("0xa", 600000), share("0xb", 300000)}, 1000000, "Shares add up to less than total", false, []string{"shares"}},
+		{[]OwnershipShare{share("0xa", 600000), share("0xb", 500000)}, 1000000, "Shares add up to more than total", false, []string{"shares"}},
+		{[]OwnershipShare{share("0xa", 1000000), share("0xb", 0)}, 1000000, "Zero share", false, []string{"shares[0xb]"}},
+		{[]OwnershipShare{share("0xa", 500000), share("0xa", 500000)}, 1000000, "Duplicate recipient", false, []string{"shares[0xa]"}},
+		{[]OwnershipShare{share("0xa", 2000000)}, 2000000, "Total above scale", false, []string{"shares[0xa]", "totalOwnership"}},
+	}
+
+	for _, item := range testOwnerships {
+		err := ValidateOwnership("shares", item.shares, item.totalOwnership)
+		if item.shouldPassValidation {
+			assert.Nil(pTest, err, item.description)
+			continue
+		}
+
+		invalid, ok := err.(ErrInvalidInput)
+		if assert.True(pTest, ok, item.description) {
+			assert.ElementsMatch(pTest, item.invalidParameters, invalid.Parameters, item.description)
+		}
+	}
+}
+
 func testValidatorWithTestValues(pTest *testing.T, validatorFunc validator.Func, testValues []testValue) {
 	validate := validator.New()
 	validate.RegisterValidation("validatorName", validatorFunc)